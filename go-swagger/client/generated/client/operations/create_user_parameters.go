@@ -63,6 +63,12 @@ CreateUserParams contains all the parameters to send to the API endpoint
 */
 type CreateUserParams struct {
 
+	/* IdempotencyKey.
+
+	   Makes retries safe: a repeated request with the same key and body gets the original response, the same key with another body is rejected with 422. Keys expire after a server-side TTL.
+	*/
+	IdempotencyKey *string
+
 	// Body.
 	Body *models.CreateUserRequest
 
@@ -119,6 +125,17 @@ func (o *CreateUserParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIdempotencyKey adds the idempotencyKey to the create user params
+func (o *CreateUserParams) WithIdempotencyKey(idempotencyKey *string) *CreateUserParams {
+	o.SetIdempotencyKey(idempotencyKey)
+	return o
+}
+
+// SetIdempotencyKey adds the idempotencyKey to the create user params
+func (o *CreateUserParams) SetIdempotencyKey(idempotencyKey *string) {
+	o.IdempotencyKey = idempotencyKey
+}
+
 // WithBody adds the body to the create user params
 func (o *CreateUserParams) WithBody(body *models.CreateUserRequest) *CreateUserParams {
 	o.SetBody(body)
@@ -137,6 +154,14 @@ func (o *CreateUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		return err
 	}
 	var res []error
+
+	if o.IdempotencyKey != nil {
+
+		// header param Idempotency-Key
+		if err := r.SetHeaderParam("Idempotency-Key", *o.IdempotencyKey); err != nil {
+			return err
+		}
+	}
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
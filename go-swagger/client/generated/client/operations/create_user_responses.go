@@ -36,6 +36,30 @@ func (o *CreateUserReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return nil, result
+	case 406:
+		result := NewCreateUserNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCreateUserConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 415:
+		result := NewCreateUserUnsupportedMediaType()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewCreateUserUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewCreateUserInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -125,7 +149,7 @@ func NewCreateUserBadRequest() *CreateUserBadRequest {
 /*
 CreateUserBadRequest describes a response with status code 400, with default header values.
 
-Bad Request
+Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
 */
 type CreateUserBadRequest struct {
 	Payload *models.ErrorResponse
@@ -187,6 +211,286 @@ func (o *CreateUserBadRequest) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewCreateUserNotAcceptable creates a CreateUserNotAcceptable with default headers values
+func NewCreateUserNotAcceptable() *CreateUserNotAcceptable {
+	return &CreateUserNotAcceptable{}
+}
+
+/*
+CreateUserNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type CreateUserNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create user not acceptable response has a 2xx status code
+func (o *CreateUserNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create user not acceptable response has a 3xx status code
+func (o *CreateUserNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create user not acceptable response has a 4xx status code
+func (o *CreateUserNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create user not acceptable response has a 5xx status code
+func (o *CreateUserNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create user not acceptable response a status code equal to that given
+func (o *CreateUserNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the create user not acceptable response
+func (o *CreateUserNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *CreateUserNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserNotAcceptable %s", 406, payload)
+}
+
+func (o *CreateUserNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserNotAcceptable %s", 406, payload)
+}
+
+func (o *CreateUserNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUserNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUserConflict creates a CreateUserConflict with default headers values
+func NewCreateUserConflict() *CreateUserConflict {
+	return &CreateUserConflict{}
+}
+
+/*
+CreateUserConflict describes a response with status code 409, with default header values.
+
+Request with the same Idempotency-Key is still in progress (code 7) or the name is already taken by another user (code 16)
+*/
+type CreateUserConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create user conflict response has a 2xx status code
+func (o *CreateUserConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create user conflict response has a 3xx status code
+func (o *CreateUserConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create user conflict response has a 4xx status code
+func (o *CreateUserConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create user conflict response has a 5xx status code
+func (o *CreateUserConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create user conflict response a status code equal to that given
+func (o *CreateUserConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the create user conflict response
+func (o *CreateUserConflict) Code() int {
+	return 409
+}
+
+func (o *CreateUserConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserConflict %s", 409, payload)
+}
+
+func (o *CreateUserConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserConflict %s", 409, payload)
+}
+
+func (o *CreateUserConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUserConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUserUnsupportedMediaType creates a CreateUserUnsupportedMediaType with default headers values
+func NewCreateUserUnsupportedMediaType() *CreateUserUnsupportedMediaType {
+	return &CreateUserUnsupportedMediaType{}
+}
+
+/*
+CreateUserUnsupportedMediaType describes a response with status code 415, with default header values.
+
+Unsupported Media Type (code 13)
+*/
+type CreateUserUnsupportedMediaType struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create user unsupported media type response has a 2xx status code
+func (o *CreateUserUnsupportedMediaType) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create user unsupported media type response has a 3xx status code
+func (o *CreateUserUnsupportedMediaType) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create user unsupported media type response has a 4xx status code
+func (o *CreateUserUnsupportedMediaType) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create user unsupported media type response has a 5xx status code
+func (o *CreateUserUnsupportedMediaType) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create user unsupported media type response a status code equal to that given
+func (o *CreateUserUnsupportedMediaType) IsCode(code int) bool {
+	return code == 415
+}
+
+// Code gets the status code for the create user unsupported media type response
+func (o *CreateUserUnsupportedMediaType) Code() int {
+	return 415
+}
+
+func (o *CreateUserUnsupportedMediaType) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *CreateUserUnsupportedMediaType) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *CreateUserUnsupportedMediaType) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUserUnsupportedMediaType) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUserUnprocessableEntity creates a CreateUserUnprocessableEntity with default headers values
+func NewCreateUserUnprocessableEntity() *CreateUserUnprocessableEntity {
+	return &CreateUserUnprocessableEntity{}
+}
+
+/*
+CreateUserUnprocessableEntity describes a response with status code 422, with default header values.
+
+Idempotency-Key was already used with another request body (code 6)
+*/
+type CreateUserUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create user unprocessable entity response has a 2xx status code
+func (o *CreateUserUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create user unprocessable entity response has a 3xx status code
+func (o *CreateUserUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create user unprocessable entity response has a 4xx status code
+func (o *CreateUserUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create user unprocessable entity response has a 5xx status code
+func (o *CreateUserUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create user unprocessable entity response a status code equal to that given
+func (o *CreateUserUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the create user unprocessable entity response
+func (o *CreateUserUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *CreateUserUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserUnprocessableEntity %s", 422, payload)
+}
+
+func (o *CreateUserUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users][%d] createUserUnprocessableEntity %s", 422, payload)
+}
+
+func (o *CreateUserUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUserUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateUserInternalServerError creates a CreateUserInternalServerError with default headers values
 func NewCreateUserInternalServerError() *CreateUserInternalServerError {
 	return &CreateUserInternalServerError{}
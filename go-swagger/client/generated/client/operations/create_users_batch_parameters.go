@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// NewCreateUsersBatchParams creates a new CreateUsersBatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateUsersBatchParams() *CreateUsersBatchParams {
+	return &CreateUsersBatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateUsersBatchParamsWithTimeout creates a new CreateUsersBatchParams object
+// with the ability to set a timeout on a request.
+func NewCreateUsersBatchParamsWithTimeout(timeout time.Duration) *CreateUsersBatchParams {
+	return &CreateUsersBatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateUsersBatchParamsWithContext creates a new CreateUsersBatchParams object
+// with the ability to set a context for a request.
+func NewCreateUsersBatchParamsWithContext(ctx context.Context) *CreateUsersBatchParams {
+	return &CreateUsersBatchParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateUsersBatchParamsWithHTTPClient creates a new CreateUsersBatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateUsersBatchParamsWithHTTPClient(client *http.Client) *CreateUsersBatchParams {
+	return &CreateUsersBatchParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+CreateUsersBatchParams contains all the parameters to send to the API endpoint
+
+	for the create users batch operation.
+
+	Typically these are written to a http.Request.
+*/
+type CreateUsersBatchParams struct {
+
+	// Body.
+	Body *models.CreateUsersBatchRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create users batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateUsersBatchParams) WithDefaults() *CreateUsersBatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create users batch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateUsersBatchParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create users batch params
+func (o *CreateUsersBatchParams) WithTimeout(timeout time.Duration) *CreateUsersBatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create users batch params
+func (o *CreateUsersBatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create users batch params
+func (o *CreateUsersBatchParams) WithContext(ctx context.Context) *CreateUsersBatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create users batch params
+func (o *CreateUsersBatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create users batch params
+func (o *CreateUsersBatchParams) WithHTTPClient(client *http.Client) *CreateUsersBatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create users batch params
+func (o *CreateUsersBatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create users batch params
+func (o *CreateUsersBatchParams) WithBody(body *models.CreateUsersBatchRequest) *CreateUsersBatchParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create users batch params
+func (o *CreateUsersBatchParams) SetBody(body *models.CreateUsersBatchRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateUsersBatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,562 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// CreateUsersBatchReader is a Reader for the CreateUsersBatch structure.
+type CreateUsersBatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateUsersBatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewCreateUsersBatchOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewCreateUsersBatchBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewCreateUsersBatchNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCreateUsersBatchConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 415:
+		result := NewCreateUsersBatchUnsupportedMediaType()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewCreateUsersBatchUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewCreateUsersBatchInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /users:batch] CreateUsersBatch", response, response.Code())
+	}
+}
+
+// NewCreateUsersBatchOK creates a CreateUsersBatchOK with default headers values
+func NewCreateUsersBatchOK() *CreateUsersBatchOK {
+	return &CreateUsersBatchOK{}
+}
+
+/*
+CreateUsersBatchOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type CreateUsersBatchOK struct {
+	Payload *models.CreateUsersBatchResponse
+}
+
+// IsSuccess returns true when this create users batch o k response has a 2xx status code
+func (o *CreateUsersBatchOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create users batch o k response has a 3xx status code
+func (o *CreateUsersBatchOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch o k response has a 4xx status code
+func (o *CreateUsersBatchOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create users batch o k response has a 5xx status code
+func (o *CreateUsersBatchOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create users batch o k response a status code equal to that given
+func (o *CreateUsersBatchOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the create users batch o k response
+func (o *CreateUsersBatchOK) Code() int {
+	return 200
+}
+
+func (o *CreateUsersBatchOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchOK %s", 200, payload)
+}
+
+func (o *CreateUsersBatchOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchOK %s", 200, payload)
+}
+
+func (o *CreateUsersBatchOK) GetPayload() *models.CreateUsersBatchResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CreateUsersBatchResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUsersBatchBadRequest creates a CreateUsersBatchBadRequest with default headers values
+func NewCreateUsersBatchBadRequest() *CreateUsersBatchBadRequest {
+	return &CreateUsersBatchBadRequest{}
+}
+
+/*
+CreateUsersBatchBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
+*/
+type CreateUsersBatchBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create users batch bad request response has a 2xx status code
+func (o *CreateUsersBatchBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create users batch bad request response has a 3xx status code
+func (o *CreateUsersBatchBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch bad request response has a 4xx status code
+func (o *CreateUsersBatchBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create users batch bad request response has a 5xx status code
+func (o *CreateUsersBatchBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create users batch bad request response a status code equal to that given
+func (o *CreateUsersBatchBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the create users batch bad request response
+func (o *CreateUsersBatchBadRequest) Code() int {
+	return 400
+}
+
+func (o *CreateUsersBatchBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchBadRequest %s", 400, payload)
+}
+
+func (o *CreateUsersBatchBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchBadRequest %s", 400, payload)
+}
+
+func (o *CreateUsersBatchBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUsersBatchNotAcceptable creates a CreateUsersBatchNotAcceptable with default headers values
+func NewCreateUsersBatchNotAcceptable() *CreateUsersBatchNotAcceptable {
+	return &CreateUsersBatchNotAcceptable{}
+}
+
+/*
+CreateUsersBatchNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type CreateUsersBatchNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create users batch not acceptable response has a 2xx status code
+func (o *CreateUsersBatchNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create users batch not acceptable response has a 3xx status code
+func (o *CreateUsersBatchNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch not acceptable response has a 4xx status code
+func (o *CreateUsersBatchNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create users batch not acceptable response has a 5xx status code
+func (o *CreateUsersBatchNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create users batch not acceptable response a status code equal to that given
+func (o *CreateUsersBatchNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the create users batch not acceptable response
+func (o *CreateUsersBatchNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *CreateUsersBatchNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchNotAcceptable %s", 406, payload)
+}
+
+func (o *CreateUsersBatchNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchNotAcceptable %s", 406, payload)
+}
+
+func (o *CreateUsersBatchNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUsersBatchConflict creates a CreateUsersBatchConflict with default headers values
+func NewCreateUsersBatchConflict() *CreateUsersBatchConflict {
+	return &CreateUsersBatchConflict{}
+}
+
+/*
+CreateUsersBatchConflict describes a response with status code 409, with default header values.
+
+A name is already taken by another user or repeated in the batch (code 16); nothing was created
+*/
+type CreateUsersBatchConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create users batch conflict response has a 2xx status code
+func (o *CreateUsersBatchConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create users batch conflict response has a 3xx status code
+func (o *CreateUsersBatchConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch conflict response has a 4xx status code
+func (o *CreateUsersBatchConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create users batch conflict response has a 5xx status code
+func (o *CreateUsersBatchConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create users batch conflict response a status code equal to that given
+func (o *CreateUsersBatchConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the create users batch conflict response
+func (o *CreateUsersBatchConflict) Code() int {
+	return 409
+}
+
+func (o *CreateUsersBatchConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchConflict %s", 409, payload)
+}
+
+func (o *CreateUsersBatchConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchConflict %s", 409, payload)
+}
+
+func (o *CreateUsersBatchConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUsersBatchUnsupportedMediaType creates a CreateUsersBatchUnsupportedMediaType with default headers values
+func NewCreateUsersBatchUnsupportedMediaType() *CreateUsersBatchUnsupportedMediaType {
+	return &CreateUsersBatchUnsupportedMediaType{}
+}
+
+/*
+CreateUsersBatchUnsupportedMediaType describes a response with status code 415, with default header values.
+
+Unsupported Media Type (code 13)
+*/
+type CreateUsersBatchUnsupportedMediaType struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create users batch unsupported media type response has a 2xx status code
+func (o *CreateUsersBatchUnsupportedMediaType) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create users batch unsupported media type response has a 3xx status code
+func (o *CreateUsersBatchUnsupportedMediaType) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch unsupported media type response has a 4xx status code
+func (o *CreateUsersBatchUnsupportedMediaType) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create users batch unsupported media type response has a 5xx status code
+func (o *CreateUsersBatchUnsupportedMediaType) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create users batch unsupported media type response a status code equal to that given
+func (o *CreateUsersBatchUnsupportedMediaType) IsCode(code int) bool {
+	return code == 415
+}
+
+// Code gets the status code for the create users batch unsupported media type response
+func (o *CreateUsersBatchUnsupportedMediaType) Code() int {
+	return 415
+}
+
+func (o *CreateUsersBatchUnsupportedMediaType) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *CreateUsersBatchUnsupportedMediaType) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *CreateUsersBatchUnsupportedMediaType) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchUnsupportedMediaType) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUsersBatchUnprocessableEntity creates a CreateUsersBatchUnprocessableEntity with default headers values
+func NewCreateUsersBatchUnprocessableEntity() *CreateUsersBatchUnprocessableEntity {
+	return &CreateUsersBatchUnprocessableEntity{}
+}
+
+/*
+CreateUsersBatchUnprocessableEntity describes a response with status code 422, with default header values.
+
+Batch rolled back, nothing was created
+*/
+type CreateUsersBatchUnprocessableEntity struct {
+	Payload *models.CreateUsersBatchResponse
+}
+
+// IsSuccess returns true when this create users batch unprocessable entity response has a 2xx status code
+func (o *CreateUsersBatchUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create users batch unprocessable entity response has a 3xx status code
+func (o *CreateUsersBatchUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch unprocessable entity response has a 4xx status code
+func (o *CreateUsersBatchUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create users batch unprocessable entity response has a 5xx status code
+func (o *CreateUsersBatchUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create users batch unprocessable entity response a status code equal to that given
+func (o *CreateUsersBatchUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the create users batch unprocessable entity response
+func (o *CreateUsersBatchUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *CreateUsersBatchUnprocessableEntity) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchUnprocessableEntity %s", 422, payload)
+}
+
+func (o *CreateUsersBatchUnprocessableEntity) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchUnprocessableEntity %s", 422, payload)
+}
+
+func (o *CreateUsersBatchUnprocessableEntity) GetPayload() *models.CreateUsersBatchResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CreateUsersBatchResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateUsersBatchInternalServerError creates a CreateUsersBatchInternalServerError with default headers values
+func NewCreateUsersBatchInternalServerError() *CreateUsersBatchInternalServerError {
+	return &CreateUsersBatchInternalServerError{}
+}
+
+/*
+CreateUsersBatchInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type CreateUsersBatchInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create users batch internal server error response has a 2xx status code
+func (o *CreateUsersBatchInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create users batch internal server error response has a 3xx status code
+func (o *CreateUsersBatchInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create users batch internal server error response has a 4xx status code
+func (o *CreateUsersBatchInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create users batch internal server error response has a 5xx status code
+func (o *CreateUsersBatchInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this create users batch internal server error response a status code equal to that given
+func (o *CreateUsersBatchInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the create users batch internal server error response
+func (o *CreateUsersBatchInternalServerError) Code() int {
+	return 500
+}
+
+func (o *CreateUsersBatchInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchInternalServerError %s", 500, payload)
+}
+
+func (o *CreateUsersBatchInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users:batch][%d] createUsersBatchInternalServerError %s", 500, payload)
+}
+
+func (o *CreateUsersBatchInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateUsersBatchInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
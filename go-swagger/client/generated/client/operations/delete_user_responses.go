@@ -0,0 +1,472 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// DeleteUserReader is a Reader for the DeleteUser structure.
+type DeleteUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteUserNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewDeleteUserBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewDeleteUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewDeleteUserNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewDeleteUserGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewDeleteUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[DELETE /users/{id}] DeleteUser", response, response.Code())
+	}
+}
+
+// NewDeleteUserNoContent creates a DeleteUserNoContent with default headers values
+func NewDeleteUserNoContent() *DeleteUserNoContent {
+	return &DeleteUserNoContent{}
+}
+
+/*
+DeleteUserNoContent describes a response with status code 204, with default header values.
+
+No Content
+*/
+type DeleteUserNoContent struct {
+}
+
+// IsSuccess returns true when this delete user no content response has a 2xx status code
+func (o *DeleteUserNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this delete user no content response has a 3xx status code
+func (o *DeleteUserNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user no content response has a 4xx status code
+func (o *DeleteUserNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete user no content response has a 5xx status code
+func (o *DeleteUserNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user no content response a status code equal to that given
+func (o *DeleteUserNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the delete user no content response
+func (o *DeleteUserNoContent) Code() int {
+	return 204
+}
+
+func (o *DeleteUserNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserNoContent", 204)
+}
+
+func (o *DeleteUserNoContent) String() string {
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserNoContent", 204)
+}
+
+func (o *DeleteUserNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteUserBadRequest creates a DeleteUserBadRequest with default headers values
+func NewDeleteUserBadRequest() *DeleteUserBadRequest {
+	return &DeleteUserBadRequest{}
+}
+
+/*
+DeleteUserBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 9 - invalid parameter)
+*/
+type DeleteUserBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete user bad request response has a 2xx status code
+func (o *DeleteUserBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user bad request response has a 3xx status code
+func (o *DeleteUserBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user bad request response has a 4xx status code
+func (o *DeleteUserBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user bad request response has a 5xx status code
+func (o *DeleteUserBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user bad request response a status code equal to that given
+func (o *DeleteUserBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the delete user bad request response
+func (o *DeleteUserBadRequest) Code() int {
+	return 400
+}
+
+func (o *DeleteUserBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserBadRequest %s", 400, payload)
+}
+
+func (o *DeleteUserBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserBadRequest %s", 400, payload)
+}
+
+func (o *DeleteUserBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteUserBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserNotFound creates a DeleteUserNotFound with default headers values
+func NewDeleteUserNotFound() *DeleteUserNotFound {
+	return &DeleteUserNotFound{}
+}
+
+/*
+DeleteUserNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type DeleteUserNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete user not found response has a 2xx status code
+func (o *DeleteUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user not found response has a 3xx status code
+func (o *DeleteUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user not found response has a 4xx status code
+func (o *DeleteUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user not found response has a 5xx status code
+func (o *DeleteUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user not found response a status code equal to that given
+func (o *DeleteUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the delete user not found response
+func (o *DeleteUserNotFound) Code() int {
+	return 404
+}
+
+func (o *DeleteUserNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserNotFound %s", 404, payload)
+}
+
+func (o *DeleteUserNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserNotFound %s", 404, payload)
+}
+
+func (o *DeleteUserNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserNotAcceptable creates a DeleteUserNotAcceptable with default headers values
+func NewDeleteUserNotAcceptable() *DeleteUserNotAcceptable {
+	return &DeleteUserNotAcceptable{}
+}
+
+/*
+DeleteUserNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type DeleteUserNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete user not acceptable response has a 2xx status code
+func (o *DeleteUserNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user not acceptable response has a 3xx status code
+func (o *DeleteUserNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user not acceptable response has a 4xx status code
+func (o *DeleteUserNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user not acceptable response has a 5xx status code
+func (o *DeleteUserNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user not acceptable response a status code equal to that given
+func (o *DeleteUserNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the delete user not acceptable response
+func (o *DeleteUserNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *DeleteUserNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserNotAcceptable %s", 406, payload)
+}
+
+func (o *DeleteUserNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserNotAcceptable %s", 406, payload)
+}
+
+func (o *DeleteUserNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteUserNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserGone creates a DeleteUserGone with default headers values
+func NewDeleteUserGone() *DeleteUserGone {
+	return &DeleteUserGone{}
+}
+
+/*
+DeleteUserGone describes a response with status code 410, with default header values.
+
+User has been deleted (code 410)
+*/
+type DeleteUserGone struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete user gone response has a 2xx status code
+func (o *DeleteUserGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user gone response has a 3xx status code
+func (o *DeleteUserGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user gone response has a 4xx status code
+func (o *DeleteUserGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this delete user gone response has a 5xx status code
+func (o *DeleteUserGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this delete user gone response a status code equal to that given
+func (o *DeleteUserGone) IsCode(code int) bool {
+	return code == 410
+}
+
+// Code gets the status code for the delete user gone response
+func (o *DeleteUserGone) Code() int {
+	return 410
+}
+
+func (o *DeleteUserGone) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserGone %s", 410, payload)
+}
+
+func (o *DeleteUserGone) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserGone %s", 410, payload)
+}
+
+func (o *DeleteUserGone) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteUserGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteUserInternalServerError creates a DeleteUserInternalServerError with default headers values
+func NewDeleteUserInternalServerError() *DeleteUserInternalServerError {
+	return &DeleteUserInternalServerError{}
+}
+
+/*
+DeleteUserInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type DeleteUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this delete user internal server error response has a 2xx status code
+func (o *DeleteUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this delete user internal server error response has a 3xx status code
+func (o *DeleteUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this delete user internal server error response has a 4xx status code
+func (o *DeleteUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this delete user internal server error response has a 5xx status code
+func (o *DeleteUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this delete user internal server error response a status code equal to that given
+func (o *DeleteUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the delete user internal server error response
+func (o *DeleteUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *DeleteUserInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserInternalServerError %s", 500, payload)
+}
+
+func (o *DeleteUserInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[DELETE /users/{id}][%d] deleteUserInternalServerError %s", 500, payload)
+}
+
+func (o *DeleteUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -62,6 +62,12 @@ GetUserByIDParams contains all the parameters to send to the API endpoint
 */
 type GetUserByIDParams struct {
 
+	/* IfNoneMatch.
+
+	   ETags known to the client; if the current one is among them, 304 is returned
+	*/
+	IfNoneMatch *string
+
 	// ID.
 	ID int64
 
@@ -118,6 +124,17 @@ func (o *GetUserByIDParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfNoneMatch adds the ifNoneMatch to the get user by Id params
+func (o *GetUserByIDParams) WithIfNoneMatch(ifNoneMatch *string) *GetUserByIDParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the get user by Id params
+func (o *GetUserByIDParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
 // WithID adds the id to the get user by Id params
 func (o *GetUserByIDParams) WithID(id int64) *GetUserByIDParams {
 	o.SetID(id)
@@ -137,6 +154,14 @@ func (o *GetUserByIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
 		return err
@@ -30,12 +30,36 @@ func (o *GetUserByIDReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return result, nil
+	case 304:
+		result := NewGetUserByIDNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 400:
+		result := NewGetUserByIDBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetUserByIDNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 406:
+		result := NewGetUserByIDNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewGetUserByIDGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewGetUserByIDInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -58,6 +82,11 @@ GetUserByIDOK describes a response with status code 200, with default header val
 OK
 */
 type GetUserByIDOK struct {
+
+	/* Current version of the user
+	 */
+	ETag string
+
 	Payload *models.GetUserByIDResponse
 }
 
@@ -107,6 +136,13 @@ func (o *GetUserByIDOK) GetPayload() *models.GetUserByIDResponse {
 
 func (o *GetUserByIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.GetUserByIDResponse)
 
 	// response payload
@@ -117,6 +153,143 @@ func (o *GetUserByIDOK) readResponse(response runtime.ClientResponse, consumer r
 	return nil
 }
 
+// NewGetUserByIDNotModified creates a GetUserByIDNotModified with default headers values
+func NewGetUserByIDNotModified() *GetUserByIDNotModified {
+	return &GetUserByIDNotModified{}
+}
+
+/*
+GetUserByIDNotModified describes a response with status code 304, with default header values.
+
+Not Modified
+*/
+type GetUserByIDNotModified struct {
+
+	/* Current version of the user
+	 */
+	ETag string
+}
+
+// IsSuccess returns true when this get user by Id not modified response has a 2xx status code
+func (o *GetUserByIDNotModified) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user by Id not modified response has a 3xx status code
+func (o *GetUserByIDNotModified) IsRedirect() bool {
+	return true
+}
+
+// IsClientError returns true when this get user by Id not modified response has a 4xx status code
+func (o *GetUserByIDNotModified) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get user by Id not modified response has a 5xx status code
+func (o *GetUserByIDNotModified) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user by Id not modified response a status code equal to that given
+func (o *GetUserByIDNotModified) IsCode(code int) bool {
+	return code == 304
+}
+
+// Code gets the status code for the get user by Id not modified response
+func (o *GetUserByIDNotModified) Code() int {
+	return 304
+}
+
+func (o *GetUserByIDNotModified) Error() string {
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdNotModified", 304)
+}
+
+func (o *GetUserByIDNotModified) String() string {
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdNotModified", 304)
+}
+
+func (o *GetUserByIDNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	return nil
+}
+
+// NewGetUserByIDBadRequest creates a GetUserByIDBadRequest with default headers values
+func NewGetUserByIDBadRequest() *GetUserByIDBadRequest {
+	return &GetUserByIDBadRequest{}
+}
+
+/*
+GetUserByIDBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 9 - invalid parameter)
+*/
+type GetUserByIDBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user by Id bad request response has a 2xx status code
+func (o *GetUserByIDBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user by Id bad request response has a 3xx status code
+func (o *GetUserByIDBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user by Id bad request response has a 4xx status code
+func (o *GetUserByIDBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user by Id bad request response has a 5xx status code
+func (o *GetUserByIDBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user by Id bad request response a status code equal to that given
+func (o *GetUserByIDBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get user by Id bad request response
+func (o *GetUserByIDBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetUserByIDBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdBadRequest %s", 400, payload)
+}
+
+func (o *GetUserByIDBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdBadRequest %s", 400, payload)
+}
+
+func (o *GetUserByIDBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserByIDBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetUserByIDNotFound creates a GetUserByIDNotFound with default headers values
 func NewGetUserByIDNotFound() *GetUserByIDNotFound {
 	return &GetUserByIDNotFound{}
@@ -187,6 +360,146 @@ func (o *GetUserByIDNotFound) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewGetUserByIDNotAcceptable creates a GetUserByIDNotAcceptable with default headers values
+func NewGetUserByIDNotAcceptable() *GetUserByIDNotAcceptable {
+	return &GetUserByIDNotAcceptable{}
+}
+
+/*
+GetUserByIDNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type GetUserByIDNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user by Id not acceptable response has a 2xx status code
+func (o *GetUserByIDNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user by Id not acceptable response has a 3xx status code
+func (o *GetUserByIDNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user by Id not acceptable response has a 4xx status code
+func (o *GetUserByIDNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user by Id not acceptable response has a 5xx status code
+func (o *GetUserByIDNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user by Id not acceptable response a status code equal to that given
+func (o *GetUserByIDNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the get user by Id not acceptable response
+func (o *GetUserByIDNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *GetUserByIDNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdNotAcceptable %s", 406, payload)
+}
+
+func (o *GetUserByIDNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdNotAcceptable %s", 406, payload)
+}
+
+func (o *GetUserByIDNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserByIDNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserByIDGone creates a GetUserByIDGone with default headers values
+func NewGetUserByIDGone() *GetUserByIDGone {
+	return &GetUserByIDGone{}
+}
+
+/*
+GetUserByIDGone describes a response with status code 410, with default header values.
+
+User has been deleted (code 410)
+*/
+type GetUserByIDGone struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user by Id gone response has a 2xx status code
+func (o *GetUserByIDGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user by Id gone response has a 3xx status code
+func (o *GetUserByIDGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user by Id gone response has a 4xx status code
+func (o *GetUserByIDGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user by Id gone response has a 5xx status code
+func (o *GetUserByIDGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user by Id gone response a status code equal to that given
+func (o *GetUserByIDGone) IsCode(code int) bool {
+	return code == 410
+}
+
+// Code gets the status code for the get user by Id gone response
+func (o *GetUserByIDGone) Code() int {
+	return 410
+}
+
+func (o *GetUserByIDGone) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdGone %s", 410, payload)
+}
+
+func (o *GetUserByIDGone) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}][%d] getUserByIdGone %s", 410, payload)
+}
+
+func (o *GetUserByIDGone) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserByIDGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetUserByIDInternalServerError creates a GetUserByIDInternalServerError with default headers values
 func NewGetUserByIDInternalServerError() *GetUserByIDInternalServerError {
 	return &GetUserByIDInternalServerError{}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetUserHistoryParams creates a new GetUserHistoryParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetUserHistoryParams() *GetUserHistoryParams {
+	return &GetUserHistoryParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetUserHistoryParamsWithTimeout creates a new GetUserHistoryParams object
+// with the ability to set a timeout on a request.
+func NewGetUserHistoryParamsWithTimeout(timeout time.Duration) *GetUserHistoryParams {
+	return &GetUserHistoryParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetUserHistoryParamsWithContext creates a new GetUserHistoryParams object
+// with the ability to set a context for a request.
+func NewGetUserHistoryParamsWithContext(ctx context.Context) *GetUserHistoryParams {
+	return &GetUserHistoryParams{
+		Context: ctx,
+	}
+}
+
+// NewGetUserHistoryParamsWithHTTPClient creates a new GetUserHistoryParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetUserHistoryParamsWithHTTPClient(client *http.Client) *GetUserHistoryParams {
+	return &GetUserHistoryParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GetUserHistoryParams contains all the parameters to send to the API endpoint
+
+	for the get user history operation.
+
+	Typically these are written to a http.Request.
+*/
+type GetUserHistoryParams struct {
+
+	// ID.
+	ID int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get user history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetUserHistoryParams) WithDefaults() *GetUserHistoryParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get user history params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetUserHistoryParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get user history params
+func (o *GetUserHistoryParams) WithTimeout(timeout time.Duration) *GetUserHistoryParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get user history params
+func (o *GetUserHistoryParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get user history params
+func (o *GetUserHistoryParams) WithContext(ctx context.Context) *GetUserHistoryParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get user history params
+func (o *GetUserHistoryParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get user history params
+func (o *GetUserHistoryParams) WithHTTPClient(client *http.Client) *GetUserHistoryParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get user history params
+func (o *GetUserHistoryParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get user history params
+func (o *GetUserHistoryParams) WithID(id int64) *GetUserHistoryParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get user history params
+func (o *GetUserHistoryParams) SetID(id int64) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetUserHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,410 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// GetUserHistoryReader is a Reader for the GetUserHistory structure.
+type GetUserHistoryReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetUserHistoryReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetUserHistoryOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewGetUserHistoryBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewGetUserHistoryNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewGetUserHistoryNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGetUserHistoryInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /users/{id}/history] GetUserHistory", response, response.Code())
+	}
+}
+
+// NewGetUserHistoryOK creates a GetUserHistoryOK with default headers values
+func NewGetUserHistoryOK() *GetUserHistoryOK {
+	return &GetUserHistoryOK{}
+}
+
+/*
+GetUserHistoryOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetUserHistoryOK struct {
+	Payload *models.UserHistoryResponse
+}
+
+// IsSuccess returns true when this get user history o k response has a 2xx status code
+func (o *GetUserHistoryOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this get user history o k response has a 3xx status code
+func (o *GetUserHistoryOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user history o k response has a 4xx status code
+func (o *GetUserHistoryOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get user history o k response has a 5xx status code
+func (o *GetUserHistoryOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user history o k response a status code equal to that given
+func (o *GetUserHistoryOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the get user history o k response
+func (o *GetUserHistoryOK) Code() int {
+	return 200
+}
+
+func (o *GetUserHistoryOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryOK %s", 200, payload)
+}
+
+func (o *GetUserHistoryOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryOK %s", 200, payload)
+}
+
+func (o *GetUserHistoryOK) GetPayload() *models.UserHistoryResponse {
+	return o.Payload
+}
+
+func (o *GetUserHistoryOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UserHistoryResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserHistoryBadRequest creates a GetUserHistoryBadRequest with default headers values
+func NewGetUserHistoryBadRequest() *GetUserHistoryBadRequest {
+	return &GetUserHistoryBadRequest{}
+}
+
+/*
+GetUserHistoryBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 9 - invalid parameter)
+*/
+type GetUserHistoryBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user history bad request response has a 2xx status code
+func (o *GetUserHistoryBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user history bad request response has a 3xx status code
+func (o *GetUserHistoryBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user history bad request response has a 4xx status code
+func (o *GetUserHistoryBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user history bad request response has a 5xx status code
+func (o *GetUserHistoryBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user history bad request response a status code equal to that given
+func (o *GetUserHistoryBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the get user history bad request response
+func (o *GetUserHistoryBadRequest) Code() int {
+	return 400
+}
+
+func (o *GetUserHistoryBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryBadRequest %s", 400, payload)
+}
+
+func (o *GetUserHistoryBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryBadRequest %s", 400, payload)
+}
+
+func (o *GetUserHistoryBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserHistoryBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserHistoryNotFound creates a GetUserHistoryNotFound with default headers values
+func NewGetUserHistoryNotFound() *GetUserHistoryNotFound {
+	return &GetUserHistoryNotFound{}
+}
+
+/*
+GetUserHistoryNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type GetUserHistoryNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user history not found response has a 2xx status code
+func (o *GetUserHistoryNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user history not found response has a 3xx status code
+func (o *GetUserHistoryNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user history not found response has a 4xx status code
+func (o *GetUserHistoryNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user history not found response has a 5xx status code
+func (o *GetUserHistoryNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user history not found response a status code equal to that given
+func (o *GetUserHistoryNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the get user history not found response
+func (o *GetUserHistoryNotFound) Code() int {
+	return 404
+}
+
+func (o *GetUserHistoryNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryNotFound %s", 404, payload)
+}
+
+func (o *GetUserHistoryNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryNotFound %s", 404, payload)
+}
+
+func (o *GetUserHistoryNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserHistoryNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserHistoryNotAcceptable creates a GetUserHistoryNotAcceptable with default headers values
+func NewGetUserHistoryNotAcceptable() *GetUserHistoryNotAcceptable {
+	return &GetUserHistoryNotAcceptable{}
+}
+
+/*
+GetUserHistoryNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type GetUserHistoryNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user history not acceptable response has a 2xx status code
+func (o *GetUserHistoryNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user history not acceptable response has a 3xx status code
+func (o *GetUserHistoryNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user history not acceptable response has a 4xx status code
+func (o *GetUserHistoryNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get user history not acceptable response has a 5xx status code
+func (o *GetUserHistoryNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get user history not acceptable response a status code equal to that given
+func (o *GetUserHistoryNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the get user history not acceptable response
+func (o *GetUserHistoryNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *GetUserHistoryNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryNotAcceptable %s", 406, payload)
+}
+
+func (o *GetUserHistoryNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryNotAcceptable %s", 406, payload)
+}
+
+func (o *GetUserHistoryNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserHistoryNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserHistoryInternalServerError creates a GetUserHistoryInternalServerError with default headers values
+func NewGetUserHistoryInternalServerError() *GetUserHistoryInternalServerError {
+	return &GetUserHistoryInternalServerError{}
+}
+
+/*
+GetUserHistoryInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type GetUserHistoryInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get user history internal server error response has a 2xx status code
+func (o *GetUserHistoryInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get user history internal server error response has a 3xx status code
+func (o *GetUserHistoryInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get user history internal server error response has a 4xx status code
+func (o *GetUserHistoryInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this get user history internal server error response has a 5xx status code
+func (o *GetUserHistoryInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this get user history internal server error response a status code equal to that given
+func (o *GetUserHistoryInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the get user history internal server error response
+func (o *GetUserHistoryInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GetUserHistoryInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryInternalServerError %s", 500, payload)
+}
+
+func (o *GetUserHistoryInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users/{id}/history][%d] getUserHistoryInternalServerError %s", 500, payload)
+}
+
+func (o *GetUserHistoryInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetUserHistoryInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
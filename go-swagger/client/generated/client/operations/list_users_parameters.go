@@ -0,0 +1,360 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListUsersParams creates a new ListUsersParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListUsersParams() *ListUsersParams {
+	return &ListUsersParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListUsersParamsWithTimeout creates a new ListUsersParams object
+// with the ability to set a timeout on a request.
+func NewListUsersParamsWithTimeout(timeout time.Duration) *ListUsersParams {
+	return &ListUsersParams{
+		timeout: timeout,
+	}
+}
+
+// NewListUsersParamsWithContext creates a new ListUsersParams object
+// with the ability to set a context for a request.
+func NewListUsersParamsWithContext(ctx context.Context) *ListUsersParams {
+	return &ListUsersParams{
+		Context: ctx,
+	}
+}
+
+// NewListUsersParamsWithHTTPClient creates a new ListUsersParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListUsersParamsWithHTTPClient(client *http.Client) *ListUsersParams {
+	return &ListUsersParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ListUsersParams contains all the parameters to send to the API endpoint
+
+	for the list users operation.
+
+	Typically these are written to a http.Request.
+*/
+type ListUsersParams struct {
+
+	/* CreatedAfter.
+
+	   Only users created strictly after this moment
+
+	   Format: date-time
+	*/
+	CreatedAfter *strfmt.DateTime
+
+	/* Cursor.
+
+	   Opaque cursor from next_cursor of the previous page
+	*/
+	Cursor *string
+
+	/* IncludeDeleted.
+
+	   Also return deleted users; they have deleted_at set. Requires the operator token in X-Debug-Token, otherwise the request fails with 403 (code 15)
+	*/
+	IncludeDeleted *bool
+
+	// Limit.
+	//
+	// Default: 20
+	Limit *int64
+
+	/* NamePrefix.
+
+	   Only users whose name starts with this prefix (case sensitive)
+	*/
+	NamePrefix *string
+
+	/* Sort.
+
+	   Comma separated sort fields (id, name, created_at), "-" prefix means descending, e.g. sort=name,-id. Unknown or duplicate fields are rejected with code 4. The cursor is bound to the sort it was issued for.
+	*/
+	Sort []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list users params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUsersParams) WithDefaults() *ListUsersParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list users params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUsersParams) SetDefaults() {
+	var (
+		includeDeletedDefault = bool(false)
+
+		limitDefault = int64(20)
+	)
+
+	val := ListUsersParams{
+		IncludeDeleted: &includeDeletedDefault,
+		Limit:          &limitDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the list users params
+func (o *ListUsersParams) WithTimeout(timeout time.Duration) *ListUsersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list users params
+func (o *ListUsersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list users params
+func (o *ListUsersParams) WithContext(ctx context.Context) *ListUsersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list users params
+func (o *ListUsersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list users params
+func (o *ListUsersParams) WithHTTPClient(client *http.Client) *ListUsersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list users params
+func (o *ListUsersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCreatedAfter adds the createdAfter to the list users params
+func (o *ListUsersParams) WithCreatedAfter(createdAfter *strfmt.DateTime) *ListUsersParams {
+	o.SetCreatedAfter(createdAfter)
+	return o
+}
+
+// SetCreatedAfter adds the createdAfter to the list users params
+func (o *ListUsersParams) SetCreatedAfter(createdAfter *strfmt.DateTime) {
+	o.CreatedAfter = createdAfter
+}
+
+// WithCursor adds the cursor to the list users params
+func (o *ListUsersParams) WithCursor(cursor *string) *ListUsersParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list users params
+func (o *ListUsersParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithIncludeDeleted adds the includeDeleted to the list users params
+func (o *ListUsersParams) WithIncludeDeleted(includeDeleted *bool) *ListUsersParams {
+	o.SetIncludeDeleted(includeDeleted)
+	return o
+}
+
+// SetIncludeDeleted adds the includeDeleted to the list users params
+func (o *ListUsersParams) SetIncludeDeleted(includeDeleted *bool) {
+	o.IncludeDeleted = includeDeleted
+}
+
+// WithLimit adds the limit to the list users params
+func (o *ListUsersParams) WithLimit(limit *int64) *ListUsersParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list users params
+func (o *ListUsersParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithNamePrefix adds the namePrefix to the list users params
+func (o *ListUsersParams) WithNamePrefix(namePrefix *string) *ListUsersParams {
+	o.SetNamePrefix(namePrefix)
+	return o
+}
+
+// SetNamePrefix adds the namePrefix to the list users params
+func (o *ListUsersParams) SetNamePrefix(namePrefix *string) {
+	o.NamePrefix = namePrefix
+}
+
+// WithSort adds the sort to the list users params
+func (o *ListUsersParams) WithSort(sort []string) *ListUsersParams {
+	o.SetSort(sort)
+	return o
+}
+
+// SetSort adds the sort to the list users params
+func (o *ListUsersParams) SetSort(sort []string) {
+	o.Sort = sort
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListUsersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.CreatedAfter != nil {
+
+		// query param created_after
+		var qrCreatedAfter strfmt.DateTime
+
+		if o.CreatedAfter != nil {
+			qrCreatedAfter = *o.CreatedAfter
+		}
+		qCreatedAfter := qrCreatedAfter.String()
+		if qCreatedAfter != "" {
+
+			if err := r.SetQueryParam("created_after", qCreatedAfter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.IncludeDeleted != nil {
+
+		// query param include_deleted
+		var qrIncludeDeleted bool
+
+		if o.IncludeDeleted != nil {
+			qrIncludeDeleted = *o.IncludeDeleted
+		}
+		qIncludeDeleted := swag.FormatBool(qrIncludeDeleted)
+		if qIncludeDeleted != "" {
+
+			if err := r.SetQueryParam("include_deleted", qIncludeDeleted); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.NamePrefix != nil {
+
+		// query param name_prefix
+		var qrNamePrefix string
+
+		if o.NamePrefix != nil {
+			qrNamePrefix = *o.NamePrefix
+		}
+		qNamePrefix := qrNamePrefix
+		if qNamePrefix != "" {
+
+			if err := r.SetQueryParam("name_prefix", qNamePrefix); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Sort != nil {
+
+		// binding items for sort
+		joinedSort := o.bindParamSort(reg)
+
+		// query array param sort
+		if err := r.SetQueryParam("sort", joinedSort...); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamListUsers binds the parameter sort
+func (o *ListUsersParams) bindParamSort(formats strfmt.Registry) []string {
+	sortIR := o.Sort
+
+	var sortIC []string
+	for _, sortIIR := range sortIR { // explode []string
+
+		sortIIV := sortIIR // string as string
+		sortIC = append(sortIC, sortIIV)
+	}
+
+	// items.CollectionFormat: "csv"
+	sortIS := swag.JoinByFormat(sortIC, "csv")
+
+	return sortIS
+}
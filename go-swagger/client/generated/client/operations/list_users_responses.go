@@ -0,0 +1,410 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// ListUsersReader is a Reader for the ListUsers structure.
+type ListUsersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListUsersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListUsersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewListUsersBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListUsersForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewListUsersNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewListUsersInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[GET /users] ListUsers", response, response.Code())
+	}
+}
+
+// NewListUsersOK creates a ListUsersOK with default headers values
+func NewListUsersOK() *ListUsersOK {
+	return &ListUsersOK{}
+}
+
+/*
+ListUsersOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListUsersOK struct {
+	Payload *models.ListUsersResponse
+}
+
+// IsSuccess returns true when this list users o k response has a 2xx status code
+func (o *ListUsersOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this list users o k response has a 3xx status code
+func (o *ListUsersOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list users o k response has a 4xx status code
+func (o *ListUsersOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list users o k response has a 5xx status code
+func (o *ListUsersOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list users o k response a status code equal to that given
+func (o *ListUsersOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the list users o k response
+func (o *ListUsersOK) Code() int {
+	return 200
+}
+
+func (o *ListUsersOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersOK %s", 200, payload)
+}
+
+func (o *ListUsersOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersOK %s", 200, payload)
+}
+
+func (o *ListUsersOK) GetPayload() *models.ListUsersResponse {
+	return o.Payload
+}
+
+func (o *ListUsersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ListUsersResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsersBadRequest creates a ListUsersBadRequest with default headers values
+func NewListUsersBadRequest() *ListUsersBadRequest {
+	return &ListUsersBadRequest{}
+}
+
+/*
+ListUsersBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 3 - validation error, code 4 - invalid sort, code 9 - invalid parameter)
+*/
+type ListUsersBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list users bad request response has a 2xx status code
+func (o *ListUsersBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list users bad request response has a 3xx status code
+func (o *ListUsersBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list users bad request response has a 4xx status code
+func (o *ListUsersBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list users bad request response has a 5xx status code
+func (o *ListUsersBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list users bad request response a status code equal to that given
+func (o *ListUsersBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the list users bad request response
+func (o *ListUsersBadRequest) Code() int {
+	return 400
+}
+
+func (o *ListUsersBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersBadRequest %s", 400, payload)
+}
+
+func (o *ListUsersBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersBadRequest %s", 400, payload)
+}
+
+func (o *ListUsersBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListUsersBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsersForbidden creates a ListUsersForbidden with default headers values
+func NewListUsersForbidden() *ListUsersForbidden {
+	return &ListUsersForbidden{}
+}
+
+/*
+ListUsersForbidden describes a response with status code 403, with default header values.
+
+Forbidden (code 15 - include_deleted without the operator token)
+*/
+type ListUsersForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list users forbidden response has a 2xx status code
+func (o *ListUsersForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list users forbidden response has a 3xx status code
+func (o *ListUsersForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list users forbidden response has a 4xx status code
+func (o *ListUsersForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list users forbidden response has a 5xx status code
+func (o *ListUsersForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list users forbidden response a status code equal to that given
+func (o *ListUsersForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the list users forbidden response
+func (o *ListUsersForbidden) Code() int {
+	return 403
+}
+
+func (o *ListUsersForbidden) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersForbidden %s", 403, payload)
+}
+
+func (o *ListUsersForbidden) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersForbidden %s", 403, payload)
+}
+
+func (o *ListUsersForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListUsersForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsersNotAcceptable creates a ListUsersNotAcceptable with default headers values
+func NewListUsersNotAcceptable() *ListUsersNotAcceptable {
+	return &ListUsersNotAcceptable{}
+}
+
+/*
+ListUsersNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type ListUsersNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list users not acceptable response has a 2xx status code
+func (o *ListUsersNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list users not acceptable response has a 3xx status code
+func (o *ListUsersNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list users not acceptable response has a 4xx status code
+func (o *ListUsersNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list users not acceptable response has a 5xx status code
+func (o *ListUsersNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list users not acceptable response a status code equal to that given
+func (o *ListUsersNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the list users not acceptable response
+func (o *ListUsersNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *ListUsersNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersNotAcceptable %s", 406, payload)
+}
+
+func (o *ListUsersNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersNotAcceptable %s", 406, payload)
+}
+
+func (o *ListUsersNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListUsersNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsersInternalServerError creates a ListUsersInternalServerError with default headers values
+func NewListUsersInternalServerError() *ListUsersInternalServerError {
+	return &ListUsersInternalServerError{}
+}
+
+/*
+ListUsersInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type ListUsersInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list users internal server error response has a 2xx status code
+func (o *ListUsersInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list users internal server error response has a 3xx status code
+func (o *ListUsersInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list users internal server error response has a 4xx status code
+func (o *ListUsersInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this list users internal server error response has a 5xx status code
+func (o *ListUsersInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this list users internal server error response a status code equal to that given
+func (o *ListUsersInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the list users internal server error response
+func (o *ListUsersInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ListUsersInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersInternalServerError %s", 500, payload)
+}
+
+func (o *ListUsersInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[GET /users][%d] listUsersInternalServerError %s", 500, payload)
+}
+
+func (o *ListUsersInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListUsersInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
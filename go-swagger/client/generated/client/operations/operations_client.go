@@ -54,12 +54,50 @@ type Client struct {
 // ClientOption may be used to customize the behavior of Client methods.
 type ClientOption func(*runtime.ClientOperation)
 
+// This client is generated with a few options you might find useful for your swagger spec.
+//
+// Feel free to add you own set of options.
+
+// WithAccept allows the client to force the Accept header
+// to negotiate a specific Producer from the server.
+//
+// You may use this option to set arbitrary extensions to your MIME media type.
+func WithAccept(mime string) ClientOption {
+	return func(r *runtime.ClientOperation) {
+		r.ProducesMediaTypes = []string{mime}
+	}
+}
+
+// WithAcceptApplicationJSON sets the Accept header to "application/json".
+func WithAcceptApplicationJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/json"}
+}
+
+// WithAcceptApplicationProblemJSON sets the Accept header to "application/problem+json".
+func WithAcceptApplicationProblemJSON(r *runtime.ClientOperation) {
+	r.ProducesMediaTypes = []string{"application/problem+json"}
+}
+
 // ClientService is the interface for Client methods
 type ClientService interface {
 	CreateUser(params *CreateUserParams, opts ...ClientOption) (*CreateUserCreated, error)
 
+	CreateUsersBatch(params *CreateUsersBatchParams, opts ...ClientOption) (*CreateUsersBatchOK, error)
+
+	DeleteUser(params *DeleteUserParams, opts ...ClientOption) (*DeleteUserNoContent, error)
+
 	GetUserByID(params *GetUserByIDParams, opts ...ClientOption) (*GetUserByIDOK, error)
 
+	GetUserHistory(params *GetUserHistoryParams, opts ...ClientOption) (*GetUserHistoryOK, error)
+
+	ListUsers(params *ListUsersParams, opts ...ClientOption) (*ListUsersOK, error)
+
+	PatchUser(params *PatchUserParams, opts ...ClientOption) (*PatchUserOK, error)
+
+	RestoreUser(params *RestoreUserParams, opts ...ClientOption) (*RestoreUserOK, error)
+
+	UpdateUser(params *UpdateUserParams, opts ...ClientOption) (*UpdateUserOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -75,7 +113,7 @@ func (a *Client) CreateUser(params *CreateUserParams, opts ...ClientOption) (*Cr
 		ID:                 "CreateUser",
 		Method:             "POST",
 		PathPattern:        "/users",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
@@ -101,6 +139,86 @@ func (a *Client) CreateUser(params *CreateUserParams, opts ...ClientOption) (*Cr
 	panic(msg)
 }
 
+/*
+CreateUsersBatch creates several users at once
+
+Every item gets its own result: either the id of the created user or an error. Without allOrNothing valid items are created even if others fail validation. With allOrNothing nothing is created if any item fails, and the response is 422 with code 5 for the items that were valid.
+*/
+func (a *Client) CreateUsersBatch(params *CreateUsersBatchParams, opts ...ClientOption) (*CreateUsersBatchOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateUsersBatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "CreateUsersBatch",
+		Method:             "POST",
+		PathPattern:        "/users:batch",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CreateUsersBatchReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateUsersBatchOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for CreateUsersBatch: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteUser deletes user
+
+Marks the user as deleted. The user stays in storage as a tombstone: GetUserById answers 410, ListUsers shows it only with include_deleted, and RestoreUser brings it back.
+*/
+func (a *Client) DeleteUser(params *DeleteUserParams, opts ...ClientOption) (*DeleteUserNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DeleteUser",
+		Method:             "DELETE",
+		PathPattern:        "/users/{id}",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteUserReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteUserNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for DeleteUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 GetUserByID gets user by ID
 */
@@ -113,7 +231,7 @@ func (a *Client) GetUserByID(params *GetUserByIDParams, opts ...ClientOption) (*
 		ID:                 "GetUserById",
 		Method:             "GET",
 		PathPattern:        "/users/{id}",
-		ProducesMediaTypes: []string{"application/json"},
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
@@ -139,6 +257,204 @@ func (a *Client) GetUserByID(params *GetUserByIDParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+	GetUserHistory gets user change history
+
+	Entries of the audit log for the user, oldest first. Every entry is hash-chained to the previous
+
+entry of the whole log, so history of deleted users is returned as well. A change is saved before
+it is logged: if the log write fails, the request still succeeds, the failure is recorded as a
+server-side incident and the change is missing from the history.
+*/
+func (a *Client) GetUserHistory(params *GetUserHistoryParams, opts ...ClientOption) (*GetUserHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetUserHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetUserHistory",
+		Method:             "GET",
+		PathPattern:        "/users/{id}/history",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetUserHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetUserHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetUserHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ListUsers lists users
+*/
+func (a *Client) ListUsers(params *ListUsersParams, opts ...ClientOption) (*ListUsersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListUsersParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListUsers",
+		Method:             "GET",
+		PathPattern:        "/users",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListUsersReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListUsersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for ListUsers: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PatchUser partiallies update user
+*/
+func (a *Client) PatchUser(params *PatchUserParams, opts ...ClientOption) (*PatchUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PatchUser",
+		Method:             "PATCH",
+		PathPattern:        "/users/{id}",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PatchUserReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for PatchUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+RestoreUser restores deleted user
+
+Restoring a user that is not deleted is a no-op.
+*/
+func (a *Client) RestoreUser(params *RestoreUserParams, opts ...ClientOption) (*RestoreUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RestoreUser",
+		Method:             "POST",
+		PathPattern:        "/users/{id}:restore",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RestoreUserReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestoreUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for RestoreUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+UpdateUser replaces user
+*/
+func (a *Client) UpdateUser(params *UpdateUserParams, opts ...ClientOption) (*UpdateUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "UpdateUser",
+		Method:             "PUT",
+		PathPattern:        "/users/{id}",
+		ProducesMediaTypes: []string{"application/json", "application/problem+json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateUserReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for UpdateUser: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
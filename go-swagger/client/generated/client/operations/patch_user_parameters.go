@@ -0,0 +1,192 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"client/generated/models"
+)
+
+// NewPatchUserParams creates a new PatchUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPatchUserParams() *PatchUserParams {
+	return &PatchUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPatchUserParamsWithTimeout creates a new PatchUserParams object
+// with the ability to set a timeout on a request.
+func NewPatchUserParamsWithTimeout(timeout time.Duration) *PatchUserParams {
+	return &PatchUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewPatchUserParamsWithContext creates a new PatchUserParams object
+// with the ability to set a context for a request.
+func NewPatchUserParamsWithContext(ctx context.Context) *PatchUserParams {
+	return &PatchUserParams{
+		Context: ctx,
+	}
+}
+
+// NewPatchUserParamsWithHTTPClient creates a new PatchUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPatchUserParamsWithHTTPClient(client *http.Client) *PatchUserParams {
+	return &PatchUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+PatchUserParams contains all the parameters to send to the API endpoint
+
+	for the patch user operation.
+
+	Typically these are written to a http.Request.
+*/
+type PatchUserParams struct {
+
+	/* IfMatch.
+
+	   ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.
+	*/
+	IfMatch string
+
+	// Body.
+	Body *models.PatchUserRequest
+
+	// ID.
+	ID int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the patch user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PatchUserParams) WithDefaults() *PatchUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the patch user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PatchUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the patch user params
+func (o *PatchUserParams) WithTimeout(timeout time.Duration) *PatchUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the patch user params
+func (o *PatchUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the patch user params
+func (o *PatchUserParams) WithContext(ctx context.Context) *PatchUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the patch user params
+func (o *PatchUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the patch user params
+func (o *PatchUserParams) WithHTTPClient(client *http.Client) *PatchUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the patch user params
+func (o *PatchUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfMatch adds the ifMatch to the patch user params
+func (o *PatchUserParams) WithIfMatch(ifMatch string) *PatchUserParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the patch user params
+func (o *PatchUserParams) SetIfMatch(ifMatch string) {
+	o.IfMatch = ifMatch
+}
+
+// WithBody adds the body to the patch user params
+func (o *PatchUserParams) WithBody(body *models.PatchUserRequest) *PatchUserParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the patch user params
+func (o *PatchUserParams) SetBody(body *models.PatchUserRequest) {
+	o.Body = body
+}
+
+// WithID adds the id to the patch user params
+func (o *PatchUserParams) WithID(id int64) *PatchUserParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the patch user params
+func (o *PatchUserParams) SetID(id int64) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PatchUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// header param If-Match
+	if err := r.SetHeaderParam("If-Match", o.IfMatch); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,726 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// PatchUserReader is a Reader for the PatchUser structure.
+type PatchUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PatchUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPatchUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPatchUserBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPatchUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewPatchUserNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewPatchUserConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewPatchUserGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewPatchUserPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 415:
+		result := NewPatchUserUnsupportedMediaType()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewPatchUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PATCH /users/{id}] PatchUser", response, response.Code())
+	}
+}
+
+// NewPatchUserOK creates a PatchUserOK with default headers values
+func NewPatchUserOK() *PatchUserOK {
+	return &PatchUserOK{}
+}
+
+/*
+PatchUserOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type PatchUserOK struct {
+
+	/* Current version of the user
+	 */
+	ETag string
+
+	Payload *models.GetUserByIDResponse
+}
+
+// IsSuccess returns true when this patch user o k response has a 2xx status code
+func (o *PatchUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this patch user o k response has a 3xx status code
+func (o *PatchUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user o k response has a 4xx status code
+func (o *PatchUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this patch user o k response has a 5xx status code
+func (o *PatchUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user o k response a status code equal to that given
+func (o *PatchUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the patch user o k response
+func (o *PatchUserOK) Code() int {
+	return 200
+}
+
+func (o *PatchUserOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserOK %s", 200, payload)
+}
+
+func (o *PatchUserOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserOK %s", 200, payload)
+}
+
+func (o *PatchUserOK) GetPayload() *models.GetUserByIDResponse {
+	return o.Payload
+}
+
+func (o *PatchUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.GetUserByIDResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserBadRequest creates a PatchUserBadRequest with default headers values
+func NewPatchUserBadRequest() *PatchUserBadRequest {
+	return &PatchUserBadRequest{}
+}
+
+/*
+PatchUserBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
+*/
+type PatchUserBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user bad request response has a 2xx status code
+func (o *PatchUserBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user bad request response has a 3xx status code
+func (o *PatchUserBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user bad request response has a 4xx status code
+func (o *PatchUserBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user bad request response has a 5xx status code
+func (o *PatchUserBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user bad request response a status code equal to that given
+func (o *PatchUserBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the patch user bad request response
+func (o *PatchUserBadRequest) Code() int {
+	return 400
+}
+
+func (o *PatchUserBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserBadRequest %s", 400, payload)
+}
+
+func (o *PatchUserBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserBadRequest %s", 400, payload)
+}
+
+func (o *PatchUserBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserNotFound creates a PatchUserNotFound with default headers values
+func NewPatchUserNotFound() *PatchUserNotFound {
+	return &PatchUserNotFound{}
+}
+
+/*
+PatchUserNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type PatchUserNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user not found response has a 2xx status code
+func (o *PatchUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user not found response has a 3xx status code
+func (o *PatchUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user not found response has a 4xx status code
+func (o *PatchUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user not found response has a 5xx status code
+func (o *PatchUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user not found response a status code equal to that given
+func (o *PatchUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the patch user not found response
+func (o *PatchUserNotFound) Code() int {
+	return 404
+}
+
+func (o *PatchUserNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserNotFound %s", 404, payload)
+}
+
+func (o *PatchUserNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserNotFound %s", 404, payload)
+}
+
+func (o *PatchUserNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserNotAcceptable creates a PatchUserNotAcceptable with default headers values
+func NewPatchUserNotAcceptable() *PatchUserNotAcceptable {
+	return &PatchUserNotAcceptable{}
+}
+
+/*
+PatchUserNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type PatchUserNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user not acceptable response has a 2xx status code
+func (o *PatchUserNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user not acceptable response has a 3xx status code
+func (o *PatchUserNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user not acceptable response has a 4xx status code
+func (o *PatchUserNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user not acceptable response has a 5xx status code
+func (o *PatchUserNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user not acceptable response a status code equal to that given
+func (o *PatchUserNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the patch user not acceptable response
+func (o *PatchUserNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *PatchUserNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserNotAcceptable %s", 406, payload)
+}
+
+func (o *PatchUserNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserNotAcceptable %s", 406, payload)
+}
+
+func (o *PatchUserNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserConflict creates a PatchUserConflict with default headers values
+func NewPatchUserConflict() *PatchUserConflict {
+	return &PatchUserConflict{}
+}
+
+/*
+PatchUserConflict describes a response with status code 409, with default header values.
+
+Name is already taken by another user (code 16)
+*/
+type PatchUserConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user conflict response has a 2xx status code
+func (o *PatchUserConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user conflict response has a 3xx status code
+func (o *PatchUserConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user conflict response has a 4xx status code
+func (o *PatchUserConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user conflict response has a 5xx status code
+func (o *PatchUserConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user conflict response a status code equal to that given
+func (o *PatchUserConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the patch user conflict response
+func (o *PatchUserConflict) Code() int {
+	return 409
+}
+
+func (o *PatchUserConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserConflict %s", 409, payload)
+}
+
+func (o *PatchUserConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserConflict %s", 409, payload)
+}
+
+func (o *PatchUserConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserGone creates a PatchUserGone with default headers values
+func NewPatchUserGone() *PatchUserGone {
+	return &PatchUserGone{}
+}
+
+/*
+PatchUserGone describes a response with status code 410, with default header values.
+
+User has been deleted (code 410)
+*/
+type PatchUserGone struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user gone response has a 2xx status code
+func (o *PatchUserGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user gone response has a 3xx status code
+func (o *PatchUserGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user gone response has a 4xx status code
+func (o *PatchUserGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user gone response has a 5xx status code
+func (o *PatchUserGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user gone response a status code equal to that given
+func (o *PatchUserGone) IsCode(code int) bool {
+	return code == 410
+}
+
+// Code gets the status code for the patch user gone response
+func (o *PatchUserGone) Code() int {
+	return 410
+}
+
+func (o *PatchUserGone) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserGone %s", 410, payload)
+}
+
+func (o *PatchUserGone) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserGone %s", 410, payload)
+}
+
+func (o *PatchUserGone) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserPreconditionFailed creates a PatchUserPreconditionFailed with default headers values
+func NewPatchUserPreconditionFailed() *PatchUserPreconditionFailed {
+	return &PatchUserPreconditionFailed{}
+}
+
+/*
+PatchUserPreconditionFailed describes a response with status code 412, with default header values.
+
+If-Match does not match the current ETag of the user (code 8)
+*/
+type PatchUserPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user precondition failed response has a 2xx status code
+func (o *PatchUserPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user precondition failed response has a 3xx status code
+func (o *PatchUserPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user precondition failed response has a 4xx status code
+func (o *PatchUserPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user precondition failed response has a 5xx status code
+func (o *PatchUserPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user precondition failed response a status code equal to that given
+func (o *PatchUserPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the patch user precondition failed response
+func (o *PatchUserPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *PatchUserPreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserPreconditionFailed %s", 412, payload)
+}
+
+func (o *PatchUserPreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserPreconditionFailed %s", 412, payload)
+}
+
+func (o *PatchUserPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserUnsupportedMediaType creates a PatchUserUnsupportedMediaType with default headers values
+func NewPatchUserUnsupportedMediaType() *PatchUserUnsupportedMediaType {
+	return &PatchUserUnsupportedMediaType{}
+}
+
+/*
+PatchUserUnsupportedMediaType describes a response with status code 415, with default header values.
+
+Unsupported Media Type (code 13)
+*/
+type PatchUserUnsupportedMediaType struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user unsupported media type response has a 2xx status code
+func (o *PatchUserUnsupportedMediaType) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user unsupported media type response has a 3xx status code
+func (o *PatchUserUnsupportedMediaType) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user unsupported media type response has a 4xx status code
+func (o *PatchUserUnsupportedMediaType) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this patch user unsupported media type response has a 5xx status code
+func (o *PatchUserUnsupportedMediaType) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this patch user unsupported media type response a status code equal to that given
+func (o *PatchUserUnsupportedMediaType) IsCode(code int) bool {
+	return code == 415
+}
+
+// Code gets the status code for the patch user unsupported media type response
+func (o *PatchUserUnsupportedMediaType) Code() int {
+	return 415
+}
+
+func (o *PatchUserUnsupportedMediaType) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *PatchUserUnsupportedMediaType) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *PatchUserUnsupportedMediaType) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserUnsupportedMediaType) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPatchUserInternalServerError creates a PatchUserInternalServerError with default headers values
+func NewPatchUserInternalServerError() *PatchUserInternalServerError {
+	return &PatchUserInternalServerError{}
+}
+
+/*
+PatchUserInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type PatchUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this patch user internal server error response has a 2xx status code
+func (o *PatchUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this patch user internal server error response has a 3xx status code
+func (o *PatchUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this patch user internal server error response has a 4xx status code
+func (o *PatchUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this patch user internal server error response has a 5xx status code
+func (o *PatchUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this patch user internal server error response a status code equal to that given
+func (o *PatchUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the patch user internal server error response
+func (o *PatchUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *PatchUserInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserInternalServerError %s", 500, payload)
+}
+
+func (o *PatchUserInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PATCH /users/{id}][%d] patchUserInternalServerError %s", 500, payload)
+}
+
+func (o *PatchUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *PatchUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
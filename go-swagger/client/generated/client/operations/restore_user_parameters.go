@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewRestoreUserParams creates a new RestoreUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRestoreUserParams() *RestoreUserParams {
+	return &RestoreUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRestoreUserParamsWithTimeout creates a new RestoreUserParams object
+// with the ability to set a timeout on a request.
+func NewRestoreUserParamsWithTimeout(timeout time.Duration) *RestoreUserParams {
+	return &RestoreUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewRestoreUserParamsWithContext creates a new RestoreUserParams object
+// with the ability to set a context for a request.
+func NewRestoreUserParamsWithContext(ctx context.Context) *RestoreUserParams {
+	return &RestoreUserParams{
+		Context: ctx,
+	}
+}
+
+// NewRestoreUserParamsWithHTTPClient creates a new RestoreUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRestoreUserParamsWithHTTPClient(client *http.Client) *RestoreUserParams {
+	return &RestoreUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+RestoreUserParams contains all the parameters to send to the API endpoint
+
+	for the restore user operation.
+
+	Typically these are written to a http.Request.
+*/
+type RestoreUserParams struct {
+
+	// ID.
+	ID int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the restore user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RestoreUserParams) WithDefaults() *RestoreUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the restore user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RestoreUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the restore user params
+func (o *RestoreUserParams) WithTimeout(timeout time.Duration) *RestoreUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the restore user params
+func (o *RestoreUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the restore user params
+func (o *RestoreUserParams) WithContext(ctx context.Context) *RestoreUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the restore user params
+func (o *RestoreUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the restore user params
+func (o *RestoreUserParams) WithHTTPClient(client *http.Client) *RestoreUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the restore user params
+func (o *RestoreUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the restore user params
+func (o *RestoreUserParams) WithID(id int64) *RestoreUserParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the restore user params
+func (o *RestoreUserParams) SetID(id int64) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RestoreUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt64(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
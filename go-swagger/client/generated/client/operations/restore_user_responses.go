@@ -0,0 +1,422 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// RestoreUserReader is a Reader for the RestoreUser structure.
+type RestoreUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RestoreUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRestoreUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewRestoreUserBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewRestoreUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewRestoreUserNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewRestoreUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[POST /users/{id}:restore] RestoreUser", response, response.Code())
+	}
+}
+
+// NewRestoreUserOK creates a RestoreUserOK with default headers values
+func NewRestoreUserOK() *RestoreUserOK {
+	return &RestoreUserOK{}
+}
+
+/*
+RestoreUserOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RestoreUserOK struct {
+
+	/* Current version of the user
+	 */
+	ETag string
+
+	Payload *models.GetUserByIDResponse
+}
+
+// IsSuccess returns true when this restore user o k response has a 2xx status code
+func (o *RestoreUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this restore user o k response has a 3xx status code
+func (o *RestoreUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore user o k response has a 4xx status code
+func (o *RestoreUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this restore user o k response has a 5xx status code
+func (o *RestoreUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore user o k response a status code equal to that given
+func (o *RestoreUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the restore user o k response
+func (o *RestoreUserOK) Code() int {
+	return 200
+}
+
+func (o *RestoreUserOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserOK %s", 200, payload)
+}
+
+func (o *RestoreUserOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserOK %s", 200, payload)
+}
+
+func (o *RestoreUserOK) GetPayload() *models.GetUserByIDResponse {
+	return o.Payload
+}
+
+func (o *RestoreUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.GetUserByIDResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreUserBadRequest creates a RestoreUserBadRequest with default headers values
+func NewRestoreUserBadRequest() *RestoreUserBadRequest {
+	return &RestoreUserBadRequest{}
+}
+
+/*
+RestoreUserBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 9 - invalid parameter)
+*/
+type RestoreUserBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this restore user bad request response has a 2xx status code
+func (o *RestoreUserBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore user bad request response has a 3xx status code
+func (o *RestoreUserBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore user bad request response has a 4xx status code
+func (o *RestoreUserBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore user bad request response has a 5xx status code
+func (o *RestoreUserBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore user bad request response a status code equal to that given
+func (o *RestoreUserBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the restore user bad request response
+func (o *RestoreUserBadRequest) Code() int {
+	return 400
+}
+
+func (o *RestoreUserBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserBadRequest %s", 400, payload)
+}
+
+func (o *RestoreUserBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserBadRequest %s", 400, payload)
+}
+
+func (o *RestoreUserBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RestoreUserBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreUserNotFound creates a RestoreUserNotFound with default headers values
+func NewRestoreUserNotFound() *RestoreUserNotFound {
+	return &RestoreUserNotFound{}
+}
+
+/*
+RestoreUserNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type RestoreUserNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this restore user not found response has a 2xx status code
+func (o *RestoreUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore user not found response has a 3xx status code
+func (o *RestoreUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore user not found response has a 4xx status code
+func (o *RestoreUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore user not found response has a 5xx status code
+func (o *RestoreUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore user not found response a status code equal to that given
+func (o *RestoreUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the restore user not found response
+func (o *RestoreUserNotFound) Code() int {
+	return 404
+}
+
+func (o *RestoreUserNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserNotFound %s", 404, payload)
+}
+
+func (o *RestoreUserNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserNotFound %s", 404, payload)
+}
+
+func (o *RestoreUserNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RestoreUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreUserNotAcceptable creates a RestoreUserNotAcceptable with default headers values
+func NewRestoreUserNotAcceptable() *RestoreUserNotAcceptable {
+	return &RestoreUserNotAcceptable{}
+}
+
+/*
+RestoreUserNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type RestoreUserNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this restore user not acceptable response has a 2xx status code
+func (o *RestoreUserNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore user not acceptable response has a 3xx status code
+func (o *RestoreUserNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore user not acceptable response has a 4xx status code
+func (o *RestoreUserNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this restore user not acceptable response has a 5xx status code
+func (o *RestoreUserNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this restore user not acceptable response a status code equal to that given
+func (o *RestoreUserNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the restore user not acceptable response
+func (o *RestoreUserNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *RestoreUserNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserNotAcceptable %s", 406, payload)
+}
+
+func (o *RestoreUserNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserNotAcceptable %s", 406, payload)
+}
+
+func (o *RestoreUserNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RestoreUserNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRestoreUserInternalServerError creates a RestoreUserInternalServerError with default headers values
+func NewRestoreUserInternalServerError() *RestoreUserInternalServerError {
+	return &RestoreUserInternalServerError{}
+}
+
+/*
+RestoreUserInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type RestoreUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this restore user internal server error response has a 2xx status code
+func (o *RestoreUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this restore user internal server error response has a 3xx status code
+func (o *RestoreUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this restore user internal server error response has a 4xx status code
+func (o *RestoreUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this restore user internal server error response has a 5xx status code
+func (o *RestoreUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this restore user internal server error response a status code equal to that given
+func (o *RestoreUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the restore user internal server error response
+func (o *RestoreUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *RestoreUserInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserInternalServerError %s", 500, payload)
+}
+
+func (o *RestoreUserInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[POST /users/{id}:restore][%d] restoreUserInternalServerError %s", 500, payload)
+}
+
+func (o *RestoreUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RestoreUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
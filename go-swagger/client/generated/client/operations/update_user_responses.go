@@ -0,0 +1,726 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/models"
+)
+
+// UpdateUserReader is a Reader for the UpdateUser structure.
+type UpdateUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewUpdateUserBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewUpdateUserNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 406:
+		result := NewUpdateUserNotAcceptable()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewUpdateUserConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewUpdateUserGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 412:
+		result := NewUpdateUserPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 415:
+		result := NewUpdateUserUnsupportedMediaType()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpdateUserInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("[PUT /users/{id}] UpdateUser", response, response.Code())
+	}
+}
+
+// NewUpdateUserOK creates a UpdateUserOK with default headers values
+func NewUpdateUserOK() *UpdateUserOK {
+	return &UpdateUserOK{}
+}
+
+/*
+UpdateUserOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type UpdateUserOK struct {
+
+	/* Current version of the user
+	 */
+	ETag string
+
+	Payload *models.GetUserByIDResponse
+}
+
+// IsSuccess returns true when this update user o k response has a 2xx status code
+func (o *UpdateUserOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this update user o k response has a 3xx status code
+func (o *UpdateUserOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user o k response has a 4xx status code
+func (o *UpdateUserOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update user o k response has a 5xx status code
+func (o *UpdateUserOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user o k response a status code equal to that given
+func (o *UpdateUserOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the update user o k response
+func (o *UpdateUserOK) Code() int {
+	return 200
+}
+
+func (o *UpdateUserOK) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserOK %s", 200, payload)
+}
+
+func (o *UpdateUserOK) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserOK %s", 200, payload)
+}
+
+func (o *UpdateUserOK) GetPayload() *models.GetUserByIDResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.GetUserByIDResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserBadRequest creates a UpdateUserBadRequest with default headers values
+func NewUpdateUserBadRequest() *UpdateUserBadRequest {
+	return &UpdateUserBadRequest{}
+}
+
+/*
+UpdateUserBadRequest describes a response with status code 400, with default header values.
+
+Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
+*/
+type UpdateUserBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user bad request response has a 2xx status code
+func (o *UpdateUserBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user bad request response has a 3xx status code
+func (o *UpdateUserBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user bad request response has a 4xx status code
+func (o *UpdateUserBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user bad request response has a 5xx status code
+func (o *UpdateUserBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user bad request response a status code equal to that given
+func (o *UpdateUserBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the update user bad request response
+func (o *UpdateUserBadRequest) Code() int {
+	return 400
+}
+
+func (o *UpdateUserBadRequest) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserBadRequest %s", 400, payload)
+}
+
+func (o *UpdateUserBadRequest) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserBadRequest %s", 400, payload)
+}
+
+func (o *UpdateUserBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserNotFound creates a UpdateUserNotFound with default headers values
+func NewUpdateUserNotFound() *UpdateUserNotFound {
+	return &UpdateUserNotFound{}
+}
+
+/*
+UpdateUserNotFound describes a response with status code 404, with default header values.
+
+Not Found
+*/
+type UpdateUserNotFound struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user not found response has a 2xx status code
+func (o *UpdateUserNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user not found response has a 3xx status code
+func (o *UpdateUserNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user not found response has a 4xx status code
+func (o *UpdateUserNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user not found response has a 5xx status code
+func (o *UpdateUserNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user not found response a status code equal to that given
+func (o *UpdateUserNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the update user not found response
+func (o *UpdateUserNotFound) Code() int {
+	return 404
+}
+
+func (o *UpdateUserNotFound) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserNotFound %s", 404, payload)
+}
+
+func (o *UpdateUserNotFound) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserNotFound %s", 404, payload)
+}
+
+func (o *UpdateUserNotFound) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserNotAcceptable creates a UpdateUserNotAcceptable with default headers values
+func NewUpdateUserNotAcceptable() *UpdateUserNotAcceptable {
+	return &UpdateUserNotAcceptable{}
+}
+
+/*
+UpdateUserNotAcceptable describes a response with status code 406, with default header values.
+
+Not Acceptable (code 14)
+*/
+type UpdateUserNotAcceptable struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user not acceptable response has a 2xx status code
+func (o *UpdateUserNotAcceptable) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user not acceptable response has a 3xx status code
+func (o *UpdateUserNotAcceptable) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user not acceptable response has a 4xx status code
+func (o *UpdateUserNotAcceptable) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user not acceptable response has a 5xx status code
+func (o *UpdateUserNotAcceptable) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user not acceptable response a status code equal to that given
+func (o *UpdateUserNotAcceptable) IsCode(code int) bool {
+	return code == 406
+}
+
+// Code gets the status code for the update user not acceptable response
+func (o *UpdateUserNotAcceptable) Code() int {
+	return 406
+}
+
+func (o *UpdateUserNotAcceptable) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserNotAcceptable %s", 406, payload)
+}
+
+func (o *UpdateUserNotAcceptable) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserNotAcceptable %s", 406, payload)
+}
+
+func (o *UpdateUserNotAcceptable) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserNotAcceptable) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserConflict creates a UpdateUserConflict with default headers values
+func NewUpdateUserConflict() *UpdateUserConflict {
+	return &UpdateUserConflict{}
+}
+
+/*
+UpdateUserConflict describes a response with status code 409, with default header values.
+
+Name is already taken by another user (code 16)
+*/
+type UpdateUserConflict struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user conflict response has a 2xx status code
+func (o *UpdateUserConflict) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user conflict response has a 3xx status code
+func (o *UpdateUserConflict) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user conflict response has a 4xx status code
+func (o *UpdateUserConflict) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user conflict response has a 5xx status code
+func (o *UpdateUserConflict) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user conflict response a status code equal to that given
+func (o *UpdateUserConflict) IsCode(code int) bool {
+	return code == 409
+}
+
+// Code gets the status code for the update user conflict response
+func (o *UpdateUserConflict) Code() int {
+	return 409
+}
+
+func (o *UpdateUserConflict) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserConflict %s", 409, payload)
+}
+
+func (o *UpdateUserConflict) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserConflict %s", 409, payload)
+}
+
+func (o *UpdateUserConflict) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserGone creates a UpdateUserGone with default headers values
+func NewUpdateUserGone() *UpdateUserGone {
+	return &UpdateUserGone{}
+}
+
+/*
+UpdateUserGone describes a response with status code 410, with default header values.
+
+User has been deleted (code 410)
+*/
+type UpdateUserGone struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user gone response has a 2xx status code
+func (o *UpdateUserGone) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user gone response has a 3xx status code
+func (o *UpdateUserGone) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user gone response has a 4xx status code
+func (o *UpdateUserGone) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user gone response has a 5xx status code
+func (o *UpdateUserGone) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user gone response a status code equal to that given
+func (o *UpdateUserGone) IsCode(code int) bool {
+	return code == 410
+}
+
+// Code gets the status code for the update user gone response
+func (o *UpdateUserGone) Code() int {
+	return 410
+}
+
+func (o *UpdateUserGone) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserGone %s", 410, payload)
+}
+
+func (o *UpdateUserGone) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserGone %s", 410, payload)
+}
+
+func (o *UpdateUserGone) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserPreconditionFailed creates a UpdateUserPreconditionFailed with default headers values
+func NewUpdateUserPreconditionFailed() *UpdateUserPreconditionFailed {
+	return &UpdateUserPreconditionFailed{}
+}
+
+/*
+UpdateUserPreconditionFailed describes a response with status code 412, with default header values.
+
+If-Match does not match the current ETag of the user (code 8)
+*/
+type UpdateUserPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user precondition failed response has a 2xx status code
+func (o *UpdateUserPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user precondition failed response has a 3xx status code
+func (o *UpdateUserPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user precondition failed response has a 4xx status code
+func (o *UpdateUserPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user precondition failed response has a 5xx status code
+func (o *UpdateUserPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user precondition failed response a status code equal to that given
+func (o *UpdateUserPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+// Code gets the status code for the update user precondition failed response
+func (o *UpdateUserPreconditionFailed) Code() int {
+	return 412
+}
+
+func (o *UpdateUserPreconditionFailed) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserPreconditionFailed %s", 412, payload)
+}
+
+func (o *UpdateUserPreconditionFailed) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserPreconditionFailed %s", 412, payload)
+}
+
+func (o *UpdateUserPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserUnsupportedMediaType creates a UpdateUserUnsupportedMediaType with default headers values
+func NewUpdateUserUnsupportedMediaType() *UpdateUserUnsupportedMediaType {
+	return &UpdateUserUnsupportedMediaType{}
+}
+
+/*
+UpdateUserUnsupportedMediaType describes a response with status code 415, with default header values.
+
+Unsupported Media Type (code 13)
+*/
+type UpdateUserUnsupportedMediaType struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user unsupported media type response has a 2xx status code
+func (o *UpdateUserUnsupportedMediaType) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user unsupported media type response has a 3xx status code
+func (o *UpdateUserUnsupportedMediaType) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user unsupported media type response has a 4xx status code
+func (o *UpdateUserUnsupportedMediaType) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update user unsupported media type response has a 5xx status code
+func (o *UpdateUserUnsupportedMediaType) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update user unsupported media type response a status code equal to that given
+func (o *UpdateUserUnsupportedMediaType) IsCode(code int) bool {
+	return code == 415
+}
+
+// Code gets the status code for the update user unsupported media type response
+func (o *UpdateUserUnsupportedMediaType) Code() int {
+	return 415
+}
+
+func (o *UpdateUserUnsupportedMediaType) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *UpdateUserUnsupportedMediaType) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserUnsupportedMediaType %s", 415, payload)
+}
+
+func (o *UpdateUserUnsupportedMediaType) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserUnsupportedMediaType) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateUserInternalServerError creates a UpdateUserInternalServerError with default headers values
+func NewUpdateUserInternalServerError() *UpdateUserInternalServerError {
+	return &UpdateUserInternalServerError{}
+}
+
+/*
+UpdateUserInternalServerError describes a response with status code 500, with default header values.
+
+Internal Server Error
+*/
+type UpdateUserInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update user internal server error response has a 2xx status code
+func (o *UpdateUserInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update user internal server error response has a 3xx status code
+func (o *UpdateUserInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update user internal server error response has a 4xx status code
+func (o *UpdateUserInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this update user internal server error response has a 5xx status code
+func (o *UpdateUserInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this update user internal server error response a status code equal to that given
+func (o *UpdateUserInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the update user internal server error response
+func (o *UpdateUserInternalServerError) Code() int {
+	return 500
+}
+
+func (o *UpdateUserInternalServerError) Error() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserInternalServerError %s", 500, payload)
+}
+
+func (o *UpdateUserInternalServerError) String() string {
+	payload, _ := json.Marshal(o.Payload)
+	return fmt.Sprintf("[PUT /users/{id}][%d] updateUserInternalServerError %s", 500, payload)
+}
+
+func (o *UpdateUserInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateUserInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
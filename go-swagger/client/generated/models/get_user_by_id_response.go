@@ -19,6 +19,10 @@ import (
 // swagger:model GetUserByIdResponse
 type GetUserByIDResponse struct {
 
+	// Set only for deleted users, which ListUsers returns with include_deleted
+	// Format: date-time
+	DeletedAt *strfmt.DateTime `json:"deleted_at,omitempty"`
+
 	// id
 	// Required: true
 	ID *int64 `json:"id"`
@@ -32,6 +36,10 @@ type GetUserByIDResponse struct {
 func (m *GetUserByIDResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDeletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -46,6 +54,18 @@ func (m *GetUserByIDResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *GetUserByIDResponse) validateDeletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deleted_at", "body", "date-time", m.DeletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *GetUserByIDResponse) validateID(formats strfmt.Registry) error {
 
 	if err := validate.Required("id", "body", m.ID); err != nil {
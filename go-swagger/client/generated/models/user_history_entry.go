@@ -0,0 +1,276 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// UserHistoryEntry user history entry
+//
+// swagger:model UserHistoryEntry
+type UserHistoryEntry struct {
+
+	// action
+	// Required: true
+	// Enum: ["create","update","delete","restore"]
+	Action *string `json:"action"`
+
+	// "operator" for changes made with the operator token in X-Debug-Token; absent for other requests. The token is shared by all operators, so no individual principal is recorded.
+	Actor string `json:"actor,omitempty"`
+
+	// at
+	// Required: true
+	// Format: date-time
+	At *strfmt.DateTime `json:"at"`
+
+	// changes
+	// Required: true
+	Changes []*UserHistoryChange `json:"changes"`
+
+	// SHA-256 of the entry including prev_hash, hex encoded
+	// Required: true
+	Hash *string `json:"hash"`
+
+	// Hash of the previous entry of the audit log; empty for the first entry
+	// Required: true
+	PrevHash *string `json:"prev_hash"`
+
+	// Position of the entry in the whole audit log, starting with 1
+	// Required: true
+	Seq *int64 `json:"seq"`
+
+	// Version of the user after the change
+	// Required: true
+	Version *int64 `json:"version"`
+}
+
+// Validate validates this user history entry
+func (m *UserHistoryEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChanges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHash(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrevHash(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeq(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var userHistoryEntryTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["create","update","delete","restore"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		userHistoryEntryTypeActionPropEnum = append(userHistoryEntryTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// UserHistoryEntryActionCreate captures enum value "create"
+	UserHistoryEntryActionCreate string = "create"
+
+	// UserHistoryEntryActionUpdate captures enum value "update"
+	UserHistoryEntryActionUpdate string = "update"
+
+	// UserHistoryEntryActionDelete captures enum value "delete"
+	UserHistoryEntryActionDelete string = "delete"
+
+	// UserHistoryEntryActionRestore captures enum value "restore"
+	UserHistoryEntryActionRestore string = "restore"
+)
+
+// prop value enum
+func (m *UserHistoryEntry) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, userHistoryEntryTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *UserHistoryEntry) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserHistoryEntry) validateAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("at", "body", m.At); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("at", "body", "date-time", m.At.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserHistoryEntry) validateChanges(formats strfmt.Registry) error {
+
+	if err := validate.Required("changes", "body", m.Changes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Changes); i++ {
+		if swag.IsZero(m.Changes[i]) { // not required
+			continue
+		}
+
+		if m.Changes[i] != nil {
+			if err := m.Changes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *UserHistoryEntry) validateHash(formats strfmt.Registry) error {
+
+	if err := validate.Required("hash", "body", m.Hash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserHistoryEntry) validatePrevHash(formats strfmt.Registry) error {
+
+	if err := validate.Required("prev_hash", "body", m.PrevHash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserHistoryEntry) validateSeq(formats strfmt.Registry) error {
+
+	if err := validate.Required("seq", "body", m.Seq); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *UserHistoryEntry) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this user history entry based on the context it is used
+func (m *UserHistoryEntry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateChanges(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *UserHistoryEntry) contextValidateChanges(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Changes); i++ {
+
+		if m.Changes[i] != nil {
+
+			if swag.IsZero(m.Changes[i]) { // not required
+				return nil
+			}
+
+			if err := m.Changes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("changes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("changes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *UserHistoryEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *UserHistoryEntry) UnmarshalBinary(b []byte) error {
+	var res UserHistoryEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ValidationErrorDetail validation error detail
+//
+// swagger:model ValidationErrorDetail
+type ValidationErrorDetail struct {
+
+	// Request field that failed validation, e.g. name or limit
+	// Required: true
+	Field *string `json:"field"`
+
+	// message
+	// Required: true
+	Message *string `json:"message"`
+
+	// Violated rule - not_blank, length, charset, range, format, required, type or unknown
+	// Required: true
+	Rule *string `json:"rule"`
+}
+
+// Validate validates this validation error detail
+func (m *ValidationErrorDetail) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateField(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRule(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ValidationErrorDetail) validateField(formats strfmt.Registry) error {
+
+	if err := validate.Required("field", "body", m.Field); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ValidationErrorDetail) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("message", "body", m.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ValidationErrorDetail) validateRule(formats strfmt.Registry) error {
+
+	if err := validate.Required("rule", "body", m.Rule); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this validation error detail based on context it is used
+func (m *ValidationErrorDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ValidationErrorDetail) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ValidationErrorDetail) UnmarshalBinary(b []byte) error {
+	var res ValidationErrorDetail
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,477 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"client/generated/client"
+	"client/generated/client/operations"
+	"client/generated/models"
+	"client/problem"
+	"client/testserver"
+)
+
+// interopStep - вызов клиента и тип ответа, в который он должен разобраться: успешные ответы go-swagger
+// возвращает результатом, остальные - ошибкой своего типа. Шаги выполняются по порядку против одного сервера
+// с пустым хранилищем.
+type interopStep struct {
+	name string
+	call func(c operations.ClientService) (any, error)
+	want any
+	// wantCode - ErrorResponse.code ответа с ошибкой; 0 - не проверяется
+	wantCode int64
+}
+
+var interopSteps = []interopStep{
+	{
+		name: "create user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUser(operations.NewCreateUserParams().WithBody(&models.CreateUserRequest{Name: ptr("Alice")}))
+		},
+		want: &operations.CreateUserCreated{},
+	},
+	{
+		name: "create user with blank name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUser(operations.NewCreateUserParams().WithBody(&models.CreateUserRequest{Name: ptr(" ")}))
+		},
+		want:     &operations.CreateUserBadRequest{},
+		wantCode: 3,
+	},
+	{
+		name: "create user with taken name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUser(operations.NewCreateUserParams().WithBody(&models.CreateUserRequest{Name: ptr("Alice")}))
+		},
+		want:     &operations.CreateUserConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "create user with idempotency key",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUser(operations.NewCreateUserParams().
+				WithIdempotencyKey(ptr("interop")).
+				WithBody(&models.CreateUserRequest{Name: ptr("Bob")}))
+		},
+		want: &operations.CreateUserCreated{},
+	},
+	{
+		name: "reuse idempotency key with another body",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUser(operations.NewCreateUserParams().
+				WithIdempotencyKey(ptr("interop")).
+				WithBody(&models.CreateUserRequest{Name: ptr("Carol")}))
+		},
+		want:     &operations.CreateUserUnprocessableEntity{},
+		wantCode: 6,
+	},
+	{
+		name: "create batch",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUsersBatch(operations.NewCreateUsersBatchParams().WithBody(&models.CreateUsersBatchRequest{
+				Items: []*models.CreateUserRequest{{Name: ptr("Carol")}, {Name: ptr(" ")}},
+			}))
+		},
+		want: &operations.CreateUsersBatchOK{},
+	},
+	{
+		name: "create batch all or nothing",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUsersBatch(operations.NewCreateUsersBatchParams().WithBody(&models.CreateUsersBatchRequest{
+				Items:        []*models.CreateUserRequest{{Name: ptr("Dave")}, {Name: ptr(" ")}},
+				AllOrNothing: ptr(true),
+			}))
+		},
+		want: &operations.CreateUsersBatchUnprocessableEntity{},
+	},
+	{
+		name: "create batch with taken name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.CreateUsersBatch(operations.NewCreateUsersBatchParams().WithBody(&models.CreateUsersBatchRequest{
+				Items: []*models.CreateUserRequest{{Name: ptr("Eve")}, {Name: ptr("Alice")}},
+			}))
+		},
+		want:     &operations.CreateUsersBatchConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "get user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserByID(operations.NewGetUserByIDParams().WithID(1))
+		},
+		want: &operations.GetUserByIDOK{},
+	},
+	{
+		name: "get unchanged user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserByID(operations.NewGetUserByIDParams().WithID(1).WithIfNoneMatch(ptr(`"1"`)))
+		},
+		want: &operations.GetUserByIDNotModified{},
+	},
+	{
+		name: "get missing user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserByID(operations.NewGetUserByIDParams().WithID(99))
+		},
+		want:     &operations.GetUserByIDNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "get missing user as problem details",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserByID(operations.NewGetUserByIDParams().WithID(99), operations.WithAcceptApplicationProblemJSON)
+		},
+		want:     &operations.GetUserByIDNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "replace user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.UpdateUser(operations.NewUpdateUserParams().WithID(1).WithIfMatch(`"1"`).
+				WithBody(&models.UpdateUserRequest{Name: ptr("Alicia")}))
+		},
+		want: &operations.UpdateUserOK{},
+	},
+	{
+		name: "replace user with stale ETag",
+		call: func(c operations.ClientService) (any, error) {
+			return c.UpdateUser(operations.NewUpdateUserParams().WithID(1).WithIfMatch(`"1"`).
+				WithBody(&models.UpdateUserRequest{Name: ptr("Alice")}))
+		},
+		want:     &operations.UpdateUserPreconditionFailed{},
+		wantCode: 8,
+	},
+	{
+		name: "replace user with blank name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.UpdateUser(operations.NewUpdateUserParams().WithID(1).WithIfMatch("*").
+				WithBody(&models.UpdateUserRequest{Name: ptr(" ")}))
+		},
+		want:     &operations.UpdateUserBadRequest{},
+		wantCode: 3,
+	},
+	{
+		name: "replace user with taken name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.UpdateUser(operations.NewUpdateUserParams().WithID(1).WithIfMatch("*").
+				WithBody(&models.UpdateUserRequest{Name: ptr("Bob")}))
+		},
+		want:     &operations.UpdateUserConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "replace missing user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.UpdateUser(operations.NewUpdateUserParams().WithID(99).WithIfMatch("*").
+				WithBody(&models.UpdateUserRequest{Name: ptr("Alice")}))
+		},
+		want:     &operations.UpdateUserNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "patch user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.PatchUser(operations.NewPatchUserParams().WithID(2).WithIfMatch("*").
+				WithBody(&models.PatchUserRequest{Name: ptr("Bobby")}))
+		},
+		want: &operations.PatchUserOK{},
+	},
+	{
+		name: "patch user with stale ETag",
+		call: func(c operations.ClientService) (any, error) {
+			return c.PatchUser(operations.NewPatchUserParams().WithID(2).WithIfMatch(`"1"`).
+				WithBody(&models.PatchUserRequest{Name: ptr("Bob")}))
+		},
+		want:     &operations.PatchUserPreconditionFailed{},
+		wantCode: 8,
+	},
+	{
+		name: "patch user with blank name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.PatchUser(operations.NewPatchUserParams().WithID(2).WithIfMatch("*").
+				WithBody(&models.PatchUserRequest{Name: ptr(" ")}))
+		},
+		want:     &operations.PatchUserBadRequest{},
+		wantCode: 3,
+	},
+	{
+		name: "patch user to taken name",
+		call: func(c operations.ClientService) (any, error) {
+			return c.PatchUser(operations.NewPatchUserParams().WithID(2).WithIfMatch("*").
+				WithBody(&models.PatchUserRequest{Name: ptr("Alicia")}))
+		},
+		want:     &operations.PatchUserConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "patch missing user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.PatchUser(operations.NewPatchUserParams().WithID(99).WithIfMatch("*").
+				WithBody(&models.PatchUserRequest{Name: ptr("Bob")}))
+		},
+		want:     &operations.PatchUserNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "list users",
+		call: func(c operations.ClientService) (any, error) {
+			return c.ListUsers(operations.NewListUsersParams().WithLimit(ptr(int64(2))).WithSort([]string{"-name"}))
+		},
+		want: &operations.ListUsersOK{},
+	},
+	{
+		name: "list users with unknown sort field",
+		call: func(c operations.ClientService) (any, error) {
+			return c.ListUsers(operations.NewListUsersParams().WithSort([]string{"email"}))
+		},
+		want:     &operations.ListUsersBadRequest{},
+		wantCode: 4,
+	},
+	{
+		name: "delete user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.DeleteUser(operations.NewDeleteUserParams().WithID(2))
+		},
+		want: &operations.DeleteUserNoContent{},
+	},
+	{
+		name: "get deleted user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserByID(operations.NewGetUserByIDParams().WithID(2))
+		},
+		want:     &operations.GetUserByIDGone{},
+		wantCode: 410,
+	},
+	{
+		name: "replace deleted user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.UpdateUser(operations.NewUpdateUserParams().WithID(2).WithIfMatch("*").
+				WithBody(&models.UpdateUserRequest{Name: ptr("Bob")}))
+		},
+		want:     &operations.UpdateUserGone{},
+		wantCode: 410,
+	},
+	{
+		name: "patch deleted user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.PatchUser(operations.NewPatchUserParams().WithID(2).WithIfMatch("*").
+				WithBody(&models.PatchUserRequest{Name: ptr("Bob")}))
+		},
+		want:     &operations.PatchUserGone{},
+		wantCode: 410,
+	},
+	{
+		name: "delete deleted user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.DeleteUser(operations.NewDeleteUserParams().WithID(2))
+		},
+		want:     &operations.DeleteUserGone{},
+		wantCode: 410,
+	},
+	{
+		name: "delete missing user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.DeleteUser(operations.NewDeleteUserParams().WithID(99))
+		},
+		want:     &operations.DeleteUserNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "restore user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.RestoreUser(operations.NewRestoreUserParams().WithID(2))
+		},
+		want: &operations.RestoreUserOK{},
+	},
+	{
+		name: "restore missing user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.RestoreUser(operations.NewRestoreUserParams().WithID(99))
+		},
+		want:     &operations.RestoreUserNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "get user history",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserHistory(operations.NewGetUserHistoryParams().WithID(2))
+		},
+		want: &operations.GetUserHistoryOK{},
+	},
+	{
+		name: "get history of missing user",
+		call: func(c operations.ClientService) (any, error) {
+			return c.GetUserHistory(operations.NewGetUserHistoryParams().WithID(99))
+		},
+		want:     &operations.GetUserHistoryNotFound{},
+		wantCode: 404,
+	},
+}
+
+// Клиент go-swagger против каждого сервера репозитория
+func TestInterop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and starts every server")
+	}
+
+	for _, dir := range testserver.Servers {
+		t.Run(dir, func(t *testing.T) {
+			t.Parallel()
+
+			client := newClient(testserver.Start(t, filepath.Join("..", "..", dir)))
+
+			for _, step := range interopSteps {
+				ok := t.Run(step.name, func(t *testing.T) {
+					got, err := step.call(client)
+					checkResponse(t, got, err, step.want, step.wantCode)
+				})
+				if !ok {
+					t.Fatalf("step %q failed, the rest of the scenario is skipped", step.name)
+				}
+			}
+		})
+	}
+}
+
+// documentedStatus - ответ с ошибкой из спецификации. Серверы отдают его только при сбое (500), гонке (409 с кодом 7)
+// или запросе, который типизированный клиент не отправит (406, 415, неверный параметр), поэтому он
+// воспроизводится заглушкой с телом ErrorResponse.
+type documentedStatus struct {
+	status int
+	code   int64
+	call   func(c operations.ClientService) (any, error)
+	want   any
+}
+
+func getUser(c operations.ClientService) (any, error) {
+	return c.GetUserByID(operations.NewGetUserByIDParams().WithID(1))
+}
+
+func updateUser(c operations.ClientService) (any, error) {
+	return c.UpdateUser(operations.NewUpdateUserParams().WithID(1).WithIfMatch("*").
+		WithBody(&models.UpdateUserRequest{Name: ptr("Alice")}))
+}
+
+func patchUser(c operations.ClientService) (any, error) {
+	return c.PatchUser(operations.NewPatchUserParams().WithID(1).WithIfMatch("*").
+		WithBody(&models.PatchUserRequest{Name: ptr("Alice")}))
+}
+
+func deleteUser(c operations.ClientService) (any, error) {
+	return c.DeleteUser(operations.NewDeleteUserParams().WithID(1))
+}
+
+func restoreUser(c operations.ClientService) (any, error) {
+	return c.RestoreUser(operations.NewRestoreUserParams().WithID(1))
+}
+
+func getUserHistory(c operations.ClientService) (any, error) {
+	return c.GetUserHistory(operations.NewGetUserHistoryParams().WithID(1))
+}
+
+func listUsers(c operations.ClientService) (any, error) {
+	return c.ListUsers(operations.NewListUsersParams())
+}
+
+func createUser(c operations.ClientService) (any, error) {
+	return c.CreateUser(operations.NewCreateUserParams().WithBody(&models.CreateUserRequest{Name: ptr("Alice")}))
+}
+
+func createUsersBatch(c operations.ClientService) (any, error) {
+	return c.CreateUsersBatch(operations.NewCreateUsersBatchParams().WithBody(&models.CreateUsersBatchRequest{
+		Items: []*models.CreateUserRequest{{Name: ptr("Alice")}},
+	}))
+}
+
+var documentedStatuses = []documentedStatus{
+	{status: 400, code: 9, call: getUser, want: &operations.GetUserByIDBadRequest{}},
+	{status: 406, code: 14, call: getUser, want: &operations.GetUserByIDNotAcceptable{}},
+	{status: 500, code: -1, call: getUser, want: &operations.GetUserByIDInternalServerError{}},
+	{status: 406, code: 14, call: updateUser, want: &operations.UpdateUserNotAcceptable{}},
+	{status: 415, code: 13, call: updateUser, want: &operations.UpdateUserUnsupportedMediaType{}},
+	{status: 500, code: -1, call: updateUser, want: &operations.UpdateUserInternalServerError{}},
+	{status: 406, code: 14, call: patchUser, want: &operations.PatchUserNotAcceptable{}},
+	{status: 415, code: 13, call: patchUser, want: &operations.PatchUserUnsupportedMediaType{}},
+	{status: 500, code: -1, call: patchUser, want: &operations.PatchUserInternalServerError{}},
+	{status: 400, code: 9, call: deleteUser, want: &operations.DeleteUserBadRequest{}},
+	{status: 406, code: 14, call: deleteUser, want: &operations.DeleteUserNotAcceptable{}},
+	{status: 500, code: -1, call: deleteUser, want: &operations.DeleteUserInternalServerError{}},
+	{status: 400, code: 9, call: restoreUser, want: &operations.RestoreUserBadRequest{}},
+	{status: 406, code: 14, call: restoreUser, want: &operations.RestoreUserNotAcceptable{}},
+	{status: 500, code: -1, call: restoreUser, want: &operations.RestoreUserInternalServerError{}},
+	{status: 400, code: 9, call: getUserHistory, want: &operations.GetUserHistoryBadRequest{}},
+	{status: 406, code: 14, call: getUserHistory, want: &operations.GetUserHistoryNotAcceptable{}},
+	{status: 500, code: -1, call: getUserHistory, want: &operations.GetUserHistoryInternalServerError{}},
+	{status: 403, code: 15, call: listUsers, want: &operations.ListUsersForbidden{}},
+	{status: 406, code: 14, call: listUsers, want: &operations.ListUsersNotAcceptable{}},
+	{status: 500, code: -1, call: listUsers, want: &operations.ListUsersInternalServerError{}},
+	{status: 406, code: 14, call: createUser, want: &operations.CreateUserNotAcceptable{}},
+	{status: 409, code: 7, call: createUser, want: &operations.CreateUserConflict{}},
+	{status: 415, code: 13, call: createUser, want: &operations.CreateUserUnsupportedMediaType{}},
+	{status: 500, code: -1, call: createUser, want: &operations.CreateUserInternalServerError{}},
+	{status: 400, code: 3, call: createUsersBatch, want: &operations.CreateUsersBatchBadRequest{}},
+	{status: 406, code: 14, call: createUsersBatch, want: &operations.CreateUsersBatchNotAcceptable{}},
+	{status: 415, code: 13, call: createUsersBatch, want: &operations.CreateUsersBatchUnsupportedMediaType{}},
+	{status: 500, code: -1, call: createUsersBatch, want: &operations.CreateUsersBatchInternalServerError{}},
+}
+
+func TestDocumentedStatuses(t *testing.T) {
+	for _, tt := range documentedStatuses {
+		t.Run(fmt.Sprintf("%T", tt.want), func(t *testing.T) {
+			stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+
+				_, _ = fmt.Fprintf(w, `{"code":%d,"error":%q}`, tt.code, http.StatusText(tt.status))
+			}))
+			defer stub.Close()
+
+			got, err := tt.call(newClient(stub.URL))
+			checkResponse(t, got, err, tt.want, tt.code)
+		})
+	}
+}
+
+// newClient - клиент сервера на baseURL, который разбирает и ответы application/problem+json
+func newClient(baseURL string) operations.ClientService {
+	transport := httptransport.New(strings.TrimPrefix(baseURL, "http://"), "", []string{"http"})
+	transport.Consumers[problem.ContentType] = problem.Consumer()
+
+	return client.New(transport, strfmt.Default).Operations
+}
+
+// checkResponse проверяет, что ответ разобран в тип want - результатом или ошибкой, а у ответа с ошибкой
+// в Payload код wantCode.
+func checkResponse(t *testing.T, got any, err error, want any, wantCode int64) {
+	t.Helper()
+
+	response := got
+	if err != nil {
+		response = err
+	}
+
+	if reflect.TypeOf(response) != reflect.TypeOf(want) {
+		t.Fatalf("response = %T %v, want %T", response, response, want)
+	}
+
+	if wantCode == 0 {
+		return
+	}
+
+	payload := reflect.ValueOf(response).Elem().FieldByName("Payload").Interface().(*models.ErrorResponse)
+	if payload == nil || payload.Code == nil || *payload.Code != wantCode {
+		t.Fatalf("payload = %+v, want code %d", payload, wantCode)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 
 	httptransport "github.com/go-openapi/runtime/client"
@@ -9,11 +10,13 @@ import (
 	"client/generated/client"
 	"client/generated/client/operations"
 	"client/generated/models"
+	"client/problem"
 )
 
 func main() {
 	option1()
 	option2()
+	option3()
 }
 
 func option1() {
@@ -49,3 +52,26 @@ func option2() {
 
 	fmt.Printf("ID: %+v\n", *resp.Payload.ID)
 }
+
+// Ошибки в формате application/problem+json
+func option3() {
+	transport := httptransport.New("localhost:8080", "", []string{"http"})
+	transport.Consumers[problem.ContentType] = problem.Consumer()
+	apiClient := client.New(transport, strfmt.Default)
+
+	params := operations.NewGetUserByIDParams()
+	params.SetID(0)
+
+	_, err := apiClient.Operations.GetUserByID(params, operations.WithAcceptApplicationProblemJSON)
+
+	var notFound *operations.GetUserByIDNotFound
+	if errors.As(err, &notFound) {
+		fmt.Printf("Code: %v, Error: %v\n", *notFound.Payload.Code, *notFound.Payload.Error)
+
+		return
+	}
+
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,48 @@
+package problem
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	"client/generated/models"
+)
+
+// ContentType - тип ответа с ошибкой в формате RFC 7807
+const ContentType = "application/problem+json"
+
+// Consumer разбирает ответы application/problem+json. Swagger 2.0 не позволяет описать отдельную схему
+// для каждого типа ответа, поэтому в сгенерированных ответах об ошибках всегда *models.ErrorResponse:
+// problem details раскладываются в него (detail, а без него title, попадает в Error).
+// Остальные ответы разбираются как обычный JSON.
+func Consumer() runtime.Consumer {
+	return runtime.ConsumerFunc(func(reader io.Reader, data any) error {
+		target, ok := data.(*models.ErrorResponse)
+		if !ok {
+			return json.NewDecoder(reader).Decode(data)
+		}
+
+		var details models.ProblemDetails
+
+		err := json.NewDecoder(reader).Decode(&details)
+		if err != nil {
+			return err
+		}
+
+		message := details.Detail
+		if message == "" && details.Title != nil {
+			message = *details.Title
+		}
+
+		*target = models.ErrorResponse{
+			Code:         details.Code,
+			Error:        &message,
+			Details:      details.Details,
+			IncidentID:   details.IncidentID,
+			DebugMessage: details.DebugMessage,
+		}
+
+		return nil
+	})
+}
@@ -19,7 +19,7 @@ import (
 // swagger:model CreateUserRequest
 type CreateUserRequest struct {
 
-	// name
+	// Unique among all users, including deleted ones
 	// Required: true
 	Name *string `json:"name"`
 }
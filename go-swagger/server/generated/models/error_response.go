@@ -7,6 +7,8 @@ package models
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,13 +21,43 @@ import (
 // swagger:model ErrorResponse
 type ErrorResponse struct {
 
-	// code
+	// Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+	// * `-1` Internal (HTTP 500) - unexpected error
+	//
 	// Required: true
+	// Enum: [404,410,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,-1]
 	Code *int64 `json:"code"`
 
+	// Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)
+	DebugMessage string `json:"debug_message,omitempty"`
+
+	// Field-level violations; set only for validation errors (code 3)
+	Details []*ValidationErrorDetail `json:"details,omitempty"`
+
 	// error
 	// Required: true
 	Error *string `json:"error"`
+
+	// Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id
+	IncidentID string `json:"incident_id,omitempty"`
 }
 
 // Validate validates this error response
@@ -36,6 +68,10 @@ func (m *ErrorResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateError(formats); err != nil {
 		res = append(res, err)
 	}
@@ -46,12 +82,63 @@ func (m *ErrorResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var errorResponseTypeCodePropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[404,410,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,-1]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		errorResponseTypeCodePropEnum = append(errorResponseTypeCodePropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *ErrorResponse) validateCodeEnum(path, location string, value int64) error {
+	if err := validate.EnumCase(path, location, value, errorResponseTypeCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (m *ErrorResponse) validateCode(formats strfmt.Registry) error {
 
 	if err := validate.Required("code", "body", m.Code); err != nil {
 		return err
 	}
 
+	// value enum
+	if err := m.validateCodeEnum("code", "body", *m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ErrorResponse) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Details); i++ {
+		if swag.IsZero(m.Details[i]) { // not required
+			continue
+		}
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -64,8 +151,42 @@ func (m *ErrorResponse) validateError(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this error response based on context it is used
+// ContextValidate validate this error response based on the context it is used
 func (m *ErrorResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ErrorResponse) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Details); i++ {
+
+		if m.Details[i] != nil {
+
+			if swag.IsZero(m.Details[i]) { // not required
+				return nil
+			}
+
+			if err := m.Details[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PatchUserRequest patch user request
+//
+// swagger:model PatchUserRequest
+type PatchUserRequest struct {
+
+	// Unique among all users, including deleted ones
+	Name *string `json:"name,omitempty"`
+}
+
+// Validate validates this patch user request
+func (m *PatchUserRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this patch user request based on context it is used
+func (m *PatchUserRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PatchUserRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PatchUserRequest) UnmarshalBinary(b []byte) error {
+	var res PatchUserRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
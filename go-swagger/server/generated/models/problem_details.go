@@ -0,0 +1,250 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ProblemDetails RFC 7807 problem details. Returned instead of ErrorResponse when the client prefers
+// application/problem+json in Accept.
+//
+// swagger:model ProblemDetails
+type ProblemDetails struct {
+
+	// Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+	// * `-1` Internal (HTTP 500) - unexpected error
+	//
+	// Required: true
+	// Enum: [404,410,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,-1]
+	Code *int64 `json:"code"`
+
+	// Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)
+	DebugMessage string `json:"debug_message,omitempty"`
+
+	// Explanation of this occurrence of the problem; error of ErrorResponse
+	Detail string `json:"detail,omitempty"`
+
+	// Field-level violations; set only for validation errors (code 3)
+	Details []*ValidationErrorDetail `json:"details,omitempty"`
+
+	// Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id
+	IncidentID string `json:"incident_id,omitempty"`
+
+	// Path of the request that caused the problem
+	Instance string `json:"instance,omitempty"`
+
+	// HTTP status code
+	// Required: true
+	Status *int64 `json:"status"`
+
+	// Short summary of the problem type
+	// Required: true
+	Title *string `json:"title"`
+
+	// Problem type URI; about:blank when the status code says it all
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this problem details
+func (m *ProblemDetails) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTitle(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var problemDetailsTypeCodePropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[404,410,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,-1]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		problemDetailsTypeCodePropEnum = append(problemDetailsTypeCodePropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *ProblemDetails) validateCodeEnum(path, location string, value int64) error {
+	if err := validate.EnumCase(path, location, value, problemDetailsTypeCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ProblemDetails) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCodeEnum("code", "body", *m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProblemDetails) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Details); i++ {
+		if swag.IsZero(m.Details[i]) { // not required
+			continue
+		}
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ProblemDetails) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProblemDetails) validateTitle(formats strfmt.Registry) error {
+
+	if err := validate.Required("title", "body", m.Title); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProblemDetails) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this problem details based on the context it is used
+func (m *ProblemDetails) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProblemDetails) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Details); i++ {
+
+		if m.Details[i] != nil {
+
+			if swag.IsZero(m.Details[i]) { // not required
+				return nil
+			}
+
+			if err := m.Details[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ProblemDetails) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ProblemDetails) UnmarshalBinary(b []byte) error {
+	var res ProblemDetails
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
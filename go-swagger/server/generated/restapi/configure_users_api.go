@@ -42,11 +42,46 @@ func configureAPI(api *operations.UsersAPIAPI) http.Handler {
 			return middleware.NotImplemented("operation operations.CreateUser has not yet been implemented")
 		})
 	}
+	if api.CreateUsersBatchHandler == nil {
+		api.CreateUsersBatchHandler = operations.CreateUsersBatchHandlerFunc(func(params operations.CreateUsersBatchParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.CreateUsersBatch has not yet been implemented")
+		})
+	}
+	if api.DeleteUserHandler == nil {
+		api.DeleteUserHandler = operations.DeleteUserHandlerFunc(func(params operations.DeleteUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.DeleteUser has not yet been implemented")
+		})
+	}
 	if api.GetUserByIDHandler == nil {
 		api.GetUserByIDHandler = operations.GetUserByIDHandlerFunc(func(params operations.GetUserByIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.GetUserByID has not yet been implemented")
 		})
 	}
+	if api.GetUserHistoryHandler == nil {
+		api.GetUserHistoryHandler = operations.GetUserHistoryHandlerFunc(func(params operations.GetUserHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.GetUserHistory has not yet been implemented")
+		})
+	}
+	if api.ListUsersHandler == nil {
+		api.ListUsersHandler = operations.ListUsersHandlerFunc(func(params operations.ListUsersParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.ListUsers has not yet been implemented")
+		})
+	}
+	if api.PatchUserHandler == nil {
+		api.PatchUserHandler = operations.PatchUserHandlerFunc(func(params operations.PatchUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.PatchUser has not yet been implemented")
+		})
+	}
+	if api.RestoreUserHandler == nil {
+		api.RestoreUserHandler = operations.RestoreUserHandlerFunc(func(params operations.RestoreUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.RestoreUser has not yet been implemented")
+		})
+	}
+	if api.UpdateUserHandler == nil {
+		api.UpdateUserHandler = operations.UpdateUserHandlerFunc(func(params operations.UpdateUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation operations.UpdateUser has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
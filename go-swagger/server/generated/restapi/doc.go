@@ -7,12 +7,14 @@
 //	Host: localhost:8080
 //	BasePath: /
 //	Version: 1.0.0
+//	License: Company Internal
 //
 //	Consumes:
 //	  - application/json
 //
 //	Produces:
 //	  - application/json
+//	  - application/problem+json
 //
 // swagger:meta
 package restapi
@@ -18,21 +18,121 @@ var (
 
 func init() {
 	SwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json",
+    "application/problem+json"
+  ],
   "schemes": [
     "http"
   ],
   "swagger": "2.0",
   "info": {
     "title": "Users API",
+    "license": {
+      "name": "Company Internal"
+    },
     "version": "1.0.0"
   },
   "host": "localhost:8080",
   "paths": {
     "/users": {
+      "get": {
+        "summary": "List users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only users created strictly after this moment",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque cursor from next_cursor of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Also return deleted users; they have deleted_at set. Requires the operator token in X-Debug-Token, otherwise the request fails with 403 (code 15)",
+            "name": "include_deleted",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only users whose name starts with this prefix (case sensitive)",
+            "name": "name_prefix",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Comma separated sort fields (id, name, created_at), \"-\" prefix means descending, e.g. sort=name,-id. Unknown or duplicate fields are rejected with code 4. The cursor is bound to the sort it was issued for.",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ListUsersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 4 - invalid sort, code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden (code 15 - include_deleted without the operator token)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
       "post": {
         "summary": "Create user",
         "operationId": "CreateUser",
         "parameters": [
+          {
+            "maxLength": 255,
+            "minLength": 1,
+            "type": "string",
+            "description": "Makes retries safe: a repeated request with the same key and body gets the original response, the same key with another body is rejected with 422. Keys expire after a server-side TTL.",
+            "name": "Idempotency-Key",
+            "in": "header"
+          },
           {
             "name": "body",
             "in": "body",
@@ -49,8 +149,1432 @@ func init() {
               "$ref": "#/definitions/CreateUserResponse"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Request with the same Idempotency-Key is still in progress (code 7) or the name is already taken by another user (code 16)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Idempotency-Key was already used with another request body (code 6)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "summary": "Get user by ID",
+        "operationId": "GetUserById",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags known to the client; if the current one is among them, 304 is returned",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "304": {
+            "description": "Not Modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "summary": "Replace user",
+        "operationId": "UpdateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UpdateUserRequest"
+            }
+          },
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Name is already taken by another user (code 16)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "412": {
+            "description": "If-Match does not match the current ETag of the user (code 8)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      },
+      "delete": {
+        "description": "Marks the user as deleted. The user stays in storage as a tombstone: GetUserById answers 410, ListUsers shows it only with include_deleted, and RestoreUser brings it back.",
+        "summary": "Delete user",
+        "operationId": "DeleteUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "No Content"
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "patch": {
+        "summary": "Partially update user",
+        "operationId": "PatchUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PatchUserRequest"
+            }
+          },
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Name is already taken by another user (code 16)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "412": {
+            "description": "If-Match does not match the current ETag of the user (code 8)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      }
+    },
+    "/users/{id}/history": {
+      "get": {
+        "description": "Entries of the audit log for the user, oldest first. Every entry is hash-chained to the previous\nentry of the whole log, so history of deleted users is returned as well. A change is saved before\nit is logged: if the log write fails, the request still succeeds, the failure is recorded as a\nserver-side incident and the change is missing from the history.\n",
+        "summary": "Get user change history",
+        "operationId": "GetUserHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UserHistoryResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/users/{id}:restore": {
+      "post": {
+        "description": "Restoring a user that is not deleted is a no-op.",
+        "summary": "Restore deleted user",
+        "operationId": "RestoreUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/users:batch": {
+      "post": {
+        "description": "Every item gets its own result: either the id of the created user or an error. Without allOrNothing valid items are created even if others fail validation. With allOrNothing nothing is created if any item fails, and the response is 422 with code 5 for the items that were valid.",
+        "summary": "Create several users at once",
+        "operationId": "CreateUsersBatch",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateUsersBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/CreateUsersBatchResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A name is already taken by another user or repeated in the batch (code 16); nothing was created",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Batch rolled back, nothing was created",
+            "schema": {
+              "$ref": "#/definitions/CreateUsersBatchResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      }
+    }
+  },
+  "definitions": {
+    "CreateUserRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "Unique among all users, including deleted ones",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "CreateUserResponse": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer"
+        }
+      }
+    },
+    "CreateUsersBatchRequest": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "allOrNothing": {
+          "type": "boolean",
+          "default": false
+        },
+        "items": {
+          "type": "array",
+          "maxItems": 100,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/CreateUserRequest"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "CreateUsersBatchResponse": {
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "results": {
+          "description": "Results in the same order as request items",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CreateUsersBatchResult"
+          }
+        }
+      }
+    },
+    "CreateUsersBatchResult": {
+      "description": "Either id of the created user or error",
+      "type": "object",
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "type": "integer"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "type": "object",
+      "required": [
+        "code",
+        "error"
+      ],
+      "properties": {
+        "code": {
+          "description": "Error code. Generated from the error catalog (errcatalog), do not edit by hand.\n* ` + "`" + `404` + "`" + ` NotFound (HTTP 404) - user does not exist\n* ` + "`" + `410` + "`" + ` Gone (HTTP 410) - user is deleted and can be restored\n* ` + "`" + `1` + "`" + ` NotPublic1 (HTTP 500) - internal error 1\n* ` + "`" + `2` + "`" + ` NotPublic2 (HTTP 500) - internal error 2\n* ` + "`" + `3` + "`" + ` Validation (HTTP 400) - request validation failed, see details\n* ` + "`" + `4` + "`" + ` InvalidSort (HTTP 400) - unsupported sort field or direction\n* ` + "`" + `5` + "`" + ` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back\n* ` + "`" + `6` + "`" + ` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request\n* ` + "`" + `7` + "`" + ` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed\n* ` + "`" + `8` + "`" + ` PreconditionFailed (HTTP 412) - If-Match does not match the current user version\n* ` + "`" + `9` + "`" + ` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed\n* ` + "`" + `10` + "`" + ` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema\n* ` + "`" + `11` + "`" + ` RouteNotFound (HTTP 404) - no operation matches the request path\n* ` + "`" + `12` + "`" + ` MethodNotAllowed (HTTP 405) - the request path does not support the request method\n* ` + "`" + `13` + "`" + ` UnsupportedMediaType (HTTP 415) - request body content type is not supported\n* ` + "`" + `14` + "`" + ` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced\n* ` + "`" + `15` + "`" + ` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token\n* ` + "`" + `16` + "`" + ` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one\n* ` + "`" + `-1` + "`" + ` Internal (HTTP 500) - unexpected error\n",
+          "type": "integer",
+          "enum": [
+            404,
+            410,
+            1,
+            2,
+            3,
+            4,
+            5,
+            6,
+            7,
+            8,
+            9,
+            10,
+            11,
+            12,
+            13,
+            14,
+            15,
+            16,
+            -1
+          ],
+          "x-enum-varnames": [
+            "NotFound",
+            "Gone",
+            "NotPublic1",
+            "NotPublic2",
+            "Validation",
+            "InvalidSort",
+            "RolledBack",
+            "IdempotencyKeyMismatch",
+            "IdempotencyInProgress",
+            "PreconditionFailed",
+            "InvalidParameter",
+            "MalformedBody",
+            "RouteNotFound",
+            "MethodNotAllowed",
+            "UnsupportedMediaType",
+            "NotAcceptable",
+            "Forbidden",
+            "AlreadyExists",
+            "Internal"
+          ]
+        },
+        "debug_message": {
+          "description": "Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)",
+          "type": "string"
+        },
+        "details": {
+          "description": "Field-level violations; set only for validation errors (code 3)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ValidationErrorDetail"
+          },
+          "x-omitempty": true
+        },
+        "error": {
+          "type": "string"
+        },
+        "incident_id": {
+          "description": "Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id",
+          "type": "string"
+        }
+      }
+    },
+    "GetUserByIdResponse": {
+      "type": "object",
+      "required": [
+        "id",
+        "name"
+      ],
+      "properties": {
+        "deleted_at": {
+          "description": "Set only for deleted users, which ListUsers returns with include_deleted",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "ListUsersResponse": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GetUserByIdResponse"
+          }
+        },
+        "next_cursor": {
+          "description": "Cursor of the next page; absent on the last page",
+          "type": "string"
+        }
+      }
+    },
+    "PatchUserRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique among all users, including deleted ones",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "additionalProperties": false
+    },
+    "ProblemDetails": {
+      "description": "RFC 7807 problem details. Returned instead of ErrorResponse when the client prefers\napplication/problem+json in Accept.\n",
+      "type": "object",
+      "required": [
+        "type",
+        "title",
+        "status",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "description": "Error code. Generated from the error catalog (errcatalog), do not edit by hand.\n* ` + "`" + `404` + "`" + ` NotFound (HTTP 404) - user does not exist\n* ` + "`" + `410` + "`" + ` Gone (HTTP 410) - user is deleted and can be restored\n* ` + "`" + `1` + "`" + ` NotPublic1 (HTTP 500) - internal error 1\n* ` + "`" + `2` + "`" + ` NotPublic2 (HTTP 500) - internal error 2\n* ` + "`" + `3` + "`" + ` Validation (HTTP 400) - request validation failed, see details\n* ` + "`" + `4` + "`" + ` InvalidSort (HTTP 400) - unsupported sort field or direction\n* ` + "`" + `5` + "`" + ` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back\n* ` + "`" + `6` + "`" + ` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request\n* ` + "`" + `7` + "`" + ` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed\n* ` + "`" + `8` + "`" + ` PreconditionFailed (HTTP 412) - If-Match does not match the current user version\n* ` + "`" + `9` + "`" + ` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed\n* ` + "`" + `10` + "`" + ` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema\n* ` + "`" + `11` + "`" + ` RouteNotFound (HTTP 404) - no operation matches the request path\n* ` + "`" + `12` + "`" + ` MethodNotAllowed (HTTP 405) - the request path does not support the request method\n* ` + "`" + `13` + "`" + ` UnsupportedMediaType (HTTP 415) - request body content type is not supported\n* ` + "`" + `14` + "`" + ` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced\n* ` + "`" + `15` + "`" + ` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token\n* ` + "`" + `16` + "`" + ` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one\n* ` + "`" + `-1` + "`" + ` Internal (HTTP 500) - unexpected error\n",
+          "type": "integer",
+          "enum": [
+            404,
+            410,
+            1,
+            2,
+            3,
+            4,
+            5,
+            6,
+            7,
+            8,
+            9,
+            10,
+            11,
+            12,
+            13,
+            14,
+            15,
+            16,
+            -1
+          ],
+          "x-enum-varnames": [
+            "NotFound",
+            "Gone",
+            "NotPublic1",
+            "NotPublic2",
+            "Validation",
+            "InvalidSort",
+            "RolledBack",
+            "IdempotencyKeyMismatch",
+            "IdempotencyInProgress",
+            "PreconditionFailed",
+            "InvalidParameter",
+            "MalformedBody",
+            "RouteNotFound",
+            "MethodNotAllowed",
+            "UnsupportedMediaType",
+            "NotAcceptable",
+            "Forbidden",
+            "AlreadyExists",
+            "Internal"
+          ]
+        },
+        "debug_message": {
+          "description": "Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)",
+          "type": "string"
+        },
+        "detail": {
+          "description": "Explanation of this occurrence of the problem; error of ErrorResponse",
+          "type": "string"
+        },
+        "details": {
+          "description": "Field-level violations; set only for validation errors (code 3)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ValidationErrorDetail"
+          },
+          "x-omitempty": true
+        },
+        "incident_id": {
+          "description": "Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id",
+          "type": "string"
+        },
+        "instance": {
+          "description": "Path of the request that caused the problem",
+          "type": "string"
+        },
+        "status": {
+          "description": "HTTP status code",
+          "type": "integer"
+        },
+        "title": {
+          "description": "Short summary of the problem type",
+          "type": "string"
+        },
+        "type": {
+          "description": "Problem type URI; about:blank when the status code says it all",
+          "type": "string"
+        }
+      }
+    },
+    "UpdateUserRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "name": {
+          "description": "Unique among all users, including deleted ones",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "UserHistoryChange": {
+      "type": "object",
+      "required": [
+        "field",
+        "from",
+        "to"
+      ],
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "from": {
+          "description": "Value before the change; empty if there was none",
+          "type": "string"
+        },
+        "to": {
+          "description": "Value after the change; empty if there is none",
+          "type": "string"
+        }
+      }
+    },
+    "UserHistoryEntry": {
+      "type": "object",
+      "required": [
+        "seq",
+        "action",
+        "at",
+        "version",
+        "changes",
+        "prev_hash",
+        "hash"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "restore"
+          ]
+        },
+        "actor": {
+          "description": "\"operator\" for changes made with the operator token in X-Debug-Token; absent for other requests. The token is shared by all operators, so no individual principal is recorded.",
+          "type": "string"
+        },
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UserHistoryChange"
+          }
+        },
+        "hash": {
+          "description": "SHA-256 of the entry including prev_hash, hex encoded",
+          "type": "string"
+        },
+        "prev_hash": {
+          "description": "Hash of the previous entry of the audit log; empty for the first entry",
+          "type": "string"
+        },
+        "seq": {
+          "description": "Position of the entry in the whole audit log, starting with 1",
+          "type": "integer"
+        },
+        "version": {
+          "description": "Version of the user after the change",
+          "type": "integer"
+        }
+      }
+    },
+    "UserHistoryResponse": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UserHistoryEntry"
+          }
+        }
+      }
+    },
+    "ValidationErrorDetail": {
+      "type": "object",
+      "required": [
+        "field",
+        "rule",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "Request field that failed validation, e.g. name or limit",
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "rule": {
+          "description": "Violated rule - not_blank, length, charset, range, format, required, type or unknown",
+          "type": "string"
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json",
+    "application/problem+json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Users API",
+    "license": {
+      "name": "Company Internal"
+    },
+    "version": "1.0.0"
+  },
+  "host": "localhost:8080",
+  "paths": {
+    "/users": {
+      "get": {
+        "summary": "List users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only users created strictly after this moment",
+            "name": "created_after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque cursor from next_cursor of the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Also return deleted users; they have deleted_at set. Requires the operator token in X-Debug-Token, otherwise the request fails with 403 (code 15)",
+            "name": "include_deleted",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only users whose name starts with this prefix (case sensitive)",
+            "name": "name_prefix",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Comma separated sort fields (id, name, created_at), \"-\" prefix means descending, e.g. sort=name,-id. Unknown or duplicate fields are rejected with code 4. The cursor is bound to the sort it was issued for.",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ListUsersResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 4 - invalid sort, code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden (code 15 - include_deleted without the operator token)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Create user",
+        "operationId": "CreateUser",
+        "parameters": [
+          {
+            "maxLength": 255,
+            "minLength": 1,
+            "type": "string",
+            "description": "Makes retries safe: a repeated request with the same key and body gets the original response, the same key with another body is rejected with 422. Keys expire after a server-side TTL.",
+            "name": "Idempotency-Key",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/CreateUserResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Request with the same Idempotency-Key is still in progress (code 7) or the name is already taken by another user (code 16)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Idempotency-Key was already used with another request body (code 6)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      }
+    },
+    "/users/{id}": {
+      "get": {
+        "summary": "Get user by ID",
+        "operationId": "GetUserById",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETags known to the client; if the current one is among them, 304 is returned",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "304": {
+            "description": "Not Modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "summary": "Replace user",
+        "operationId": "UpdateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UpdateUserRequest"
+            }
+          },
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Name is already taken by another user (code 16)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "412": {
+            "description": "If-Match does not match the current ETag of the user (code 8)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      },
+      "delete": {
+        "description": "Marks the user as deleted. The user stays in storage as a tombstone: GetUserById answers 410, ListUsers shows it only with include_deleted, and RestoreUser brings it back.",
+        "summary": "Delete user",
+        "operationId": "DeleteUser",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "No Content"
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "patch": {
+        "summary": "Partially update user",
+        "operationId": "PatchUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.",
+            "name": "If-Match",
+            "in": "header",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PatchUserRequest"
+            }
+          },
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Name is already taken by another user (code 16)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "User has been deleted (code 410)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "412": {
+            "description": "If-Match does not match the current ETag of the user (code 8)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      }
+    },
+    "/users/{id}/history": {
+      "get": {
+        "description": "Entries of the audit log for the user, oldest first. Every entry is hash-chained to the previous\nentry of the whole log, so history of deleted users is returned as well. A change is saved before\nit is logged: if the log write fails, the request still succeeds, the failure is recorded as a\nserver-side incident and the change is missing from the history.\n",
+        "summary": "Get user change history",
+        "operationId": "GetUserHistory",
+        "parameters": [
+          {
+            "type": "integer",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UserHistoryResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -61,14 +1585,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-codegen-request-body-name": "body"
+        }
       }
     },
-    "/users/{id}": {
-      "get": {
-        "summary": "Get user by ID",
-        "operationId": "GetUserById",
+    "/users/{id}:restore": {
+      "post": {
+        "description": "Restoring a user that is not deleted is a no-op.",
+        "summary": "Restore deleted user",
+        "operationId": "RestoreUser",
         "parameters": [
           {
             "type": "integer",
@@ -82,6 +1606,18 @@ func init() {
             "description": "OK",
             "schema": {
               "$ref": "#/definitions/GetUserByIdResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Current version of the user"
+              }
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 9 - invalid parameter)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "404": {
@@ -90,6 +1626,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Internal Server Error",
             "schema": {
@@ -98,6 +1640,68 @@ func init() {
           }
         }
       }
+    },
+    "/users:batch": {
+      "post": {
+        "description": "Every item gets its own result: either the id of the created user or an error. Without allOrNothing valid items are created even if others fail validation. With allOrNothing nothing is created if any item fails, and the response is 422 with code 5 for the items that were valid.",
+        "summary": "Create several users at once",
+        "operationId": "CreateUsersBatch",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateUsersBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/CreateUsersBatchResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "406": {
+            "description": "Not Acceptable (code 14)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "A name is already taken by another user or repeated in the batch (code 16); nothing was created",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "415": {
+            "description": "Unsupported Media Type (code 13)",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Batch rolled back, nothing was created",
+            "schema": {
+              "$ref": "#/definitions/CreateUsersBatchResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-codegen-request-body-name": "body"
+      }
     }
   },
   "definitions": {
@@ -108,9 +1712,11 @@ func init() {
       ],
       "properties": {
         "name": {
+          "description": "Unique among all users, including deleted ones",
           "type": "string"
         }
-      }
+      },
+      "additionalProperties": false
     },
     "CreateUserResponse": {
       "type": "object",
@@ -123,6 +1729,54 @@ func init() {
         }
       }
     },
+    "CreateUsersBatchRequest": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "allOrNothing": {
+          "type": "boolean",
+          "default": false
+        },
+        "items": {
+          "type": "array",
+          "maxItems": 100,
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/CreateUserRequest"
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "CreateUsersBatchResponse": {
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "results": {
+          "description": "Results in the same order as request items",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/CreateUsersBatchResult"
+          }
+        }
+      }
+    },
+    "CreateUsersBatchResult": {
+      "description": "Either id of the created user or error",
+      "type": "object",
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/ErrorResponse"
+        },
+        "id": {
+          "type": "integer"
+        }
+      }
+    },
     "ErrorResponse": {
       "type": "object",
       "required": [
@@ -131,10 +1785,69 @@ func init() {
       ],
       "properties": {
         "code": {
-          "type": "integer"
+          "description": "Error code. Generated from the error catalog (errcatalog), do not edit by hand.\n* ` + "`" + `404` + "`" + ` NotFound (HTTP 404) - user does not exist\n* ` + "`" + `410` + "`" + ` Gone (HTTP 410) - user is deleted and can be restored\n* ` + "`" + `1` + "`" + ` NotPublic1 (HTTP 500) - internal error 1\n* ` + "`" + `2` + "`" + ` NotPublic2 (HTTP 500) - internal error 2\n* ` + "`" + `3` + "`" + ` Validation (HTTP 400) - request validation failed, see details\n* ` + "`" + `4` + "`" + ` InvalidSort (HTTP 400) - unsupported sort field or direction\n* ` + "`" + `5` + "`" + ` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back\n* ` + "`" + `6` + "`" + ` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request\n* ` + "`" + `7` + "`" + ` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed\n* ` + "`" + `8` + "`" + ` PreconditionFailed (HTTP 412) - If-Match does not match the current user version\n* ` + "`" + `9` + "`" + ` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed\n* ` + "`" + `10` + "`" + ` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema\n* ` + "`" + `11` + "`" + ` RouteNotFound (HTTP 404) - no operation matches the request path\n* ` + "`" + `12` + "`" + ` MethodNotAllowed (HTTP 405) - the request path does not support the request method\n* ` + "`" + `13` + "`" + ` UnsupportedMediaType (HTTP 415) - request body content type is not supported\n* ` + "`" + `14` + "`" + ` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced\n* ` + "`" + `15` + "`" + ` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token\n* ` + "`" + `16` + "`" + ` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one\n* ` + "`" + `-1` + "`" + ` Internal (HTTP 500) - unexpected error\n",
+          "type": "integer",
+          "enum": [
+            404,
+            410,
+            1,
+            2,
+            3,
+            4,
+            5,
+            6,
+            7,
+            8,
+            9,
+            10,
+            11,
+            12,
+            13,
+            14,
+            15,
+            16,
+            -1
+          ],
+          "x-enum-varnames": [
+            "NotFound",
+            "Gone",
+            "NotPublic1",
+            "NotPublic2",
+            "Validation",
+            "InvalidSort",
+            "RolledBack",
+            "IdempotencyKeyMismatch",
+            "IdempotencyInProgress",
+            "PreconditionFailed",
+            "InvalidParameter",
+            "MalformedBody",
+            "RouteNotFound",
+            "MethodNotAllowed",
+            "UnsupportedMediaType",
+            "NotAcceptable",
+            "Forbidden",
+            "AlreadyExists",
+            "Internal"
+          ]
+        },
+        "debug_message": {
+          "description": "Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)",
+          "type": "string"
+        },
+        "details": {
+          "description": "Field-level violations; set only for validation errors (code 3)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ValidationErrorDetail"
+          },
+          "x-omitempty": true
         },
         "error": {
           "type": "string"
+        },
+        "incident_id": {
+          "description": "Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id",
+          "type": "string"
         }
       }
     },
@@ -145,6 +1858,12 @@ func init() {
         "name"
       ],
       "properties": {
+        "deleted_at": {
+          "description": "Set only for deleted users, which ListUsers returns with include_deleted",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
         "id": {
           "type": "integer"
         },
@@ -152,141 +1871,248 @@ func init() {
           "type": "string"
         }
       }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Users API",
-    "version": "1.0.0"
-  },
-  "host": "localhost:8080",
-  "paths": {
-    "/users": {
-      "post": {
-        "summary": "Create user",
-        "operationId": "CreateUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CreateUserRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "$ref": "#/definitions/CreateUserResponse"
-            }
-          },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal Server Error",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    },
+    "ListUsersResponse": {
+      "type": "object",
+      "required": [
+        "items"
+      ],
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GetUserByIdResponse"
           }
         },
-        "x-codegen-request-body-name": "body"
+        "next_cursor": {
+          "description": "Cursor of the next page; absent on the last page",
+          "type": "string"
+        }
       }
     },
-    "/users/{id}": {
-      "get": {
-        "summary": "Get user by ID",
-        "operationId": "GetUserById",
-        "parameters": [
-          {
-            "type": "integer",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/GetUserByIdResponse"
-            }
-          },
-          "404": {
-            "description": "Not Found",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+    "PatchUserRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "Unique among all users, including deleted ones",
+          "type": "string",
+          "x-nullable": true
+        }
+      },
+      "additionalProperties": false
+    },
+    "ProblemDetails": {
+      "description": "RFC 7807 problem details. Returned instead of ErrorResponse when the client prefers\napplication/problem+json in Accept.\n",
+      "type": "object",
+      "required": [
+        "type",
+        "title",
+        "status",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "description": "Error code. Generated from the error catalog (errcatalog), do not edit by hand.\n* ` + "`" + `404` + "`" + ` NotFound (HTTP 404) - user does not exist\n* ` + "`" + `410` + "`" + ` Gone (HTTP 410) - user is deleted and can be restored\n* ` + "`" + `1` + "`" + ` NotPublic1 (HTTP 500) - internal error 1\n* ` + "`" + `2` + "`" + ` NotPublic2 (HTTP 500) - internal error 2\n* ` + "`" + `3` + "`" + ` Validation (HTTP 400) - request validation failed, see details\n* ` + "`" + `4` + "`" + ` InvalidSort (HTTP 400) - unsupported sort field or direction\n* ` + "`" + `5` + "`" + ` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back\n* ` + "`" + `6` + "`" + ` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request\n* ` + "`" + `7` + "`" + ` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed\n* ` + "`" + `8` + "`" + ` PreconditionFailed (HTTP 412) - If-Match does not match the current user version\n* ` + "`" + `9` + "`" + ` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed\n* ` + "`" + `10` + "`" + ` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema\n* ` + "`" + `11` + "`" + ` RouteNotFound (HTTP 404) - no operation matches the request path\n* ` + "`" + `12` + "`" + ` MethodNotAllowed (HTTP 405) - the request path does not support the request method\n* ` + "`" + `13` + "`" + ` UnsupportedMediaType (HTTP 415) - request body content type is not supported\n* ` + "`" + `14` + "`" + ` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced\n* ` + "`" + `15` + "`" + ` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token\n* ` + "`" + `16` + "`" + ` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one\n* ` + "`" + `-1` + "`" + ` Internal (HTTP 500) - unexpected error\n",
+          "type": "integer",
+          "enum": [
+            404,
+            410,
+            1,
+            2,
+            3,
+            4,
+            5,
+            6,
+            7,
+            8,
+            9,
+            10,
+            11,
+            12,
+            13,
+            14,
+            15,
+            16,
+            -1
+          ],
+          "x-enum-varnames": [
+            "NotFound",
+            "Gone",
+            "NotPublic1",
+            "NotPublic2",
+            "Validation",
+            "InvalidSort",
+            "RolledBack",
+            "IdempotencyKeyMismatch",
+            "IdempotencyInProgress",
+            "PreconditionFailed",
+            "InvalidParameter",
+            "MalformedBody",
+            "RouteNotFound",
+            "MethodNotAllowed",
+            "UnsupportedMediaType",
+            "NotAcceptable",
+            "Forbidden",
+            "AlreadyExists",
+            "Internal"
+          ]
+        },
+        "debug_message": {
+          "description": "Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)",
+          "type": "string"
+        },
+        "detail": {
+          "description": "Explanation of this occurrence of the problem; error of ErrorResponse",
+          "type": "string"
+        },
+        "details": {
+          "description": "Field-level violations; set only for validation errors (code 3)",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ValidationErrorDetail"
           },
-          "500": {
-            "description": "Internal Server Error",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+          "x-omitempty": true
+        },
+        "incident_id": {
+          "description": "Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id",
+          "type": "string"
+        },
+        "instance": {
+          "description": "Path of the request that caused the problem",
+          "type": "string"
+        },
+        "status": {
+          "description": "HTTP status code",
+          "type": "integer"
+        },
+        "title": {
+          "description": "Short summary of the problem type",
+          "type": "string"
+        },
+        "type": {
+          "description": "Problem type URI; about:blank when the status code says it all",
+          "type": "string"
         }
       }
-    }
-  },
-  "definitions": {
-    "CreateUserRequest": {
+    },
+    "UpdateUserRequest": {
       "type": "object",
       "required": [
         "name"
       ],
       "properties": {
         "name": {
+          "description": "Unique among all users, including deleted ones",
           "type": "string"
         }
-      }
+      },
+      "additionalProperties": false
     },
-    "CreateUserResponse": {
+    "UserHistoryChange": {
       "type": "object",
       "required": [
-        "id"
+        "field",
+        "from",
+        "to"
       ],
       "properties": {
-        "id": {
-          "type": "integer"
+        "field": {
+          "type": "string"
+        },
+        "from": {
+          "description": "Value before the change; empty if there was none",
+          "type": "string"
+        },
+        "to": {
+          "description": "Value after the change; empty if there is none",
+          "type": "string"
         }
       }
     },
-    "ErrorResponse": {
+    "UserHistoryEntry": {
       "type": "object",
       "required": [
-        "code",
-        "error"
+        "seq",
+        "action",
+        "at",
+        "version",
+        "changes",
+        "prev_hash",
+        "hash"
       ],
       "properties": {
-        "code": {
-          "type": "integer"
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "delete",
+            "restore"
+          ]
         },
-        "error": {
+        "actor": {
+          "description": "\"operator\" for changes made with the operator token in X-Debug-Token; absent for other requests. The token is shared by all operators, so no individual principal is recorded.",
+          "type": "string"
+        },
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UserHistoryChange"
+          }
+        },
+        "hash": {
+          "description": "SHA-256 of the entry including prev_hash, hex encoded",
+          "type": "string"
+        },
+        "prev_hash": {
+          "description": "Hash of the previous entry of the audit log; empty for the first entry",
           "type": "string"
+        },
+        "seq": {
+          "description": "Position of the entry in the whole audit log, starting with 1",
+          "type": "integer"
+        },
+        "version": {
+          "description": "Version of the user after the change",
+          "type": "integer"
         }
       }
     },
-    "GetUserByIdResponse": {
+    "UserHistoryResponse": {
       "type": "object",
       "required": [
-        "id",
-        "name"
+        "items"
       ],
       "properties": {
-        "id": {
-          "type": "integer"
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UserHistoryEntry"
+          }
+        }
+      }
+    },
+    "ValidationErrorDetail": {
+      "type": "object",
+      "required": [
+        "field",
+        "rule",
+        "message"
+      ],
+      "properties": {
+        "field": {
+          "description": "Request field that failed validation, e.g. name or limit",
+          "type": "string"
         },
-        "name": {
+        "message": {
+          "type": "string"
+        },
+        "rule": {
+          "description": "Violated rule - not_blank, length, charset, range, format, required, type or unknown",
           "type": "string"
         }
       }
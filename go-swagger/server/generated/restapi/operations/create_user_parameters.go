@@ -12,6 +12,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/validate"
 
 	"server/generated/models"
@@ -34,6 +35,12 @@ type CreateUserParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Makes retries safe: a repeated request with the same key and body gets the original response, the same key with another body is rejected with 422. Keys expire after a server-side TTL.
+	  Max Length: 255
+	  Min Length: 1
+	  In: header
+	*/
+	IdempotencyKey *string
 	/*
 	  Required: true
 	  In: body
@@ -50,6 +57,10 @@ func (o *CreateUserParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	o.HTTPRequest = r
 
+	if err := o.bindIdempotencyKey(r.Header[http.CanonicalHeaderKey("Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
 		var body models.CreateUserRequest
@@ -82,3 +93,38 @@ func (o *CreateUserParams) BindRequest(r *http.Request, route *middleware.Matche
 	}
 	return nil
 }
+
+// bindIdempotencyKey binds and validates parameter IdempotencyKey from header.
+func (o *CreateUserParams) bindIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IdempotencyKey = &raw
+
+	if err := o.validateIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIdempotencyKey carries on validations for parameter IdempotencyKey
+func (o *CreateUserParams) validateIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("Idempotency-Key", "header", *o.IdempotencyKey, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("Idempotency-Key", "header", *o.IdempotencyKey, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -62,7 +62,7 @@ func (o *CreateUserCreated) WriteResponse(rw http.ResponseWriter, producer runti
 const CreateUserBadRequestCode int = 400
 
 /*
-CreateUserBadRequest Bad Request
+CreateUserBadRequest Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
 
 swagger:response createUserBadRequest
 */
@@ -103,6 +103,186 @@ func (o *CreateUserBadRequest) WriteResponse(rw http.ResponseWriter, producer ru
 	}
 }
 
+// CreateUserNotAcceptableCode is the HTTP code returned for type CreateUserNotAcceptable
+const CreateUserNotAcceptableCode int = 406
+
+/*
+CreateUserNotAcceptable Not Acceptable (code 14)
+
+swagger:response createUserNotAcceptable
+*/
+type CreateUserNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUserNotAcceptable creates CreateUserNotAcceptable with default headers values
+func NewCreateUserNotAcceptable() *CreateUserNotAcceptable {
+
+	return &CreateUserNotAcceptable{}
+}
+
+// WithPayload adds the payload to the create user not acceptable response
+func (o *CreateUserNotAcceptable) WithPayload(payload *models.ErrorResponse) *CreateUserNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create user not acceptable response
+func (o *CreateUserNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUserNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUserConflictCode is the HTTP code returned for type CreateUserConflict
+const CreateUserConflictCode int = 409
+
+/*
+CreateUserConflict Request with the same Idempotency-Key is still in progress (code 7) or the name is already taken by another user (code 16)
+
+swagger:response createUserConflict
+*/
+type CreateUserConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUserConflict creates CreateUserConflict with default headers values
+func NewCreateUserConflict() *CreateUserConflict {
+
+	return &CreateUserConflict{}
+}
+
+// WithPayload adds the payload to the create user conflict response
+func (o *CreateUserConflict) WithPayload(payload *models.ErrorResponse) *CreateUserConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create user conflict response
+func (o *CreateUserConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUserConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUserUnsupportedMediaTypeCode is the HTTP code returned for type CreateUserUnsupportedMediaType
+const CreateUserUnsupportedMediaTypeCode int = 415
+
+/*
+CreateUserUnsupportedMediaType Unsupported Media Type (code 13)
+
+swagger:response createUserUnsupportedMediaType
+*/
+type CreateUserUnsupportedMediaType struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUserUnsupportedMediaType creates CreateUserUnsupportedMediaType with default headers values
+func NewCreateUserUnsupportedMediaType() *CreateUserUnsupportedMediaType {
+
+	return &CreateUserUnsupportedMediaType{}
+}
+
+// WithPayload adds the payload to the create user unsupported media type response
+func (o *CreateUserUnsupportedMediaType) WithPayload(payload *models.ErrorResponse) *CreateUserUnsupportedMediaType {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create user unsupported media type response
+func (o *CreateUserUnsupportedMediaType) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUserUnsupportedMediaType) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(415)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUserUnprocessableEntityCode is the HTTP code returned for type CreateUserUnprocessableEntity
+const CreateUserUnprocessableEntityCode int = 422
+
+/*
+CreateUserUnprocessableEntity Idempotency-Key was already used with another request body (code 6)
+
+swagger:response createUserUnprocessableEntity
+*/
+type CreateUserUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUserUnprocessableEntity creates CreateUserUnprocessableEntity with default headers values
+func NewCreateUserUnprocessableEntity() *CreateUserUnprocessableEntity {
+
+	return &CreateUserUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create user unprocessable entity response
+func (o *CreateUserUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *CreateUserUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create user unprocessable entity response
+func (o *CreateUserUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUserUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // CreateUserInternalServerErrorCode is the HTTP code returned for type CreateUserInternalServerError
 const CreateUserInternalServerErrorCode int = 500
 
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateUsersBatchHandlerFunc turns a function with the right signature into a create users batch handler
+type CreateUsersBatchHandlerFunc func(CreateUsersBatchParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateUsersBatchHandlerFunc) Handle(params CreateUsersBatchParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateUsersBatchHandler interface for that can handle valid create users batch params
+type CreateUsersBatchHandler interface {
+	Handle(CreateUsersBatchParams) middleware.Responder
+}
+
+// NewCreateUsersBatch creates a new http.Handler for the create users batch operation
+func NewCreateUsersBatch(ctx *middleware.Context, handler CreateUsersBatchHandler) *CreateUsersBatch {
+	return &CreateUsersBatch{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateUsersBatch swagger:route POST /users:batch createUsersBatch
+
+# Create several users at once
+
+Every item gets its own result: either the id of the created user or an error. Without allOrNothing valid items are created even if others fail validation. With allOrNothing nothing is created if any item fails, and the response is 422 with code 5 for the items that were valid.
+*/
+type CreateUsersBatch struct {
+	Context *middleware.Context
+	Handler CreateUsersBatchHandler
+}
+
+func (o *CreateUsersBatch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateUsersBatchParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,329 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// CreateUsersBatchOKCode is the HTTP code returned for type CreateUsersBatchOK
+const CreateUsersBatchOKCode int = 200
+
+/*
+CreateUsersBatchOK OK
+
+swagger:response createUsersBatchOK
+*/
+type CreateUsersBatchOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CreateUsersBatchResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchOK creates CreateUsersBatchOK with default headers values
+func NewCreateUsersBatchOK() *CreateUsersBatchOK {
+
+	return &CreateUsersBatchOK{}
+}
+
+// WithPayload adds the payload to the create users batch o k response
+func (o *CreateUsersBatchOK) WithPayload(payload *models.CreateUsersBatchResponse) *CreateUsersBatchOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch o k response
+func (o *CreateUsersBatchOK) SetPayload(payload *models.CreateUsersBatchResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUsersBatchBadRequestCode is the HTTP code returned for type CreateUsersBatchBadRequest
+const CreateUsersBatchBadRequestCode int = 400
+
+/*
+CreateUsersBatchBadRequest Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
+
+swagger:response createUsersBatchBadRequest
+*/
+type CreateUsersBatchBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchBadRequest creates CreateUsersBatchBadRequest with default headers values
+func NewCreateUsersBatchBadRequest() *CreateUsersBatchBadRequest {
+
+	return &CreateUsersBatchBadRequest{}
+}
+
+// WithPayload adds the payload to the create users batch bad request response
+func (o *CreateUsersBatchBadRequest) WithPayload(payload *models.ErrorResponse) *CreateUsersBatchBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch bad request response
+func (o *CreateUsersBatchBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUsersBatchNotAcceptableCode is the HTTP code returned for type CreateUsersBatchNotAcceptable
+const CreateUsersBatchNotAcceptableCode int = 406
+
+/*
+CreateUsersBatchNotAcceptable Not Acceptable (code 14)
+
+swagger:response createUsersBatchNotAcceptable
+*/
+type CreateUsersBatchNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchNotAcceptable creates CreateUsersBatchNotAcceptable with default headers values
+func NewCreateUsersBatchNotAcceptable() *CreateUsersBatchNotAcceptable {
+
+	return &CreateUsersBatchNotAcceptable{}
+}
+
+// WithPayload adds the payload to the create users batch not acceptable response
+func (o *CreateUsersBatchNotAcceptable) WithPayload(payload *models.ErrorResponse) *CreateUsersBatchNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch not acceptable response
+func (o *CreateUsersBatchNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUsersBatchConflictCode is the HTTP code returned for type CreateUsersBatchConflict
+const CreateUsersBatchConflictCode int = 409
+
+/*
+CreateUsersBatchConflict A name is already taken by another user or repeated in the batch (code 16); nothing was created
+
+swagger:response createUsersBatchConflict
+*/
+type CreateUsersBatchConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchConflict creates CreateUsersBatchConflict with default headers values
+func NewCreateUsersBatchConflict() *CreateUsersBatchConflict {
+
+	return &CreateUsersBatchConflict{}
+}
+
+// WithPayload adds the payload to the create users batch conflict response
+func (o *CreateUsersBatchConflict) WithPayload(payload *models.ErrorResponse) *CreateUsersBatchConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch conflict response
+func (o *CreateUsersBatchConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUsersBatchUnsupportedMediaTypeCode is the HTTP code returned for type CreateUsersBatchUnsupportedMediaType
+const CreateUsersBatchUnsupportedMediaTypeCode int = 415
+
+/*
+CreateUsersBatchUnsupportedMediaType Unsupported Media Type (code 13)
+
+swagger:response createUsersBatchUnsupportedMediaType
+*/
+type CreateUsersBatchUnsupportedMediaType struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchUnsupportedMediaType creates CreateUsersBatchUnsupportedMediaType with default headers values
+func NewCreateUsersBatchUnsupportedMediaType() *CreateUsersBatchUnsupportedMediaType {
+
+	return &CreateUsersBatchUnsupportedMediaType{}
+}
+
+// WithPayload adds the payload to the create users batch unsupported media type response
+func (o *CreateUsersBatchUnsupportedMediaType) WithPayload(payload *models.ErrorResponse) *CreateUsersBatchUnsupportedMediaType {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch unsupported media type response
+func (o *CreateUsersBatchUnsupportedMediaType) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchUnsupportedMediaType) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(415)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUsersBatchUnprocessableEntityCode is the HTTP code returned for type CreateUsersBatchUnprocessableEntity
+const CreateUsersBatchUnprocessableEntityCode int = 422
+
+/*
+CreateUsersBatchUnprocessableEntity Batch rolled back, nothing was created
+
+swagger:response createUsersBatchUnprocessableEntity
+*/
+type CreateUsersBatchUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CreateUsersBatchResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchUnprocessableEntity creates CreateUsersBatchUnprocessableEntity with default headers values
+func NewCreateUsersBatchUnprocessableEntity() *CreateUsersBatchUnprocessableEntity {
+
+	return &CreateUsersBatchUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the create users batch unprocessable entity response
+func (o *CreateUsersBatchUnprocessableEntity) WithPayload(payload *models.CreateUsersBatchResponse) *CreateUsersBatchUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch unprocessable entity response
+func (o *CreateUsersBatchUnprocessableEntity) SetPayload(payload *models.CreateUsersBatchResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateUsersBatchInternalServerErrorCode is the HTTP code returned for type CreateUsersBatchInternalServerError
+const CreateUsersBatchInternalServerErrorCode int = 500
+
+/*
+CreateUsersBatchInternalServerError Internal Server Error
+
+swagger:response createUsersBatchInternalServerError
+*/
+type CreateUsersBatchInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateUsersBatchInternalServerError creates CreateUsersBatchInternalServerError with default headers values
+func NewCreateUsersBatchInternalServerError() *CreateUsersBatchInternalServerError {
+
+	return &CreateUsersBatchInternalServerError{}
+}
+
+// WithPayload adds the payload to the create users batch internal server error response
+func (o *CreateUsersBatchInternalServerError) WithPayload(payload *models.ErrorResponse) *CreateUsersBatchInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create users batch internal server error response
+func (o *CreateUsersBatchInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateUsersBatchInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
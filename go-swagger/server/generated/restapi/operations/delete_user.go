@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteUserHandlerFunc turns a function with the right signature into a delete user handler
+type DeleteUserHandlerFunc func(DeleteUserParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteUserHandlerFunc) Handle(params DeleteUserParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteUserHandler interface for that can handle valid delete user params
+type DeleteUserHandler interface {
+	Handle(DeleteUserParams) middleware.Responder
+}
+
+// NewDeleteUser creates a new http.Handler for the delete user operation
+func NewDeleteUser(ctx *middleware.Context, handler DeleteUserHandler) *DeleteUser {
+	return &DeleteUser{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteUser swagger:route DELETE /users/{id} deleteUser
+
+# Delete user
+
+Marks the user as deleted. The user stays in storage as a tombstone: GetUserById answers 410, ListUsers shows it only with include_deleted, and RestoreUser brings it back.
+*/
+type DeleteUser struct {
+	Context *middleware.Context
+	Handler DeleteUserHandler
+}
+
+func (o *DeleteUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteUserParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// DeleteUserNoContentCode is the HTTP code returned for type DeleteUserNoContent
+const DeleteUserNoContentCode int = 204
+
+/*
+DeleteUserNoContent No Content
+
+swagger:response deleteUserNoContent
+*/
+type DeleteUserNoContent struct {
+}
+
+// NewDeleteUserNoContent creates DeleteUserNoContent with default headers values
+func NewDeleteUserNoContent() *DeleteUserNoContent {
+
+	return &DeleteUserNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteUserNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteUserBadRequestCode is the HTTP code returned for type DeleteUserBadRequest
+const DeleteUserBadRequestCode int = 400
+
+/*
+DeleteUserBadRequest Bad Request (code 9 - invalid parameter)
+
+swagger:response deleteUserBadRequest
+*/
+type DeleteUserBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserBadRequest creates DeleteUserBadRequest with default headers values
+func NewDeleteUserBadRequest() *DeleteUserBadRequest {
+
+	return &DeleteUserBadRequest{}
+}
+
+// WithPayload adds the payload to the delete user bad request response
+func (o *DeleteUserBadRequest) WithPayload(payload *models.ErrorResponse) *DeleteUserBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user bad request response
+func (o *DeleteUserBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserNotFoundCode is the HTTP code returned for type DeleteUserNotFound
+const DeleteUserNotFoundCode int = 404
+
+/*
+DeleteUserNotFound Not Found
+
+swagger:response deleteUserNotFound
+*/
+type DeleteUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserNotFound creates DeleteUserNotFound with default headers values
+func NewDeleteUserNotFound() *DeleteUserNotFound {
+
+	return &DeleteUserNotFound{}
+}
+
+// WithPayload adds the payload to the delete user not found response
+func (o *DeleteUserNotFound) WithPayload(payload *models.ErrorResponse) *DeleteUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user not found response
+func (o *DeleteUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserNotAcceptableCode is the HTTP code returned for type DeleteUserNotAcceptable
+const DeleteUserNotAcceptableCode int = 406
+
+/*
+DeleteUserNotAcceptable Not Acceptable (code 14)
+
+swagger:response deleteUserNotAcceptable
+*/
+type DeleteUserNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserNotAcceptable creates DeleteUserNotAcceptable with default headers values
+func NewDeleteUserNotAcceptable() *DeleteUserNotAcceptable {
+
+	return &DeleteUserNotAcceptable{}
+}
+
+// WithPayload adds the payload to the delete user not acceptable response
+func (o *DeleteUserNotAcceptable) WithPayload(payload *models.ErrorResponse) *DeleteUserNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user not acceptable response
+func (o *DeleteUserNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserGoneCode is the HTTP code returned for type DeleteUserGone
+const DeleteUserGoneCode int = 410
+
+/*
+DeleteUserGone User has been deleted (code 410)
+
+swagger:response deleteUserGone
+*/
+type DeleteUserGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserGone creates DeleteUserGone with default headers values
+func NewDeleteUserGone() *DeleteUserGone {
+
+	return &DeleteUserGone{}
+}
+
+// WithPayload adds the payload to the delete user gone response
+func (o *DeleteUserGone) WithPayload(payload *models.ErrorResponse) *DeleteUserGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user gone response
+func (o *DeleteUserGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteUserInternalServerErrorCode is the HTTP code returned for type DeleteUserInternalServerError
+const DeleteUserInternalServerErrorCode int = 500
+
+/*
+DeleteUserInternalServerError Internal Server Error
+
+swagger:response deleteUserInternalServerError
+*/
+type DeleteUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDeleteUserInternalServerError creates DeleteUserInternalServerError with default headers values
+func NewDeleteUserInternalServerError() *DeleteUserInternalServerError {
+
+	return &DeleteUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the delete user internal server error response
+func (o *DeleteUserInternalServerError) WithPayload(payload *models.ErrorResponse) *DeleteUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete user internal server error response
+func (o *DeleteUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
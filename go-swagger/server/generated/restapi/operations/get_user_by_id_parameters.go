@@ -31,6 +31,10 @@ type GetUserByIDParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*ETags known to the client; if the current one is among them, 304 is returned
+	  In: header
+	*/
+	IfNoneMatch *string
 	/*
 	  Required: true
 	  In: path
@@ -47,6 +51,10 @@ func (o *GetUserByIDParams) BindRequest(r *http.Request, route *middleware.Match
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -57,6 +65,23 @@ func (o *GetUserByIDParams) BindRequest(r *http.Request, route *middleware.Match
 	return nil
 }
 
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetUserByIDParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *GetUserByIDParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
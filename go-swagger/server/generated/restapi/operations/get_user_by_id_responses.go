@@ -22,6 +22,10 @@ GetUserByIDOK OK
 swagger:response getUserByIdOK
 */
 type GetUserByIDOK struct {
+	/*Current version of the user
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -35,6 +39,17 @@ func NewGetUserByIDOK() *GetUserByIDOK {
 	return &GetUserByIDOK{}
 }
 
+// WithETag adds the eTag to the get user by Id o k response
+func (o *GetUserByIDOK) WithETag(eTag string) *GetUserByIDOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get user by Id o k response
+func (o *GetUserByIDOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get user by Id o k response
 func (o *GetUserByIDOK) WithPayload(payload *models.GetUserByIDResponse) *GetUserByIDOK {
 	o.Payload = payload
@@ -49,6 +64,13 @@ func (o *GetUserByIDOK) SetPayload(payload *models.GetUserByIDResponse) {
 // WriteResponse to the client
 func (o *GetUserByIDOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -58,6 +80,98 @@ func (o *GetUserByIDOK) WriteResponse(rw http.ResponseWriter, producer runtime.P
 	}
 }
 
+// GetUserByIDNotModifiedCode is the HTTP code returned for type GetUserByIDNotModified
+const GetUserByIDNotModifiedCode int = 304
+
+/*
+GetUserByIDNotModified Not Modified
+
+swagger:response getUserByIdNotModified
+*/
+type GetUserByIDNotModified struct {
+	/*Current version of the user
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewGetUserByIDNotModified creates GetUserByIDNotModified with default headers values
+func NewGetUserByIDNotModified() *GetUserByIDNotModified {
+
+	return &GetUserByIDNotModified{}
+}
+
+// WithETag adds the eTag to the get user by Id not modified response
+func (o *GetUserByIDNotModified) WithETag(eTag string) *GetUserByIDNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get user by Id not modified response
+func (o *GetUserByIDNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *GetUserByIDNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
+// GetUserByIDBadRequestCode is the HTTP code returned for type GetUserByIDBadRequest
+const GetUserByIDBadRequestCode int = 400
+
+/*
+GetUserByIDBadRequest Bad Request (code 9 - invalid parameter)
+
+swagger:response getUserByIdBadRequest
+*/
+type GetUserByIDBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserByIDBadRequest creates GetUserByIDBadRequest with default headers values
+func NewGetUserByIDBadRequest() *GetUserByIDBadRequest {
+
+	return &GetUserByIDBadRequest{}
+}
+
+// WithPayload adds the payload to the get user by Id bad request response
+func (o *GetUserByIDBadRequest) WithPayload(payload *models.ErrorResponse) *GetUserByIDBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user by Id bad request response
+func (o *GetUserByIDBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserByIDBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetUserByIDNotFoundCode is the HTTP code returned for type GetUserByIDNotFound
 const GetUserByIDNotFoundCode int = 404
 
@@ -103,6 +217,96 @@ func (o *GetUserByIDNotFound) WriteResponse(rw http.ResponseWriter, producer run
 	}
 }
 
+// GetUserByIDNotAcceptableCode is the HTTP code returned for type GetUserByIDNotAcceptable
+const GetUserByIDNotAcceptableCode int = 406
+
+/*
+GetUserByIDNotAcceptable Not Acceptable (code 14)
+
+swagger:response getUserByIdNotAcceptable
+*/
+type GetUserByIDNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserByIDNotAcceptable creates GetUserByIDNotAcceptable with default headers values
+func NewGetUserByIDNotAcceptable() *GetUserByIDNotAcceptable {
+
+	return &GetUserByIDNotAcceptable{}
+}
+
+// WithPayload adds the payload to the get user by Id not acceptable response
+func (o *GetUserByIDNotAcceptable) WithPayload(payload *models.ErrorResponse) *GetUserByIDNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user by Id not acceptable response
+func (o *GetUserByIDNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserByIDNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserByIDGoneCode is the HTTP code returned for type GetUserByIDGone
+const GetUserByIDGoneCode int = 410
+
+/*
+GetUserByIDGone User has been deleted (code 410)
+
+swagger:response getUserByIdGone
+*/
+type GetUserByIDGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserByIDGone creates GetUserByIDGone with default headers values
+func NewGetUserByIDGone() *GetUserByIDGone {
+
+	return &GetUserByIDGone{}
+}
+
+// WithPayload adds the payload to the get user by Id gone response
+func (o *GetUserByIDGone) WithPayload(payload *models.ErrorResponse) *GetUserByIDGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user by Id gone response
+func (o *GetUserByIDGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserByIDGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetUserByIDInternalServerErrorCode is the HTTP code returned for type GetUserByIDInternalServerError
 const GetUserByIDInternalServerErrorCode int = 500
 
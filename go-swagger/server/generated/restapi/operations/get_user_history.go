@@ -0,0 +1,61 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetUserHistoryHandlerFunc turns a function with the right signature into a get user history handler
+type GetUserHistoryHandlerFunc func(GetUserHistoryParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetUserHistoryHandlerFunc) Handle(params GetUserHistoryParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetUserHistoryHandler interface for that can handle valid get user history params
+type GetUserHistoryHandler interface {
+	Handle(GetUserHistoryParams) middleware.Responder
+}
+
+// NewGetUserHistory creates a new http.Handler for the get user history operation
+func NewGetUserHistory(ctx *middleware.Context, handler GetUserHistoryHandler) *GetUserHistory {
+	return &GetUserHistory{Context: ctx, Handler: handler}
+}
+
+/*
+	GetUserHistory swagger:route GET /users/{id}/history getUserHistory
+
+# Get user change history
+
+Entries of the audit log for the user, oldest first. Every entry is hash-chained to the previous
+entry of the whole log, so history of deleted users is returned as well. A change is saved before
+it is logged: if the log write fails, the request still succeeds, the failure is recorded as a
+server-side incident and the change is missing from the history.
+*/
+type GetUserHistory struct {
+	Context *middleware.Context
+	Handler GetUserHistoryHandler
+}
+
+func (o *GetUserHistory) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetUserHistoryParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
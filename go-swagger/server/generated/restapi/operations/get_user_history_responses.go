@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// GetUserHistoryOKCode is the HTTP code returned for type GetUserHistoryOK
+const GetUserHistoryOKCode int = 200
+
+/*
+GetUserHistoryOK OK
+
+swagger:response getUserHistoryOK
+*/
+type GetUserHistoryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.UserHistoryResponse `json:"body,omitempty"`
+}
+
+// NewGetUserHistoryOK creates GetUserHistoryOK with default headers values
+func NewGetUserHistoryOK() *GetUserHistoryOK {
+
+	return &GetUserHistoryOK{}
+}
+
+// WithPayload adds the payload to the get user history o k response
+func (o *GetUserHistoryOK) WithPayload(payload *models.UserHistoryResponse) *GetUserHistoryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user history o k response
+func (o *GetUserHistoryOK) SetPayload(payload *models.UserHistoryResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserHistoryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserHistoryBadRequestCode is the HTTP code returned for type GetUserHistoryBadRequest
+const GetUserHistoryBadRequestCode int = 400
+
+/*
+GetUserHistoryBadRequest Bad Request (code 9 - invalid parameter)
+
+swagger:response getUserHistoryBadRequest
+*/
+type GetUserHistoryBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserHistoryBadRequest creates GetUserHistoryBadRequest with default headers values
+func NewGetUserHistoryBadRequest() *GetUserHistoryBadRequest {
+
+	return &GetUserHistoryBadRequest{}
+}
+
+// WithPayload adds the payload to the get user history bad request response
+func (o *GetUserHistoryBadRequest) WithPayload(payload *models.ErrorResponse) *GetUserHistoryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user history bad request response
+func (o *GetUserHistoryBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserHistoryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserHistoryNotFoundCode is the HTTP code returned for type GetUserHistoryNotFound
+const GetUserHistoryNotFoundCode int = 404
+
+/*
+GetUserHistoryNotFound Not Found
+
+swagger:response getUserHistoryNotFound
+*/
+type GetUserHistoryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserHistoryNotFound creates GetUserHistoryNotFound with default headers values
+func NewGetUserHistoryNotFound() *GetUserHistoryNotFound {
+
+	return &GetUserHistoryNotFound{}
+}
+
+// WithPayload adds the payload to the get user history not found response
+func (o *GetUserHistoryNotFound) WithPayload(payload *models.ErrorResponse) *GetUserHistoryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user history not found response
+func (o *GetUserHistoryNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserHistoryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserHistoryNotAcceptableCode is the HTTP code returned for type GetUserHistoryNotAcceptable
+const GetUserHistoryNotAcceptableCode int = 406
+
+/*
+GetUserHistoryNotAcceptable Not Acceptable (code 14)
+
+swagger:response getUserHistoryNotAcceptable
+*/
+type GetUserHistoryNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserHistoryNotAcceptable creates GetUserHistoryNotAcceptable with default headers values
+func NewGetUserHistoryNotAcceptable() *GetUserHistoryNotAcceptable {
+
+	return &GetUserHistoryNotAcceptable{}
+}
+
+// WithPayload adds the payload to the get user history not acceptable response
+func (o *GetUserHistoryNotAcceptable) WithPayload(payload *models.ErrorResponse) *GetUserHistoryNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user history not acceptable response
+func (o *GetUserHistoryNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserHistoryNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetUserHistoryInternalServerErrorCode is the HTTP code returned for type GetUserHistoryInternalServerError
+const GetUserHistoryInternalServerErrorCode int = 500
+
+/*
+GetUserHistoryInternalServerError Internal Server Error
+
+swagger:response getUserHistoryInternalServerError
+*/
+type GetUserHistoryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetUserHistoryInternalServerError creates GetUserHistoryInternalServerError with default headers values
+func NewGetUserHistoryInternalServerError() *GetUserHistoryInternalServerError {
+
+	return &GetUserHistoryInternalServerError{}
+}
+
+// WithPayload adds the payload to the get user history internal server error response
+func (o *GetUserHistoryInternalServerError) WithPayload(payload *models.ErrorResponse) *GetUserHistoryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user history internal server error response
+func (o *GetUserHistoryInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserHistoryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
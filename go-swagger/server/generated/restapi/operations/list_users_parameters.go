@@ -0,0 +1,287 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewListUsersParams creates a new ListUsersParams object
+// with the default values initialized.
+func NewListUsersParams() ListUsersParams {
+
+	var (
+		// initialize parameters with default values
+
+		includeDeletedDefault = bool(false)
+		limitDefault          = int64(20)
+	)
+
+	return ListUsersParams{
+		IncludeDeleted: &includeDeletedDefault,
+
+		Limit: &limitDefault,
+	}
+}
+
+// ListUsersParams contains all the bound params for the list users operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters ListUsers
+type ListUsersParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only users created strictly after this moment
+	  In: query
+	*/
+	CreatedAfter *strfmt.DateTime
+	/*Opaque cursor from next_cursor of the previous page
+	  In: query
+	*/
+	Cursor *string
+	/*Also return deleted users; they have deleted_at set. Requires the operator token in X-Debug-Token, otherwise the request fails with 403 (code 15)
+	  In: query
+	  Default: false
+	*/
+	IncludeDeleted *bool
+	/*
+	  Maximum: 100
+	  Minimum: 1
+	  In: query
+	  Default: 20
+	*/
+	Limit *int64
+	/*Only users whose name starts with this prefix (case sensitive)
+	  In: query
+	*/
+	NamePrefix *string
+	/*Comma separated sort fields (id, name, created_at), "-" prefix means descending, e.g. sort=name,-id. Unknown or duplicate fields are rejected with code 4. The cursor is bound to the sort it was issued for.
+	  In: query
+	  Collection Format: csv
+	*/
+	Sort []string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListUsersParams() beforehand.
+func (o *ListUsersParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qCreatedAfter, qhkCreatedAfter, _ := qs.GetOK("created_after")
+	if err := o.bindCreatedAfter(qCreatedAfter, qhkCreatedAfter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qIncludeDeleted, qhkIncludeDeleted, _ := qs.GetOK("include_deleted")
+	if err := o.bindIncludeDeleted(qIncludeDeleted, qhkIncludeDeleted, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNamePrefix, qhkNamePrefix, _ := qs.GetOK("name_prefix")
+	if err := o.bindNamePrefix(qNamePrefix, qhkNamePrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSort, qhkSort, _ := qs.GetOK("sort")
+	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCreatedAfter binds and validates parameter CreatedAfter from query.
+func (o *ListUsersParams) bindCreatedAfter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("created_after", "query", "strfmt.DateTime", raw)
+	}
+	o.CreatedAfter = (value.(*strfmt.DateTime))
+
+	if err := o.validateCreatedAfter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreatedAfter carries on validations for parameter CreatedAfter
+func (o *ListUsersParams) validateCreatedAfter(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("created_after", "query", "date-time", o.CreatedAfter.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListUsersParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindIncludeDeleted binds and validates parameter IncludeDeleted from query.
+func (o *ListUsersParams) bindIncludeDeleted(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListUsersParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("include_deleted", "query", "bool", raw)
+	}
+	o.IncludeDeleted = &value
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListUsersParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListUsersParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListUsersParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindNamePrefix binds and validates parameter NamePrefix from query.
+func (o *ListUsersParams) bindNamePrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.NamePrefix = &raw
+
+	return nil
+}
+
+// bindSort binds and validates array parameter Sort from query.
+//
+// Arrays are parsed according to CollectionFormat: "csv" (defaults to "csv" when empty).
+func (o *ListUsersParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvSort string
+	if len(rawData) > 0 {
+		qvSort = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat: csv
+	sortIC := swag.SplitByFormat(qvSort, "csv")
+	if len(sortIC) == 0 {
+		return nil
+	}
+
+	var sortIR []string
+	for _, sortIV := range sortIC {
+		sortI := sortIV
+
+		sortIR = append(sortIR, sortI)
+	}
+
+	o.Sort = sortIR
+
+	return nil
+}
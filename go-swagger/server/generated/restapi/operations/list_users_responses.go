@@ -0,0 +1,239 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// ListUsersOKCode is the HTTP code returned for type ListUsersOK
+const ListUsersOKCode int = 200
+
+/*
+ListUsersOK OK
+
+swagger:response listUsersOK
+*/
+type ListUsersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListUsersResponse `json:"body,omitempty"`
+}
+
+// NewListUsersOK creates ListUsersOK with default headers values
+func NewListUsersOK() *ListUsersOK {
+
+	return &ListUsersOK{}
+}
+
+// WithPayload adds the payload to the list users o k response
+func (o *ListUsersOK) WithPayload(payload *models.ListUsersResponse) *ListUsersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users o k response
+func (o *ListUsersOK) SetPayload(payload *models.ListUsersResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUsersBadRequestCode is the HTTP code returned for type ListUsersBadRequest
+const ListUsersBadRequestCode int = 400
+
+/*
+ListUsersBadRequest Bad Request (code 3 - validation error, code 4 - invalid sort, code 9 - invalid parameter)
+
+swagger:response listUsersBadRequest
+*/
+type ListUsersBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListUsersBadRequest creates ListUsersBadRequest with default headers values
+func NewListUsersBadRequest() *ListUsersBadRequest {
+
+	return &ListUsersBadRequest{}
+}
+
+// WithPayload adds the payload to the list users bad request response
+func (o *ListUsersBadRequest) WithPayload(payload *models.ErrorResponse) *ListUsersBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users bad request response
+func (o *ListUsersBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUsersForbiddenCode is the HTTP code returned for type ListUsersForbidden
+const ListUsersForbiddenCode int = 403
+
+/*
+ListUsersForbidden Forbidden (code 15 - include_deleted without the operator token)
+
+swagger:response listUsersForbidden
+*/
+type ListUsersForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListUsersForbidden creates ListUsersForbidden with default headers values
+func NewListUsersForbidden() *ListUsersForbidden {
+
+	return &ListUsersForbidden{}
+}
+
+// WithPayload adds the payload to the list users forbidden response
+func (o *ListUsersForbidden) WithPayload(payload *models.ErrorResponse) *ListUsersForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users forbidden response
+func (o *ListUsersForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUsersNotAcceptableCode is the HTTP code returned for type ListUsersNotAcceptable
+const ListUsersNotAcceptableCode int = 406
+
+/*
+ListUsersNotAcceptable Not Acceptable (code 14)
+
+swagger:response listUsersNotAcceptable
+*/
+type ListUsersNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListUsersNotAcceptable creates ListUsersNotAcceptable with default headers values
+func NewListUsersNotAcceptable() *ListUsersNotAcceptable {
+
+	return &ListUsersNotAcceptable{}
+}
+
+// WithPayload adds the payload to the list users not acceptable response
+func (o *ListUsersNotAcceptable) WithPayload(payload *models.ErrorResponse) *ListUsersNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users not acceptable response
+func (o *ListUsersNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListUsersInternalServerErrorCode is the HTTP code returned for type ListUsersInternalServerError
+const ListUsersInternalServerErrorCode int = 500
+
+/*
+ListUsersInternalServerError Internal Server Error
+
+swagger:response listUsersInternalServerError
+*/
+type ListUsersInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListUsersInternalServerError creates ListUsersInternalServerError with default headers values
+func NewListUsersInternalServerError() *ListUsersInternalServerError {
+
+	return &ListUsersInternalServerError{}
+}
+
+// WithPayload adds the payload to the list users internal server error response
+func (o *ListUsersInternalServerError) WithPayload(payload *models.ErrorResponse) *ListUsersInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list users internal server error response
+func (o *ListUsersInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListUsersInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
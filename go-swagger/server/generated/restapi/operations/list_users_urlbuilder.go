@@ -0,0 +1,157 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ListUsersURL generates an URL for the list users operation
+type ListUsersURL struct {
+	CreatedAfter   *strfmt.DateTime
+	Cursor         *string
+	IncludeDeleted *bool
+	Limit          *int64
+	NamePrefix     *string
+	Sort           []string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListUsersURL) WithBasePath(bp string) *ListUsersURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListUsersURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListUsersURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/users"
+
+	_basePath := o._basePath
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var createdAfterQ string
+	if o.CreatedAfter != nil {
+		createdAfterQ = o.CreatedAfter.String()
+	}
+	if createdAfterQ != "" {
+		qs.Set("created_after", createdAfterQ)
+	}
+
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var includeDeletedQ string
+	if o.IncludeDeleted != nil {
+		includeDeletedQ = swag.FormatBool(*o.IncludeDeleted)
+	}
+	if includeDeletedQ != "" {
+		qs.Set("include_deleted", includeDeletedQ)
+	}
+
+	var limitQ string
+	if o.Limit != nil {
+		limitQ = swag.FormatInt64(*o.Limit)
+	}
+	if limitQ != "" {
+		qs.Set("limit", limitQ)
+	}
+
+	var namePrefixQ string
+	if o.NamePrefix != nil {
+		namePrefixQ = *o.NamePrefix
+	}
+	if namePrefixQ != "" {
+		qs.Set("name_prefix", namePrefixQ)
+	}
+
+	var sortIR []string
+	for _, sortI := range o.Sort {
+		sortIS := sortI
+		if sortIS != "" {
+			sortIR = append(sortIR, sortIS)
+		}
+	}
+
+	sort := swag.JoinByFormat(sortIR, "csv")
+
+	if len(sort) > 0 {
+		qsv := sort[0]
+		if qsv != "" {
+			qs.Set("sort", qsv)
+		}
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListUsersURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListUsersURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListUsersURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListUsersURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListUsersURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListUsersURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PatchUserHandlerFunc turns a function with the right signature into a patch user handler
+type PatchUserHandlerFunc func(PatchUserParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PatchUserHandlerFunc) Handle(params PatchUserParams) middleware.Responder {
+	return fn(params)
+}
+
+// PatchUserHandler interface for that can handle valid patch user params
+type PatchUserHandler interface {
+	Handle(PatchUserParams) middleware.Responder
+}
+
+// NewPatchUser creates a new http.Handler for the patch user operation
+func NewPatchUser(ctx *middleware.Context, handler PatchUserHandler) *PatchUser {
+	return &PatchUser{Context: ctx, Handler: handler}
+}
+
+/*
+	PatchUser swagger:route PATCH /users/{id} patchUser
+
+Partially update user
+*/
+type PatchUser struct {
+	Context *middleware.Context
+	Handler PatchUserHandler
+}
+
+func (o *PatchUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPatchUserParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// PatchUserOKCode is the HTTP code returned for type PatchUserOK
+const PatchUserOKCode int = 200
+
+/*
+PatchUserOK OK
+
+swagger:response patchUserOK
+*/
+type PatchUserOK struct {
+	/*Current version of the user
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetUserByIDResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserOK creates PatchUserOK with default headers values
+func NewPatchUserOK() *PatchUserOK {
+
+	return &PatchUserOK{}
+}
+
+// WithETag adds the eTag to the patch user o k response
+func (o *PatchUserOK) WithETag(eTag string) *PatchUserOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the patch user o k response
+func (o *PatchUserOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the patch user o k response
+func (o *PatchUserOK) WithPayload(payload *models.GetUserByIDResponse) *PatchUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user o k response
+func (o *PatchUserOK) SetPayload(payload *models.GetUserByIDResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserBadRequestCode is the HTTP code returned for type PatchUserBadRequest
+const PatchUserBadRequestCode int = 400
+
+/*
+PatchUserBadRequest Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
+
+swagger:response patchUserBadRequest
+*/
+type PatchUserBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserBadRequest creates PatchUserBadRequest with default headers values
+func NewPatchUserBadRequest() *PatchUserBadRequest {
+
+	return &PatchUserBadRequest{}
+}
+
+// WithPayload adds the payload to the patch user bad request response
+func (o *PatchUserBadRequest) WithPayload(payload *models.ErrorResponse) *PatchUserBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user bad request response
+func (o *PatchUserBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserNotFoundCode is the HTTP code returned for type PatchUserNotFound
+const PatchUserNotFoundCode int = 404
+
+/*
+PatchUserNotFound Not Found
+
+swagger:response patchUserNotFound
+*/
+type PatchUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserNotFound creates PatchUserNotFound with default headers values
+func NewPatchUserNotFound() *PatchUserNotFound {
+
+	return &PatchUserNotFound{}
+}
+
+// WithPayload adds the payload to the patch user not found response
+func (o *PatchUserNotFound) WithPayload(payload *models.ErrorResponse) *PatchUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user not found response
+func (o *PatchUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserNotAcceptableCode is the HTTP code returned for type PatchUserNotAcceptable
+const PatchUserNotAcceptableCode int = 406
+
+/*
+PatchUserNotAcceptable Not Acceptable (code 14)
+
+swagger:response patchUserNotAcceptable
+*/
+type PatchUserNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserNotAcceptable creates PatchUserNotAcceptable with default headers values
+func NewPatchUserNotAcceptable() *PatchUserNotAcceptable {
+
+	return &PatchUserNotAcceptable{}
+}
+
+// WithPayload adds the payload to the patch user not acceptable response
+func (o *PatchUserNotAcceptable) WithPayload(payload *models.ErrorResponse) *PatchUserNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user not acceptable response
+func (o *PatchUserNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserConflictCode is the HTTP code returned for type PatchUserConflict
+const PatchUserConflictCode int = 409
+
+/*
+PatchUserConflict Name is already taken by another user (code 16)
+
+swagger:response patchUserConflict
+*/
+type PatchUserConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserConflict creates PatchUserConflict with default headers values
+func NewPatchUserConflict() *PatchUserConflict {
+
+	return &PatchUserConflict{}
+}
+
+// WithPayload adds the payload to the patch user conflict response
+func (o *PatchUserConflict) WithPayload(payload *models.ErrorResponse) *PatchUserConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user conflict response
+func (o *PatchUserConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserGoneCode is the HTTP code returned for type PatchUserGone
+const PatchUserGoneCode int = 410
+
+/*
+PatchUserGone User has been deleted (code 410)
+
+swagger:response patchUserGone
+*/
+type PatchUserGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserGone creates PatchUserGone with default headers values
+func NewPatchUserGone() *PatchUserGone {
+
+	return &PatchUserGone{}
+}
+
+// WithPayload adds the payload to the patch user gone response
+func (o *PatchUserGone) WithPayload(payload *models.ErrorResponse) *PatchUserGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user gone response
+func (o *PatchUserGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserPreconditionFailedCode is the HTTP code returned for type PatchUserPreconditionFailed
+const PatchUserPreconditionFailedCode int = 412
+
+/*
+PatchUserPreconditionFailed If-Match does not match the current ETag of the user (code 8)
+
+swagger:response patchUserPreconditionFailed
+*/
+type PatchUserPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserPreconditionFailed creates PatchUserPreconditionFailed with default headers values
+func NewPatchUserPreconditionFailed() *PatchUserPreconditionFailed {
+
+	return &PatchUserPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the patch user precondition failed response
+func (o *PatchUserPreconditionFailed) WithPayload(payload *models.ErrorResponse) *PatchUserPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user precondition failed response
+func (o *PatchUserPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserUnsupportedMediaTypeCode is the HTTP code returned for type PatchUserUnsupportedMediaType
+const PatchUserUnsupportedMediaTypeCode int = 415
+
+/*
+PatchUserUnsupportedMediaType Unsupported Media Type (code 13)
+
+swagger:response patchUserUnsupportedMediaType
+*/
+type PatchUserUnsupportedMediaType struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserUnsupportedMediaType creates PatchUserUnsupportedMediaType with default headers values
+func NewPatchUserUnsupportedMediaType() *PatchUserUnsupportedMediaType {
+
+	return &PatchUserUnsupportedMediaType{}
+}
+
+// WithPayload adds the payload to the patch user unsupported media type response
+func (o *PatchUserUnsupportedMediaType) WithPayload(payload *models.ErrorResponse) *PatchUserUnsupportedMediaType {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user unsupported media type response
+func (o *PatchUserUnsupportedMediaType) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserUnsupportedMediaType) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(415)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PatchUserInternalServerErrorCode is the HTTP code returned for type PatchUserInternalServerError
+const PatchUserInternalServerErrorCode int = 500
+
+/*
+PatchUserInternalServerError Internal Server Error
+
+swagger:response patchUserInternalServerError
+*/
+type PatchUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPatchUserInternalServerError creates PatchUserInternalServerError with default headers values
+func NewPatchUserInternalServerError() *PatchUserInternalServerError {
+
+	return &PatchUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the patch user internal server error response
+func (o *PatchUserInternalServerError) WithPayload(payload *models.ErrorResponse) *PatchUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch user internal server error response
+func (o *PatchUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
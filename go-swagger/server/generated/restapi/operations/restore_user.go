@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RestoreUserHandlerFunc turns a function with the right signature into a restore user handler
+type RestoreUserHandlerFunc func(RestoreUserParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RestoreUserHandlerFunc) Handle(params RestoreUserParams) middleware.Responder {
+	return fn(params)
+}
+
+// RestoreUserHandler interface for that can handle valid restore user params
+type RestoreUserHandler interface {
+	Handle(RestoreUserParams) middleware.Responder
+}
+
+// NewRestoreUser creates a new http.Handler for the restore user operation
+func NewRestoreUser(ctx *middleware.Context, handler RestoreUserHandler) *RestoreUser {
+	return &RestoreUser{Context: ctx, Handler: handler}
+}
+
+/*
+	RestoreUser swagger:route POST /users/{id}:restore restoreUser
+
+# Restore deleted user
+
+Restoring a user that is not deleted is a no-op.
+*/
+type RestoreUser struct {
+	Context *middleware.Context
+	Handler RestoreUserHandler
+}
+
+func (o *RestoreUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRestoreUserParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,261 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// RestoreUserOKCode is the HTTP code returned for type RestoreUserOK
+const RestoreUserOKCode int = 200
+
+/*
+RestoreUserOK OK
+
+swagger:response restoreUserOK
+*/
+type RestoreUserOK struct {
+	/*Current version of the user
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetUserByIDResponse `json:"body,omitempty"`
+}
+
+// NewRestoreUserOK creates RestoreUserOK with default headers values
+func NewRestoreUserOK() *RestoreUserOK {
+
+	return &RestoreUserOK{}
+}
+
+// WithETag adds the eTag to the restore user o k response
+func (o *RestoreUserOK) WithETag(eTag string) *RestoreUserOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the restore user o k response
+func (o *RestoreUserOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the restore user o k response
+func (o *RestoreUserOK) WithPayload(payload *models.GetUserByIDResponse) *RestoreUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore user o k response
+func (o *RestoreUserOK) SetPayload(payload *models.GetUserByIDResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreUserBadRequestCode is the HTTP code returned for type RestoreUserBadRequest
+const RestoreUserBadRequestCode int = 400
+
+/*
+RestoreUserBadRequest Bad Request (code 9 - invalid parameter)
+
+swagger:response restoreUserBadRequest
+*/
+type RestoreUserBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRestoreUserBadRequest creates RestoreUserBadRequest with default headers values
+func NewRestoreUserBadRequest() *RestoreUserBadRequest {
+
+	return &RestoreUserBadRequest{}
+}
+
+// WithPayload adds the payload to the restore user bad request response
+func (o *RestoreUserBadRequest) WithPayload(payload *models.ErrorResponse) *RestoreUserBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore user bad request response
+func (o *RestoreUserBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreUserBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreUserNotFoundCode is the HTTP code returned for type RestoreUserNotFound
+const RestoreUserNotFoundCode int = 404
+
+/*
+RestoreUserNotFound Not Found
+
+swagger:response restoreUserNotFound
+*/
+type RestoreUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRestoreUserNotFound creates RestoreUserNotFound with default headers values
+func NewRestoreUserNotFound() *RestoreUserNotFound {
+
+	return &RestoreUserNotFound{}
+}
+
+// WithPayload adds the payload to the restore user not found response
+func (o *RestoreUserNotFound) WithPayload(payload *models.ErrorResponse) *RestoreUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore user not found response
+func (o *RestoreUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreUserNotAcceptableCode is the HTTP code returned for type RestoreUserNotAcceptable
+const RestoreUserNotAcceptableCode int = 406
+
+/*
+RestoreUserNotAcceptable Not Acceptable (code 14)
+
+swagger:response restoreUserNotAcceptable
+*/
+type RestoreUserNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRestoreUserNotAcceptable creates RestoreUserNotAcceptable with default headers values
+func NewRestoreUserNotAcceptable() *RestoreUserNotAcceptable {
+
+	return &RestoreUserNotAcceptable{}
+}
+
+// WithPayload adds the payload to the restore user not acceptable response
+func (o *RestoreUserNotAcceptable) WithPayload(payload *models.ErrorResponse) *RestoreUserNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore user not acceptable response
+func (o *RestoreUserNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreUserNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RestoreUserInternalServerErrorCode is the HTTP code returned for type RestoreUserInternalServerError
+const RestoreUserInternalServerErrorCode int = 500
+
+/*
+RestoreUserInternalServerError Internal Server Error
+
+swagger:response restoreUserInternalServerError
+*/
+type RestoreUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRestoreUserInternalServerError creates RestoreUserInternalServerError with default headers values
+func NewRestoreUserInternalServerError() *RestoreUserInternalServerError {
+
+	return &RestoreUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the restore user internal server error response
+func (o *RestoreUserInternalServerError) WithPayload(payload *models.ErrorResponse) *RestoreUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the restore user internal server error response
+func (o *RestoreUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RestoreUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,441 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"server/generated/models"
+)
+
+// UpdateUserOKCode is the HTTP code returned for type UpdateUserOK
+const UpdateUserOKCode int = 200
+
+/*
+UpdateUserOK OK
+
+swagger:response updateUserOK
+*/
+type UpdateUserOK struct {
+	/*Current version of the user
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetUserByIDResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserOK creates UpdateUserOK with default headers values
+func NewUpdateUserOK() *UpdateUserOK {
+
+	return &UpdateUserOK{}
+}
+
+// WithETag adds the eTag to the update user o k response
+func (o *UpdateUserOK) WithETag(eTag string) *UpdateUserOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the update user o k response
+func (o *UpdateUserOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the update user o k response
+func (o *UpdateUserOK) WithPayload(payload *models.GetUserByIDResponse) *UpdateUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user o k response
+func (o *UpdateUserOK) SetPayload(payload *models.GetUserByIDResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserBadRequestCode is the HTTP code returned for type UpdateUserBadRequest
+const UpdateUserBadRequestCode int = 400
+
+/*
+UpdateUserBadRequest Bad Request (code 3 - validation error, code 9 - invalid parameter, code 10 - malformed body)
+
+swagger:response updateUserBadRequest
+*/
+type UpdateUserBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserBadRequest creates UpdateUserBadRequest with default headers values
+func NewUpdateUserBadRequest() *UpdateUserBadRequest {
+
+	return &UpdateUserBadRequest{}
+}
+
+// WithPayload adds the payload to the update user bad request response
+func (o *UpdateUserBadRequest) WithPayload(payload *models.ErrorResponse) *UpdateUserBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user bad request response
+func (o *UpdateUserBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserNotFoundCode is the HTTP code returned for type UpdateUserNotFound
+const UpdateUserNotFoundCode int = 404
+
+/*
+UpdateUserNotFound Not Found
+
+swagger:response updateUserNotFound
+*/
+type UpdateUserNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserNotFound creates UpdateUserNotFound with default headers values
+func NewUpdateUserNotFound() *UpdateUserNotFound {
+
+	return &UpdateUserNotFound{}
+}
+
+// WithPayload adds the payload to the update user not found response
+func (o *UpdateUserNotFound) WithPayload(payload *models.ErrorResponse) *UpdateUserNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user not found response
+func (o *UpdateUserNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserNotAcceptableCode is the HTTP code returned for type UpdateUserNotAcceptable
+const UpdateUserNotAcceptableCode int = 406
+
+/*
+UpdateUserNotAcceptable Not Acceptable (code 14)
+
+swagger:response updateUserNotAcceptable
+*/
+type UpdateUserNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserNotAcceptable creates UpdateUserNotAcceptable with default headers values
+func NewUpdateUserNotAcceptable() *UpdateUserNotAcceptable {
+
+	return &UpdateUserNotAcceptable{}
+}
+
+// WithPayload adds the payload to the update user not acceptable response
+func (o *UpdateUserNotAcceptable) WithPayload(payload *models.ErrorResponse) *UpdateUserNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user not acceptable response
+func (o *UpdateUserNotAcceptable) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserConflictCode is the HTTP code returned for type UpdateUserConflict
+const UpdateUserConflictCode int = 409
+
+/*
+UpdateUserConflict Name is already taken by another user (code 16)
+
+swagger:response updateUserConflict
+*/
+type UpdateUserConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserConflict creates UpdateUserConflict with default headers values
+func NewUpdateUserConflict() *UpdateUserConflict {
+
+	return &UpdateUserConflict{}
+}
+
+// WithPayload adds the payload to the update user conflict response
+func (o *UpdateUserConflict) WithPayload(payload *models.ErrorResponse) *UpdateUserConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user conflict response
+func (o *UpdateUserConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserGoneCode is the HTTP code returned for type UpdateUserGone
+const UpdateUserGoneCode int = 410
+
+/*
+UpdateUserGone User has been deleted (code 410)
+
+swagger:response updateUserGone
+*/
+type UpdateUserGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserGone creates UpdateUserGone with default headers values
+func NewUpdateUserGone() *UpdateUserGone {
+
+	return &UpdateUserGone{}
+}
+
+// WithPayload adds the payload to the update user gone response
+func (o *UpdateUserGone) WithPayload(payload *models.ErrorResponse) *UpdateUserGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user gone response
+func (o *UpdateUserGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserPreconditionFailedCode is the HTTP code returned for type UpdateUserPreconditionFailed
+const UpdateUserPreconditionFailedCode int = 412
+
+/*
+UpdateUserPreconditionFailed If-Match does not match the current ETag of the user (code 8)
+
+swagger:response updateUserPreconditionFailed
+*/
+type UpdateUserPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserPreconditionFailed creates UpdateUserPreconditionFailed with default headers values
+func NewUpdateUserPreconditionFailed() *UpdateUserPreconditionFailed {
+
+	return &UpdateUserPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the update user precondition failed response
+func (o *UpdateUserPreconditionFailed) WithPayload(payload *models.ErrorResponse) *UpdateUserPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user precondition failed response
+func (o *UpdateUserPreconditionFailed) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserUnsupportedMediaTypeCode is the HTTP code returned for type UpdateUserUnsupportedMediaType
+const UpdateUserUnsupportedMediaTypeCode int = 415
+
+/*
+UpdateUserUnsupportedMediaType Unsupported Media Type (code 13)
+
+swagger:response updateUserUnsupportedMediaType
+*/
+type UpdateUserUnsupportedMediaType struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserUnsupportedMediaType creates UpdateUserUnsupportedMediaType with default headers values
+func NewUpdateUserUnsupportedMediaType() *UpdateUserUnsupportedMediaType {
+
+	return &UpdateUserUnsupportedMediaType{}
+}
+
+// WithPayload adds the payload to the update user unsupported media type response
+func (o *UpdateUserUnsupportedMediaType) WithPayload(payload *models.ErrorResponse) *UpdateUserUnsupportedMediaType {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user unsupported media type response
+func (o *UpdateUserUnsupportedMediaType) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserUnsupportedMediaType) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(415)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateUserInternalServerErrorCode is the HTTP code returned for type UpdateUserInternalServerError
+const UpdateUserInternalServerErrorCode int = 500
+
+/*
+UpdateUserInternalServerError Internal Server Error
+
+swagger:response updateUserInternalServerError
+*/
+type UpdateUserInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUpdateUserInternalServerError creates UpdateUserInternalServerError with default headers values
+func NewUpdateUserInternalServerError() *UpdateUserInternalServerError {
+
+	return &UpdateUserInternalServerError{}
+}
+
+// WithPayload adds the payload to the update user internal server error response
+func (o *UpdateUserInternalServerError) WithPayload(payload *models.ErrorResponse) *UpdateUserInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update user internal server error response
+func (o *UpdateUserInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateUserInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
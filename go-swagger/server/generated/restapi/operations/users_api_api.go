@@ -45,9 +45,30 @@ func NewUsersAPIAPI(spec *loads.Document) *UsersAPIAPI {
 		CreateUserHandler: CreateUserHandlerFunc(func(params CreateUserParams) middleware.Responder {
 			return middleware.NotImplemented("operation CreateUser has not yet been implemented")
 		}),
+		CreateUsersBatchHandler: CreateUsersBatchHandlerFunc(func(params CreateUsersBatchParams) middleware.Responder {
+			return middleware.NotImplemented("operation CreateUsersBatch has not yet been implemented")
+		}),
+		DeleteUserHandler: DeleteUserHandlerFunc(func(params DeleteUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteUser has not yet been implemented")
+		}),
 		GetUserByIDHandler: GetUserByIDHandlerFunc(func(params GetUserByIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetUserByID has not yet been implemented")
 		}),
+		GetUserHistoryHandler: GetUserHistoryHandlerFunc(func(params GetUserHistoryParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetUserHistory has not yet been implemented")
+		}),
+		ListUsersHandler: ListUsersHandlerFunc(func(params ListUsersParams) middleware.Responder {
+			return middleware.NotImplemented("operation ListUsers has not yet been implemented")
+		}),
+		PatchUserHandler: PatchUserHandlerFunc(func(params PatchUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation PatchUser has not yet been implemented")
+		}),
+		RestoreUserHandler: RestoreUserHandlerFunc(func(params RestoreUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation RestoreUser has not yet been implemented")
+		}),
+		UpdateUserHandler: UpdateUserHandlerFunc(func(params UpdateUserParams) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateUser has not yet been implemented")
+		}),
 	}
 }
 
@@ -82,12 +103,27 @@ type UsersAPIAPI struct {
 
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
+	//   - application/problem+json
 	JSONProducer runtime.Producer
 
 	// CreateUserHandler sets the operation handler for the create user operation
 	CreateUserHandler CreateUserHandler
+	// CreateUsersBatchHandler sets the operation handler for the create users batch operation
+	CreateUsersBatchHandler CreateUsersBatchHandler
+	// DeleteUserHandler sets the operation handler for the delete user operation
+	DeleteUserHandler DeleteUserHandler
 	// GetUserByIDHandler sets the operation handler for the get user by Id operation
 	GetUserByIDHandler GetUserByIDHandler
+	// GetUserHistoryHandler sets the operation handler for the get user history operation
+	GetUserHistoryHandler GetUserHistoryHandler
+	// ListUsersHandler sets the operation handler for the list users operation
+	ListUsersHandler ListUsersHandler
+	// PatchUserHandler sets the operation handler for the patch user operation
+	PatchUserHandler PatchUserHandler
+	// RestoreUserHandler sets the operation handler for the restore user operation
+	RestoreUserHandler RestoreUserHandler
+	// UpdateUserHandler sets the operation handler for the update user operation
+	UpdateUserHandler UpdateUserHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -168,9 +204,30 @@ func (o *UsersAPIAPI) Validate() error {
 	if o.CreateUserHandler == nil {
 		unregistered = append(unregistered, "CreateUserHandler")
 	}
+	if o.CreateUsersBatchHandler == nil {
+		unregistered = append(unregistered, "CreateUsersBatchHandler")
+	}
+	if o.DeleteUserHandler == nil {
+		unregistered = append(unregistered, "DeleteUserHandler")
+	}
 	if o.GetUserByIDHandler == nil {
 		unregistered = append(unregistered, "GetUserByIDHandler")
 	}
+	if o.GetUserHistoryHandler == nil {
+		unregistered = append(unregistered, "GetUserHistoryHandler")
+	}
+	if o.ListUsersHandler == nil {
+		unregistered = append(unregistered, "ListUsersHandler")
+	}
+	if o.PatchUserHandler == nil {
+		unregistered = append(unregistered, "PatchUserHandler")
+	}
+	if o.RestoreUserHandler == nil {
+		unregistered = append(unregistered, "RestoreUserHandler")
+	}
+	if o.UpdateUserHandler == nil {
+		unregistered = append(unregistered, "UpdateUserHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -219,6 +276,8 @@ func (o *UsersAPIAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produ
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/problem+json":
+			result["application/problem+json"] = o.JSONProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -263,10 +322,38 @@ func (o *UsersAPIAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/users"] = NewCreateUser(o.context, o.CreateUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users:batch"] = NewCreateUsersBatch(o.context, o.CreateUsersBatchHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/{id}"] = NewDeleteUser(o.context, o.DeleteUserHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/users/{id}"] = NewGetUserByID(o.context, o.GetUserByIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{id}/history"] = NewGetUserHistory(o.context, o.GetUserHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users"] = NewListUsers(o.context, o.ListUsersHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/users/{id}"] = NewPatchUser(o.context, o.PatchUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{id}:restore"] = NewRestoreUser(o.context, o.RestoreUserHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/users/{id}"] = NewUpdateUser(o.context, o.UpdateUserHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -2,83 +2,91 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	openapierrors "github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 
 	"server/generated/models"
 	"server/generated/restapi/operations"
-	"server/usecases"
+	"shared/errcatalog"
+	"shared/etag"
+	"shared/incident"
+	"shared/usecases"
 )
 
 type Handlers struct {
-	useCases UseCases
+	useCases  UseCases
+	incidents *incident.Reporter
 }
 
 type UseCases interface {
 	GetUser(ctx context.Context, id int) (usecases.User, error)
 	CreateUsers(ctx context.Context, userRequests usecases.CreateUserRequestDTO) (int, error)
+	CreateUsersBatch(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error)
+	UpdateUser(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO) (usecases.User, error)
+	PatchUser(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO) (usecases.User, error)
+	DeleteUser(ctx context.Context, id int) error
+	RestoreUser(ctx context.Context, id int) (usecases.User, error)
+	UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error)
+	ListUsers(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO) (usecases.UsersPage, error)
 }
 
-func New(useCases UseCases) *Handlers {
+func New(useCases UseCases, incidents *incident.Reporter) *Handlers {
 	return &Handlers{
-		useCases: useCases,
+		useCases:  useCases,
+		incidents: incidents,
 	}
 }
 
 func (h *Handlers) GetUsers(params operations.GetUserByIDParams) middleware.Responder {
 	user, err := h.useCases.GetUser(params.HTTPRequest.Context(), int(params.ID))
 	if err != nil {
-		switch {
-		case errors.Is(err, usecases.ErrNotFound):
-			resp := operations.
-				NewGetUserByIDNotFound().
-				WithPayload(
-					&models.ErrorResponse{
-						Code:  ToPtr(int64(404)),
-						Error: ToPtr("Not Found"),
-					},
-				)
+		entry := errcatalog.Lookup(err, errcatalog.NotFound, errcatalog.Gone, errcatalog.NotPublic1, errcatalog.NotPublic2)
 
-			return resp
-		case errors.Is(err, usecases.ErrNotPublic1):
+		switch entry.Status {
+		case http.StatusNotFound:
 			resp := operations.
-				NewGetUserByIDInternalServerError().
-				WithPayload(
-					&models.ErrorResponse{
-						Code:  ToPtr(int64(1)),
-						Error: ToPtr("Internal Server Error 1"),
-					},
-				)
+				NewGetUserByIDNotFound().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
 
 			return resp
-		case errors.Is(err, usecases.ErrNotPublic2):
+		case http.StatusGone:
 			resp := operations.
-				NewGetUserByIDInternalServerError().
-				WithPayload(
-					&models.ErrorResponse{
-						Code:  ToPtr(int64(2)),
-						Error: ToPtr("Internal Server Error 2"),
-					},
-				)
+				NewGetUserByIDGone().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
 
 			return resp
 		default:
 			resp := operations.
 				NewGetUserByIDInternalServerError().
-				WithPayload(
-					&models.ErrorResponse{
-						Code:  ToPtr(int64(-1)),
-						Error: ToPtr("Internal Server Error"),
-					},
-				)
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
 
 			return resp
 		}
 	}
 
+	if params.IfNoneMatch != nil && etag.NoneMatch(*params.IfNoneMatch, user.Version) {
+		resp := operations.
+			NewGetUserByIDNotModified().
+			WithETag(etag.Format(user.Version))
+
+		return resp
+	}
+
 	resp := operations.
 		NewGetUserByIDOK().
+		WithETag(etag.Format(user.Version)).
 		WithPayload(
 			&models.GetUserByIDResponse{
 				ID:   ToPtr(int64(user.ID)),
@@ -96,27 +104,25 @@ func (h *Handlers) CreateUsers(params operations.CreateUserParams) middleware.Re
 
 	id, err := h.useCases.CreateUsers(params.HTTPRequest.Context(), createUserRequestDTO)
 	if err != nil {
-		switch {
-		case errors.Is(err, usecases.ErrValidation):
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
 			resp := operations.
 				NewCreateUserBadRequest().
-				WithPayload(
-					&models.ErrorResponse{
-						Code:  ToPtr(int64(3)),
-						Error: ToPtr(err.Error()),
-					},
-				)
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusConflict:
+			resp := operations.
+				NewCreateUserConflict().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
 
 			return resp
 		default:
 			resp := operations.
 				NewCreateUserInternalServerError().
-				WithPayload(
-					&models.ErrorResponse{
-						Code:  ToPtr(int64(-1)),
-						Error: ToPtr("Internal Server Error"),
-					},
-				)
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
 
 			return resp
 		}
@@ -133,6 +139,682 @@ func (h *Handlers) CreateUsers(params operations.CreateUserParams) middleware.Re
 	return resp
 }
 
+func (h *Handlers) CreateUsersBatch(params operations.CreateUsersBatchParams) middleware.Responder {
+	createUsersBatchRequestDTO := usecases.CreateUsersBatchRequestDTO{
+		Items: make([]usecases.CreateUserRequestDTO, 0, len(params.Body.Items)),
+	}
+
+	for _, item := range params.Body.Items {
+		createUsersBatchRequestDTO.Items = append(createUsersBatchRequestDTO.Items, usecases.CreateUserRequestDTO{
+			Name: *item.Name,
+		})
+	}
+
+	if params.Body.AllOrNothing != nil {
+		createUsersBatchRequestDTO.AllOrNothing = *params.Body.AllOrNothing
+	}
+
+	batch, err := h.useCases.CreateUsersBatch(params.HTTPRequest.Context(), createUsersBatchRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			resp := operations.
+				NewCreateUsersBatchBadRequest().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusConflict:
+			resp := operations.
+				NewCreateUsersBatchConflict().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewCreateUsersBatchInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	payload := &models.CreateUsersBatchResponse{
+		Results: make([]*models.CreateUsersBatchResult, 0, len(batch.Results)),
+	}
+
+	for _, result := range batch.Results {
+		if result.Err != nil {
+			payload.Results = append(payload.Results, &models.CreateUsersBatchResult{
+				Error: h.batchItemError(params.HTTPRequest.Context(), result.Err),
+			})
+
+			continue
+		}
+
+		payload.Results = append(payload.Results, &models.CreateUsersBatchResult{
+			ID: int64(result.ID),
+		})
+	}
+
+	if batch.RolledBack {
+		return operations.NewCreateUsersBatchUnprocessableEntity().WithPayload(payload)
+	}
+
+	return operations.NewCreateUsersBatchOK().WithPayload(payload)
+}
+
+// batchItemError - ошибка отдельного элемента пакетного создания
+func (h *Handlers) batchItemError(ctx context.Context, err error) *models.ErrorResponse {
+	return h.errorResponse(ctx, err, errcatalog.Lookup(err, errcatalog.Validation, errcatalog.RolledBack))
+}
+
+// ServeError - обработчик ошибок go-swagger (UsersAPIAPI.ServeError): ошибки маршрутизации, разбора и валидации
+// параметров и тела до вызова обработчика отдаются в виде ErrorResponse.
+func (h *Handlers) ServeError(w http.ResponseWriter, r *http.Request, err error) {
+	var methodErr *openapierrors.MethodNotAllowedError
+	if errors.As(err, &methodErr) {
+		// роутер go-swagger собирает методы из map, порядок нужно зафиксировать
+		allowed := slices.Clone(methodErr.Allowed)
+		slices.Sort(allowed)
+
+		w.Header().Set("Allow", strings.Join(allowed, ","))
+	}
+
+	entry, err := swaggerError(err)
+
+	h.writeEntry(w, r, err, entry)
+}
+
+// JSONConsumer разбирает JSON-тело запроса (UsersAPIAPI.JSONConsumer), как runtime.JSONConsumer, но отклоняет
+// неизвестные поля: в спецификации у тел запросов additionalProperties: false, а сгенерированные модели лишние
+// поля пропускают.
+func (h *Handlers) JSONConsumer(reader io.Reader, data any) error {
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(data)
+}
+
+func (h *Handlers) writeEntry(w http.ResponseWriter, r *http.Request, err error, entry errcatalog.Entry) {
+	responseBytes, marshalErr := json.Marshal(h.errorResponse(r.Context(), err, entry))
+	if marshalErr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(entry.Status)
+
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(responseBytes)
+	}
+}
+
+// swaggerError выбирает запись каталога для ошибки go-swagger и ошибку, текст которой получит клиент. Как и в
+// shared/validation, нарушения схемы собираются в одну ошибку валидации, а остальные ошибки (неразобранный
+// параметр, нет тела, неподдерживаемый Content-Type) важнее их.
+func swaggerError(err error) (errcatalog.Entry, error) {
+	var fields []usecases.FieldError
+
+	for _, inner := range swaggerErrors(err) {
+		field, ok := swaggerFieldError(inner)
+		if !ok {
+			return swaggerErrorEntry(inner), inner
+		}
+
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return swaggerErrorEntry(err), err
+	}
+
+	// порядок полей как в shared/validation, чтобы ответы серверов совпадали
+	slices.SortStableFunc(fields, func(a, b usecases.FieldError) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return errcatalog.Validation, &usecases.ValidationError{Fields: fields}
+}
+
+// swaggerErrors раскладывает составную ошибку go-swagger на ошибки отдельных параметров и полей.
+func swaggerErrors(err error) []error {
+	var composite *openapierrors.CompositeError
+	if !errors.As(err, &composite) {
+		return []error{err}
+	}
+
+	var errs []error
+
+	for _, inner := range composite.Errors {
+		errs = append(errs, swaggerErrors(inner)...)
+	}
+
+	return errs
+}
+
+// swaggerFieldError - нарушение по полю, если ошибка go-swagger сводится к нему: нарушение схемы поля тела или
+// значения параметра, поле неверного типа или неизвестное поле в теле. Поле параметра называется его именем,
+// поле тела - путем в JSON через точку.
+func swaggerFieldError(err error) (usecases.FieldError, bool) {
+	var (
+		parseErr      *openapierrors.ParseError
+		validationErr *openapierrors.Validation
+	)
+
+	switch {
+	case errors.As(err, &parseErr):
+		if parseErr.In != "body" {
+			return usecases.FieldError{}, false
+		}
+
+		return bodyFieldError(parseErr.Reason)
+	case errors.As(err, &validationErr):
+		switch {
+		case validationErr.Code() <= openapierrors.InvalidTypeCode:
+			// HTTP-коды (Content-Type, Accept) и неразобранный параметр: нарушения схемы идут после InvalidTypeCode
+			return usecases.FieldError{}, false
+		case validationErr.Code() == openapierrors.RequiredFailCode && (validationErr.In != "body" || validationErr.Name == "body"):
+			// нет обязательного параметра или самого тела (параметр body)
+			return usecases.FieldError{}, false
+		}
+
+		rule, message := describeSwagger(validationErr)
+
+		return usecases.FieldError{Field: validationErr.Name, Rule: rule, Message: message}, true
+	default:
+		return usecases.FieldError{}, false
+	}
+}
+
+// bodyFieldError - нарушение по полю из ошибки разбора JSON-тела: значение неверного типа или неизвестное поле.
+func bodyFieldError(err error) (usecases.FieldError, bool) {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return usecases.FieldError{Field: typeErr.Field, Rule: usecases.RuleType, Message: "must be of type " + jsonType(typeErr.Type)}, true
+	}
+
+	var property string
+
+	_, scanErr := fmt.Sscanf(err.Error(), "json: unknown field %q", &property)
+	if scanErr == nil {
+		return usecases.FieldError{Field: property, Rule: usecases.RuleUnknown, Message: "is not allowed"}, true
+	}
+
+	return usecases.FieldError{}, false
+}
+
+// jsonType - тип JSON для типа Go, в который не удалось разобрать значение
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return jsonType(t.Elem())
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// describeSwagger переводит ошибку валидации go-swagger в правило usecases и сообщение в том же стиле, что и
+// у shared/validation. Граница нарушенного ограничения есть только в тексте ошибки: это последнее число в нем.
+func describeSwagger(validationErr *openapierrors.Validation) (rule string, message string) {
+	reason := strings.TrimPrefix(validationErr.Error(), validationErr.Name+" in "+validationErr.In+" ")
+	inclusive := !strings.Contains(reason, " than ") || strings.Contains(reason, " or equal to ")
+	limit := lastNumber(reason)
+
+	switch validationErr.Code() {
+	case openapierrors.RequiredFailCode:
+		return usecases.RuleRequired, "is required"
+	case openapierrors.TooShortFailCode:
+		return usecases.RuleLength, "must be at least " + limit + " characters long"
+	case openapierrors.TooLongFailCode:
+		return usecases.RuleLength, "must be at most " + limit + " characters long"
+	case openapierrors.MinItemsFailCode:
+		return usecases.RuleLength, "must contain at least " + limit + " items"
+	case openapierrors.MaxItemsFailCode:
+		return usecases.RuleLength, "must contain at most " + limit + " items"
+	case openapierrors.MinFailCode:
+		if inclusive {
+			return usecases.RuleRange, "must be at least " + limit
+		}
+
+		return usecases.RuleRange, reason
+	case openapierrors.MaxFailCode:
+		if inclusive {
+			return usecases.RuleRange, "must be at most " + limit
+		}
+
+		return usecases.RuleRange, reason
+	case openapierrors.MultipleOfFailCode:
+		return usecases.RuleRange, reason
+	default:
+		return usecases.RuleFormat, reason
+	}
+}
+
+// lastNumber - последнее число в тексте ошибки go-swagger
+func lastNumber(text string) string {
+	words := strings.Fields(text)
+
+	for i := len(words) - 1; i >= 0; i-- {
+		_, err := strconv.ParseFloat(words[i], 64)
+		if err == nil {
+			return words[i]
+		}
+	}
+
+	return ""
+}
+
+// swaggerErrorEntry выбирает запись каталога для ошибки go-swagger, которая не сводится к нарушению по полю;
+// место параметра "body" отличает ошибки тела от ошибок параметров.
+func swaggerErrorEntry(err error) errcatalog.Entry {
+	var (
+		parseErr      *openapierrors.ParseError
+		validationErr *openapierrors.Validation
+		apiErr        openapierrors.Error
+	)
+
+	switch {
+	case errors.As(err, &parseErr):
+		if parseErr.In == "body" {
+			return errcatalog.MalformedBody
+		}
+
+		return errcatalog.InvalidParameter
+	case errors.As(err, &validationErr):
+		switch {
+		case validationErr.Code() == http.StatusUnsupportedMediaType:
+			return errcatalog.UnsupportedMediaType
+		case validationErr.Code() == http.StatusNotAcceptable:
+			return errcatalog.NotAcceptable
+		case validationErr.In == "body":
+			return errcatalog.MalformedBody
+		default:
+			return errcatalog.InvalidParameter
+		}
+	case errors.As(err, &apiErr):
+		switch apiErr.Code() {
+		case http.StatusNotFound:
+			return errcatalog.RouteNotFound
+		case http.StatusMethodNotAllowed:
+			return errcatalog.MethodNotAllowed
+		}
+	}
+
+	return errcatalog.Internal
+}
+
+// errorResponse - ответ с ошибкой по записи каталога. Нарушения по полям добавляются только к ошибке валидации;
+// внутренняя ошибка (5xx) регистрируется как инцидент, и клиент получает его ID.
+func (h *Handlers) errorResponse(ctx context.Context, err error, entry errcatalog.Entry) *models.ErrorResponse {
+	response := &models.ErrorResponse{
+		Code:  ToPtr(int64(entry.Code)),
+		Error: ToPtr(entry.Text(err)),
+	}
+
+	if entry == errcatalog.Validation {
+		response.Details = validationDetails(err)
+	}
+
+	if entry.Status >= http.StatusInternalServerError {
+		response.IncidentID, response.DebugMessage = h.incidents.Report(ctx, entry.Code, err)
+	}
+
+	return response
+}
+
+// validationDetails - нарушения по полям из ошибки валидации; nil, если ошибка их не содержит
+func validationDetails(err error) []*models.ValidationErrorDetail {
+	var validationErr *usecases.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	details := make([]*models.ValidationErrorDetail, 0, len(validationErr.Fields))
+
+	for _, f := range validationErr.Fields {
+		details = append(details, &models.ValidationErrorDetail{
+			Field:   ToPtr(f.Field),
+			Rule:    ToPtr(f.Rule),
+			Message: ToPtr(f.Message),
+		})
+	}
+
+	return details
+}
+
+func (h *Handlers) UpdateUser(params operations.UpdateUserParams) middleware.Responder {
+	updateUserRequestDTO := usecases.UpdateUserRequestDTO{
+		Name:    *params.Body.Name,
+		IfMatch: etag.ParseIfMatch(params.IfMatch),
+	}
+
+	user, err := h.useCases.UpdateUser(params.HTTPRequest.Context(), int(params.ID), updateUserRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			resp := operations.
+				NewUpdateUserBadRequest().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusNotFound:
+			resp := operations.
+				NewUpdateUserNotFound().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusGone:
+			resp := operations.
+				NewUpdateUserGone().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusConflict:
+			resp := operations.
+				NewUpdateUserConflict().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusPreconditionFailed:
+			resp := operations.
+				NewUpdateUserPreconditionFailed().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewUpdateUserInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	resp := operations.
+		NewUpdateUserOK().
+		WithETag(etag.Format(user.Version)).
+		WithPayload(
+			&models.GetUserByIDResponse{
+				ID:   ToPtr(int64(user.ID)),
+				Name: ToPtr(user.Name),
+			},
+		)
+
+	return resp
+}
+
+func (h *Handlers) PatchUser(params operations.PatchUserParams) middleware.Responder {
+	patchUserRequestDTO := usecases.PatchUserRequestDTO{
+		Name:    params.Body.Name,
+		IfMatch: etag.ParseIfMatch(params.IfMatch),
+	}
+
+	user, err := h.useCases.PatchUser(params.HTTPRequest.Context(), int(params.ID), patchUserRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			resp := operations.
+				NewPatchUserBadRequest().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusNotFound:
+			resp := operations.
+				NewPatchUserNotFound().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusGone:
+			resp := operations.
+				NewPatchUserGone().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusConflict:
+			resp := operations.
+				NewPatchUserConflict().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusPreconditionFailed:
+			resp := operations.
+				NewPatchUserPreconditionFailed().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewPatchUserInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	resp := operations.
+		NewPatchUserOK().
+		WithETag(etag.Format(user.Version)).
+		WithPayload(
+			&models.GetUserByIDResponse{
+				ID:   ToPtr(int64(user.ID)),
+				Name: ToPtr(user.Name),
+			},
+		)
+
+	return resp
+}
+
+func (h *Handlers) DeleteUser(params operations.DeleteUserParams) middleware.Responder {
+	err := h.useCases.DeleteUser(params.HTTPRequest.Context(), int(params.ID))
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound, errcatalog.Gone)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			resp := operations.
+				NewDeleteUserNotFound().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusGone:
+			resp := operations.
+				NewDeleteUserGone().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewDeleteUserInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	return operations.NewDeleteUserNoContent()
+}
+
+func (h *Handlers) RestoreUser(params operations.RestoreUserParams) middleware.Responder {
+	user, err := h.useCases.RestoreUser(params.HTTPRequest.Context(), int(params.ID))
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			resp := operations.
+				NewRestoreUserNotFound().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewRestoreUserInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	resp := operations.
+		NewRestoreUserOK().
+		WithETag(etag.Format(user.Version)).
+		WithPayload(
+			&models.GetUserByIDResponse{
+				ID:   ToPtr(int64(user.ID)),
+				Name: ToPtr(user.Name),
+			},
+		)
+
+	return resp
+}
+
+func (h *Handlers) GetUserHistory(params operations.GetUserHistoryParams) middleware.Responder {
+	history, err := h.useCases.UserHistory(params.HTTPRequest.Context(), int(params.ID))
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			resp := operations.
+				NewGetUserHistoryNotFound().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewGetUserHistoryInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	payload := &models.UserHistoryResponse{
+		Items: make([]*models.UserHistoryEntry, 0, len(history)),
+	}
+
+	for _, entry := range history {
+		item := &models.UserHistoryEntry{
+			Seq:      ToPtr(int64(entry.Seq)),
+			Action:   ToPtr(string(entry.Action)),
+			Actor:    entry.Actor,
+			At:       ToPtr(strfmt.DateTime(entry.At)),
+			Version:  ToPtr(int64(entry.Version)),
+			Changes:  make([]*models.UserHistoryChange, 0, len(entry.Changes)),
+			PrevHash: ToPtr(entry.PrevHash),
+			Hash:     ToPtr(entry.Hash),
+		}
+
+		for _, change := range entry.Changes {
+			item.Changes = append(item.Changes, &models.UserHistoryChange{
+				Field: ToPtr(change.Field),
+				From:  ToPtr(change.From),
+				To:    ToPtr(change.To),
+			})
+		}
+
+		payload.Items = append(payload.Items, item)
+	}
+
+	resp := operations.
+		NewGetUserHistoryOK().
+		WithPayload(payload)
+
+	return resp
+}
+
+func (h *Handlers) ListUsers(params operations.ListUsersParams) middleware.Responder {
+	var listUsersRequestDTO usecases.ListUsersRequestDTO
+
+	if params.Limit != nil {
+		listUsersRequestDTO.Limit = int(*params.Limit)
+	}
+
+	if params.Cursor != nil {
+		listUsersRequestDTO.Cursor = *params.Cursor
+	}
+
+	if params.NamePrefix != nil {
+		listUsersRequestDTO.NamePrefix = *params.NamePrefix
+	}
+
+	if params.CreatedAfter != nil {
+		listUsersRequestDTO.CreatedAfter = time.Time(*params.CreatedAfter)
+	}
+
+	listUsersRequestDTO.Sort = params.Sort
+
+	if params.IncludeDeleted != nil {
+		listUsersRequestDTO.IncludeDeleted = *params.IncludeDeleted
+	}
+
+	page, err := h.useCases.ListUsers(params.HTTPRequest.Context(), listUsersRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.InvalidSort, errcatalog.Validation, errcatalog.Forbidden)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			resp := operations.
+				NewListUsersBadRequest().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		case http.StatusForbidden:
+			resp := operations.
+				NewListUsersForbidden().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		default:
+			resp := operations.
+				NewListUsersInternalServerError().
+				WithPayload(h.errorResponse(params.HTTPRequest.Context(), err, entry))
+
+			return resp
+		}
+	}
+
+	payload := &models.ListUsersResponse{
+		Items:      make([]*models.GetUserByIDResponse, 0, len(page.Users)),
+		NextCursor: page.NextCursor,
+	}
+
+	for _, user := range page.Users {
+		item := &models.GetUserByIDResponse{
+			ID:   ToPtr(int64(user.ID)),
+			Name: ToPtr(user.Name),
+		}
+
+		if user.Deleted() {
+			item.DeletedAt = ToPtr(strfmt.DateTime(user.DeletedAt))
+		}
+
+		payload.Items = append(payload.Items, item)
+	}
+
+	return operations.NewListUsersOK().WithPayload(payload)
+}
+
 func ToPtr[T any](v T) *T {
 	return &v
 }
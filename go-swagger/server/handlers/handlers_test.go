@@ -4,18 +4,28 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
 
 	"server/generated/models"
+	"server/generated/restapi"
 	"server/generated/restapi/operations"
-	"server/usecases"
+	"shared/errcatalog"
+	"shared/incident"
+	"shared/usecases"
+	"shared/validation"
 )
 
 func readJSONBody[T any](t *testing.T, rr *httptest.ResponseRecorder) T {
@@ -38,12 +48,53 @@ func readJSONBody[T any](t *testing.T, rr *httptest.ResponseRecorder) T {
 	return got
 }
 
+// specValidator - проверка по спецификации, общая для тестов
+var specValidator = sync.OnceValues(func() (*validation.Validator, error) {
+	return validation.FromSwagger(restapi.SwaggerJSON)
+})
+
+// checkResponse проваливает тест, если ответ обработчика операции operationID не соответствует спецификации.
+func checkResponse(t *testing.T, operationID string, rr *httptest.ResponseRecorder) {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.FromSwagger() error = %v", err)
+	}
+
+	// Content-Type по Accept ставит runtime go-swagger до WriteResponse, а в тестах обработчики вызываются без него
+	header := rr.Header().Clone()
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", runtime.JSONMime)
+	}
+
+	err = validator.ValidateResponse(operationID, rr.Code, header, rr.Body.Bytes())
+	if err != nil {
+		t.Errorf("%v; body: %s", err, rr.Body.String())
+	}
+}
+
+// checkResponses - checkResponse для ответов, прошедших через роутер: операция определяется по запросу.
+func checkResponses(t *testing.T, next http.Handler) http.Handler {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.FromSwagger() error = %v", err)
+	}
+
+	return validator.ResponseMiddleware(func(r *http.Request, err error) {
+		t.Errorf("%s %s: %v", r.Method, r.URL, err)
+	})(next)
+}
+
 func TestHandlers_GetUsers(t *testing.T) {
 	type fields struct {
 		setup func(t *testing.T) UseCases
 	}
 	type args struct {
-		id int64
+		id          int64
+		ifNoneMatch *string
 	}
 
 	tests := []struct {
@@ -53,6 +104,7 @@ func TestHandlers_GetUsers(t *testing.T) {
 		wantStatusCode int
 		wantCT         string
 		wantBody       any
+		wantETag       string
 	}{
 		{
 			name: "ok",
@@ -62,7 +114,7 @@ func TestHandlers_GetUsers(t *testing.T) {
 
 					m.EXPECT().
 						GetUser(mock.Anything, 1).
-						Return(usecases.User{ID: 1, Name: "Alice"}, nil).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
 						Once()
 
 					return m
@@ -75,6 +127,25 @@ func TestHandlers_GetUsers(t *testing.T) {
 				ID:   ToPtr(int64(1)),
 				Name: ToPtr("Alice"),
 			},
+			wantETag: `"3"`,
+		},
+		{
+			name: "not modified 304",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 1).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifNoneMatch: ToPtr(`W/"3"`)},
+			wantStatusCode: http.StatusNotModified,
+			wantETag:       `"3"`,
 		},
 		{
 			name: "not found",
@@ -98,6 +169,28 @@ func TestHandlers_GetUsers(t *testing.T) {
 				Error: ToPtr("Not Found"),
 			},
 		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 6).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 6},
+			wantStatusCode: http.StatusGone,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(410)),
+				Error: ToPtr("Gone"),
+			},
+		},
 		{
 			name: "internal error 1",
 			fields: fields{
@@ -178,6 +271,7 @@ func TestHandlers_GetUsers(t *testing.T) {
 			params := operations.GetUserByIDParams{
 				HTTPRequest: req,
 				ID:          tt.args.id,
+				IfNoneMatch: tt.args.ifNoneMatch,
 			}
 
 			responder := h.GetUsers(params)
@@ -186,10 +280,16 @@ func TestHandlers_GetUsers(t *testing.T) {
 
 			responder.WriteResponse(rr, runtime.JSONProducer())
 
+			checkResponse(t, "GetUserById", rr)
+
 			if rr.Code != tt.wantStatusCode {
 				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
 			}
 
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
 			switch want := tt.wantBody.(type) {
 			case *models.GetUserByIDResponse:
 				got := readJSONBody[models.GetUserByIDResponse](t, rr)
@@ -201,6 +301,10 @@ func TestHandlers_GetUsers(t *testing.T) {
 				if !reflect.DeepEqual(&got, want) {
 					t.Fatalf("body = %#v, want %#v", got, *want)
 				}
+			case nil:
+				if rr.Body.Len() != 0 {
+					t.Fatalf("body = %q, want empty", rr.Body.String())
+				}
 			default:
 				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
 			}
@@ -275,6 +379,38 @@ func TestHandlers_CreateUsers(t *testing.T) {
 				Error: ToPtr(usecases.ErrValidation.Error()),
 			},
 		},
+		{
+			name: "validation error with details -> 400",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsers(
+							mock.Anything,
+							usecases.CreateUserRequestDTO{Name: "<script>"},
+						).
+						Return(0, &usecases.ValidationError{
+							Fields: []usecases.FieldError{
+								{Field: "name", Rule: usecases.RuleCharset, Message: "may contain only letters, digits, spaces and - ' . _"},
+							},
+						}).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{name: "<script>"},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(3)),
+				Error: ToPtr("validation error: name may contain only letters, digits, spaces and - ' . _"),
+				Details: []*models.ValidationErrorDetail{
+					{Field: ToPtr("name"), Rule: ToPtr("charset"), Message: ToPtr("may contain only letters, digits, spaces and - ' . _")},
+				},
+			},
+		},
 		{
 			name: "internal server error (default) -> 500",
 			fields: fields{
@@ -326,6 +462,8 @@ func TestHandlers_CreateUsers(t *testing.T) {
 
 			responder.WriteResponse(rr, runtime.JSONProducer())
 
+			checkResponse(t, "CreateUser", rr)
+
 			if rr.Code != tt.wantStatusCode {
 				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
 			}
@@ -347,3 +485,1757 @@ func TestHandlers_CreateUsers(t *testing.T) {
 		})
 	}
 }
+
+// ---------- CreateUsersBatch ----------
+
+func TestHandlers_CreateUsersBatch(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		names        []string
+		allOrNothing *bool
+	}
+
+	itemsDTO := []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: ""}}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantBody       any
+	}{
+		{
+			name: "partial success 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{
+							Results: []usecases.CreateUserResult{{ID: 10}, {Err: usecases.ErrValidation}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{names: []string{"Alice", ""}},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.CreateUsersBatchResponse{
+				Results: []*models.CreateUsersBatchResult{
+					{ID: 10},
+					{Error: &models.ErrorResponse{Code: ToPtr(int64(3)), Error: ToPtr(usecases.ErrValidation.Error())}},
+				},
+			},
+		},
+		{
+			name: "all or nothing rolled back -> 422",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO, AllOrNothing: true}).
+						Return(usecases.CreateUsersBatchResult{
+							Results:    []usecases.CreateUserResult{{Err: usecases.ErrRolledBack}, {Err: usecases.ErrValidation}},
+							RolledBack: true,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{names: []string{"Alice", ""}, allOrNothing: ToPtr(true)},
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantBody: &models.CreateUsersBatchResponse{
+				Results: []*models.CreateUsersBatchResult{
+					{Error: &models.ErrorResponse{Code: ToPtr(int64(5)), Error: ToPtr(usecases.ErrRolledBack.Error())}},
+					{Error: &models.ErrorResponse{Code: ToPtr(int64(3)), Error: ToPtr(usecases.ErrValidation.Error())}},
+				},
+			},
+		},
+		{
+			name: "validation error -> 400",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{names: []string{"Alice", ""}},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(3)),
+				Error: ToPtr(usecases.ErrValidation.Error()),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{}, errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{names: []string{"Alice", ""}},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			body := &models.CreateUsersBatchRequest{
+				AllOrNothing: tt.args.allOrNothing,
+			}
+
+			for _, name := range tt.args.names {
+				body.Items = append(body.Items, &models.CreateUserRequest{Name: ToPtr(name)})
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/users:batch", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.CreateUsersBatchParams{
+				HTTPRequest: req,
+				Body:        body,
+			}
+
+			responder := h.CreateUsersBatch(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "CreateUsersBatch", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			switch want := tt.wantBody.(type) {
+			case *models.CreateUsersBatchResponse:
+				got := readJSONBody[models.CreateUsersBatchResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// ---------- UpdateUser ----------
+
+func TestHandlers_UpdateUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id      int64
+		ifMatch string
+		name    string
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+		wantETag       string
+	}{
+		{
+			name: "ok 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, name: "Bob"},
+			wantETag:       `"2"`,
+			wantStatusCode: http.StatusOK,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.GetUserByIDResponse{
+				ID:   ToPtr(int64(1)),
+				Name: ToPtr("Bob"),
+			},
+		},
+		{
+			name: "validation error -> 400",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: ""},
+						).
+						Return(usecases.User{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, name: ""},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(3)),
+				Error: ToPtr(usecases.ErrValidation.Error()),
+			},
+		},
+		{
+			name: "not found -> 404",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							2,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2, name: "Bob"},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(404)),
+				Error: ToPtr("Not Found"),
+			},
+		},
+		{
+			name: "gone -> 410",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							4,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 4, name: "Bob"},
+			wantStatusCode: http.StatusGone,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(410)),
+				Error: ToPtr("Gone"),
+			},
+		},
+		{
+			name: "precondition failed -> 412",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, name: "Bob"},
+			wantStatusCode: http.StatusPreconditionFailed,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(8)),
+				Error: ToPtr("Precondition Failed"),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							3,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3, name: "Bob"},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/users/", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.UpdateUserParams{
+				HTTPRequest: req,
+				ID:          tt.args.id,
+				IfMatch:     tt.args.ifMatch,
+				Body: &models.UpdateUserRequest{
+					Name: ToPtr(tt.args.name),
+				},
+			}
+
+			responder := h.UpdateUser(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "UpdateUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case *models.GetUserByIDResponse:
+				got := readJSONBody[models.GetUserByIDResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// ---------- PatchUser ----------
+
+func TestHandlers_PatchUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id      int64
+		ifMatch string
+		name    *string
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+		wantETag       string
+	}{
+		{
+			name: "ok 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: ToPtr("Bob"), IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, name: ToPtr("Bob")},
+			wantETag:       `"2"`,
+			wantStatusCode: http.StatusOK,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.GetUserByIDResponse{
+				ID:   ToPtr(int64(1)),
+				Name: ToPtr("Bob"),
+			},
+		},
+		{
+			name: "empty patch -> 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{IfMatch: usecases.VersionMatch{Any: true}},
+						).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 4}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: "*"},
+			wantETag:       `"4"`,
+			wantStatusCode: http.StatusOK,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.GetUserByIDResponse{
+				ID:   ToPtr(int64(1)),
+				Name: ToPtr("Alice"),
+			},
+		},
+		{
+			name: "not found -> 404",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							2,
+							usecases.PatchUserRequestDTO{Name: ToPtr("Bob")},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2, name: ToPtr("Bob")},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(404)),
+				Error: ToPtr("Not Found"),
+			},
+		},
+		{
+			name: "precondition failed -> 412",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: ToPtr("Bob"), IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, name: ToPtr("Bob")},
+			wantStatusCode: http.StatusPreconditionFailed,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(8)),
+				Error: ToPtr("Precondition Failed"),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							3,
+							usecases.PatchUserRequestDTO{Name: ToPtr("Bob")},
+						).
+						Return(usecases.User{}, errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3, name: ToPtr("Bob")},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         runtime.JSONMime,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.PatchUserParams{
+				HTTPRequest: req,
+				ID:          tt.args.id,
+				IfMatch:     tt.args.ifMatch,
+				Body: &models.PatchUserRequest{
+					Name: tt.args.name,
+				},
+			}
+
+			responder := h.PatchUser(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "PatchUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case *models.GetUserByIDResponse:
+				got := readJSONBody[models.GetUserByIDResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// ---------- DeleteUser ----------
+
+func TestHandlers_DeleteUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id int64
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantBody       any
+	}{
+		{
+			name: "no content 204",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 1).
+						Return(nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1},
+			wantStatusCode: http.StatusNoContent,
+			wantBody:       nil,
+		},
+		{
+			name: "not found -> 404",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 2).
+						Return(usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2},
+			wantStatusCode: http.StatusNotFound,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(404)),
+				Error: ToPtr("Not Found"),
+			},
+		},
+		{
+			name: "already deleted -> 410",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 4).
+						Return(usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 4},
+			wantStatusCode: http.StatusGone,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(410)),
+				Error: ToPtr("Gone"),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 3).
+						Return(errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.DeleteUserParams{
+				HTTPRequest: req,
+				ID:          tt.args.id,
+			}
+
+			responder := h.DeleteUser(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "DeleteUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			switch want := tt.wantBody.(type) {
+			case nil:
+				if rr.Body.Len() != 0 {
+					t.Fatalf("body = %q, want empty", rr.Body.String())
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// ---------- RestoreUser ----------
+
+func TestHandlers_RestoreUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id int64
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantBody       any
+		wantETag       string
+	}{
+		{
+			name: "ok 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 1).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.GetUserByIDResponse{
+				ID:   ToPtr(int64(1)),
+				Name: ToPtr("Alice"),
+			},
+			wantETag: `"3"`,
+		},
+		{
+			name: "not found -> 404",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 2).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2},
+			wantStatusCode: http.StatusNotFound,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(404)),
+				Error: ToPtr("Not Found"),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 3).
+						Return(usecases.User{}, errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/users/", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.RestoreUserParams{
+				HTTPRequest: req,
+				ID:          tt.args.id,
+			}
+
+			responder := h.RestoreUser(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "RestoreUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case *models.GetUserByIDResponse:
+				got := readJSONBody[models.GetUserByIDResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// Путь с пользовательским методом ":restore" должен доходить до RestoreUser с числовым id.
+func TestHandlers_RestoreUser_Routing(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		RestoreUser(mock.Anything, 7).
+		Return(usecases.User{ID: 7, Name: "Alice", Version: 2}, nil).
+		Once()
+
+	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
+	if err != nil {
+		t.Fatalf("loads.Embedded() error = %v", err)
+	}
+
+	api := operations.NewUsersAPIAPI(swaggerSpec)
+	api.RestoreUserHandler = operations.RestoreUserHandlerFunc(New(m, nil).RestoreUser)
+
+	req := httptest.NewRequest(http.MethodPost, "/users/7:restore", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, api.Serve(nil)).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+// ---------- ListUsers ----------
+
+func TestHandlers_GetUserHistory(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id int64
+	}
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantBody       any
+	}{
+		{
+			name: "ok 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 1).
+						Return([]usecases.AuditEntry{
+							{
+								Seq:     1,
+								UserID:  1,
+								Action:  usecases.AuditActionCreate,
+								Actor:   "admin",
+								At:      at,
+								Version: 1,
+								Changes: []usecases.AuditChange{{Field: "name", To: "Alice"}},
+								Hash:    "h1",
+							},
+							{
+								Seq:      3,
+								UserID:   1,
+								Action:   usecases.AuditActionDelete,
+								At:       at,
+								Version:  2,
+								Changes:  []usecases.AuditChange{},
+								PrevHash: "h2",
+								Hash:     "h3",
+							},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.UserHistoryResponse{
+				Items: []*models.UserHistoryEntry{
+					{
+						Seq:      ToPtr(int64(1)),
+						Action:   ToPtr("create"),
+						Actor:    "admin",
+						At:       ToPtr(strfmt.DateTime(at)),
+						Version:  ToPtr(int64(1)),
+						Changes:  []*models.UserHistoryChange{{Field: ToPtr("name"), From: ToPtr(""), To: ToPtr("Alice")}},
+						PrevHash: ToPtr(""),
+						Hash:     ToPtr("h1"),
+					},
+					{
+						Seq:      ToPtr(int64(3)),
+						Action:   ToPtr("delete"),
+						At:       ToPtr(strfmt.DateTime(at)),
+						Version:  ToPtr(int64(2)),
+						Changes:  []*models.UserHistoryChange{},
+						PrevHash: ToPtr("h2"),
+						Hash:     ToPtr("h3"),
+					},
+				},
+			},
+		},
+		{
+			name: "not found -> 404",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 2).
+						Return(nil, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2},
+			wantStatusCode: http.StatusNotFound,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(404)),
+				Error: ToPtr("Not Found"),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 3).
+						Return(nil, errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users/", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.GetUserHistoryParams{
+				HTTPRequest: req,
+				ID:          tt.args.id,
+			}
+
+			responder := h.GetUserHistory(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "GetUserHistory", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			switch want := tt.wantBody.(type) {
+			case *models.UserHistoryResponse:
+				got := readJSONBody[models.UserHistoryResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHandlers_ListUsers(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		limit          *int64
+		cursor         *string
+		namePrefix     *string
+		createdAfter   *strfmt.DateTime
+		sort           []string
+		includeDeleted *bool
+	}
+
+	cursor := "eyJhZnRlcl9pZCI6Mn0"
+	nextCursor := "eyJhZnRlcl9pZCI6NH0"
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantBody       any
+	}{
+		{
+			name: "first page 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{limit: ToPtr(int64(20))},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.ListUsersResponse{
+				Items: []*models.GetUserByIDResponse{
+					{ID: ToPtr(int64(1)), Name: ToPtr("Alice")},
+				},
+			},
+		},
+		{
+			name: "page with cursor 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 2, Cursor: cursor}).
+						Return(usecases.UsersPage{
+							Users:      []usecases.User{{ID: 3, Name: "Carol"}, {ID: 4, Name: "Dave"}},
+							NextCursor: nextCursor,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{limit: ToPtr(int64(2)), cursor: ToPtr(cursor)},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.ListUsersResponse{
+				Items: []*models.GetUserByIDResponse{
+					{ID: ToPtr(int64(3)), Name: ToPtr("Carol")},
+					{ID: ToPtr(int64(4)), Name: ToPtr("Dave")},
+				},
+				NextCursor: nextCursor,
+			},
+		},
+		{
+			name: "filters and sort 200",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+							Limit:        20,
+							NamePrefix:   "Al",
+							CreatedAfter: createdAfter,
+							Sort:         []string{"name", "-id"},
+						}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				limit:        ToPtr(int64(20)),
+				namePrefix:   ToPtr("Al"),
+				createdAfter: ToPtr(strfmt.DateTime(createdAfter)),
+				sort:         []string{"name", "-id"},
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.ListUsersResponse{
+				Items: []*models.GetUserByIDResponse{
+					{ID: ToPtr(int64(1)), Name: ToPtr("Alice")},
+				},
+			},
+		},
+		{
+			name: "include deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, IncludeDeleted: true}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob", DeletedAt: deletedAt}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				limit:          ToPtr(int64(20)),
+				includeDeleted: ToPtr(true),
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody: &models.ListUsersResponse{
+				Items: []*models.GetUserByIDResponse{
+					{ID: ToPtr(int64(1)), Name: ToPtr("Alice")},
+					{ID: ToPtr(int64(2)), Name: ToPtr("Bob"), DeletedAt: ToPtr(strfmt.DateTime(deletedAt))},
+				},
+			},
+		},
+		{
+			name: "invalid sort -> 400",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, Sort: []string{"email"}}).
+						Return(usecases.UsersPage{}, usecases.ErrInvalidSort).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{limit: ToPtr(int64(20)), sort: []string{"email"}},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(4)),
+				Error: ToPtr(usecases.ErrInvalidSort.Error()),
+			},
+		},
+		{
+			name: "include deleted without operator token -> 403",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, IncludeDeleted: true}).
+						Return(usecases.UsersPage{}, usecases.ErrForbidden).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{limit: ToPtr(int64(20)), includeDeleted: ToPtr(true)},
+			wantStatusCode: http.StatusForbidden,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(15)),
+				Error: ToPtr("Forbidden"),
+			},
+		},
+		{
+			name: "invalid cursor -> 400",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, Cursor: cursor}).
+						Return(usecases.UsersPage{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{limit: ToPtr(int64(20)), cursor: ToPtr(cursor)},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(3)),
+				Error: ToPtr(usecases.ErrValidation.Error()),
+			},
+		},
+		{
+			name: "internal server error (default) -> 500",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20}).
+						Return(usecases.UsersPage{}, errors.New("unexpected")).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{limit: ToPtr(int64(20))},
+			wantStatusCode: http.StatusInternalServerError,
+			wantBody: &models.ErrorResponse{
+				Code:  ToPtr(int64(-1)),
+				Error: ToPtr("Internal Server Error"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			req = req.WithContext(context.Background())
+
+			params := operations.ListUsersParams{
+				HTTPRequest:    req,
+				Limit:          tt.args.limit,
+				Cursor:         tt.args.cursor,
+				NamePrefix:     tt.args.namePrefix,
+				CreatedAfter:   tt.args.createdAfter,
+				Sort:           tt.args.sort,
+				IncludeDeleted: tt.args.includeDeleted,
+			}
+
+			responder := h.ListUsers(params)
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "ListUsers", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			switch want := tt.wantBody.(type) {
+			case *models.ListUsersResponse:
+				got := readJSONBody[models.ListUsersResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			case *models.ErrorResponse:
+				got := readJSONBody[models.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(&got, want) {
+					t.Fatalf("body = %#v, want %#v", got, *want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// Параметры фильтрации и сортировки должны разбираться сгенерированным биндингом запроса.
+func TestHandlers_ListUsers_QueryBinding(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+			Limit:          5,
+			NamePrefix:     "Al",
+			CreatedAfter:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Sort:           []string{"name", "-id"},
+			IncludeDeleted: true,
+		}).
+		Return(usecases.UsersPage{}, nil).
+		Once()
+
+	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
+	if err != nil {
+		t.Fatalf("loads.Embedded() error = %v", err)
+	}
+
+	api := operations.NewUsersAPIAPI(swaggerSpec)
+	api.ListUsersHandler = operations.ListUsersHandlerFunc(New(m, nil).ListUsers)
+
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=5&name_prefix=Al&created_after=2025-01-01T00:00:00Z&sort=name,-id&include_deleted=true", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, api.Serve(nil)).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+// ---------- Incident ----------
+
+func TestHandlers_Incident(t *testing.T) {
+	notPublic := fmt.Errorf("load user: %w", usecases.ErrNotPublic1)
+
+	tests := []struct {
+		name             string
+		err              error
+		debug            bool
+		wantStatusCode   int
+		wantIncident     bool
+		wantDebugMessage string
+	}{
+		{
+			name:           "internal error is recorded",
+			err:            notPublic,
+			wantStatusCode: http.StatusInternalServerError,
+			wantIncident:   true,
+		},
+		{
+			name:             "debug mode returns the underlying message",
+			err:              notPublic,
+			debug:            true,
+			wantStatusCode:   http.StatusInternalServerError,
+			wantIncident:     true,
+			wantDebugMessage: "load user: we can't expose this text 1",
+		},
+		{
+			name:           "client error is not an incident",
+			err:            usecases.ErrNotFound,
+			debug:          true,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockUseCases(t)
+
+			m.EXPECT().
+				GetUser(mock.Anything, 1).
+				Return(usecases.User{}, tt.err).
+				Once()
+
+			sink := incident.NewMemory()
+			h := New(m, incident.New(sink))
+
+			ctx := context.Background()
+			if tt.debug {
+				ctx = incident.WithDebug(ctx)
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			req = req.WithContext(ctx)
+
+			responder := h.GetUsers(operations.GetUserByIDParams{
+				HTTPRequest: req,
+				ID:          1,
+			})
+
+			rr := httptest.NewRecorder()
+
+			responder.WriteResponse(rr, runtime.JSONProducer())
+
+			checkResponse(t, "GetUserById", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			got := readJSONBody[models.ErrorResponse](t, rr)
+
+			if got.DebugMessage != tt.wantDebugMessage {
+				t.Fatalf("debug_message = %q, want %q", got.DebugMessage, tt.wantDebugMessage)
+			}
+
+			if (got.IncidentID != "") != tt.wantIncident {
+				t.Fatalf("incident_id = %q, want present = %v", got.IncidentID, tt.wantIncident)
+			}
+
+			if !tt.wantIncident {
+				return
+			}
+
+			recorded, err := sink.Get(got.IncidentID)
+			if err != nil {
+				t.Fatalf("sink.Get() error = %v", err)
+			}
+
+			wantChain := []string{tt.err.Error(), usecases.ErrNotPublic1.Error()}
+			if recorded.Code != 1 || !reflect.DeepEqual(recorded.Chain, wantChain) {
+				t.Fatalf("incident = %+v, want code 1 and chain %q", recorded, wantChain)
+			}
+		})
+	}
+}
+
+// ---------- ServeError ----------
+
+func TestHandlers_ServeError(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		accept         string
+		body           string
+		wantStatusCode int
+		wantCode       int64
+		wantAllow      string
+	}{
+		{
+			name:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/users/abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.InvalidParameter.Code),
+		},
+		{
+			name:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=many",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.InvalidParameter.Code),
+		},
+		{
+			name:           "malformed body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.MalformedBody.Code),
+		},
+		{
+			name:           "body that does not match the schema",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+		},
+		{
+			name:           "unsupported body content type",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "text/plain",
+			body:           "Alice",
+			wantStatusCode: http.StatusUnsupportedMediaType,
+			wantCode:       int64(errcatalog.UnsupportedMediaType.Code),
+		},
+		{
+			name:           "unacceptable response format",
+			method:         http.MethodGet,
+			target:         "/users/1",
+			accept:         "text/html",
+			wantStatusCode: http.StatusNotAcceptable,
+			wantCode:       int64(errcatalog.NotAcceptable.Code),
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodGet,
+			target:         "/accounts",
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       int64(errcatalog.RouteNotFound.Code),
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPut,
+			target:         "/users",
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantCode:       int64(errcatalog.MethodNotAllowed.Code),
+			wantAllow:      "GET,POST",
+		},
+	}
+
+	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
+	if err != nil {
+		t.Fatalf("loads.Embedded() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(NewMockUseCases(t), nil)
+
+			api := operations.NewUsersAPIAPI(swaggerSpec)
+			api.ServeError = h.ServeError
+			api.GetUserByIDHandler = operations.GetUserByIDHandlerFunc(h.GetUsers)
+			api.CreateUserHandler = operations.CreateUserHandlerFunc(h.CreateUsers)
+			api.ListUsersHandler = operations.ListUsersHandlerFunc(h.ListUsers)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, api.Serve(nil)).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			got := readJSONBody[models.ErrorResponse](t, rr)
+			if got.Code == nil || *got.Code != tt.wantCode || got.Error == nil || *got.Error == "" {
+				t.Fatalf("body = %s, want code %d with a message", rr.Body.String(), tt.wantCode)
+			}
+
+			if allow := rr.Header().Get("Allow"); allow != tt.wantAllow {
+				t.Fatalf("Allow = %q, want %q", allow, tt.wantAllow)
+			}
+		})
+	}
+}
+
+func TestHandlers_RequestValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		idempotencyKey string
+		body           string
+		wantStatusCode int
+		wantCode       int64
+		wantDetails    []*models.ValidationErrorDetail
+	}{
+		{
+			name:           "missing required field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("name"), Rule: ToPtr(usecases.RuleRequired), Message: ToPtr("is required")},
+			},
+		},
+		{
+			name:           "unknown field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":"Alice","admin":true}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("admin"), Rule: ToPtr(usecases.RuleUnknown), Message: ToPtr("is not allowed")},
+			},
+		},
+		{
+			name:           "field of the wrong type",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":5}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("name"), Rule: ToPtr(usecases.RuleType), Message: ToPtr("must be of type string")},
+			},
+		},
+		{
+			name:           "empty batch",
+			method:         http.MethodPost,
+			target:         "/users:batch",
+			contentType:    "application/json",
+			body:           `{"items":[]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("items"), Rule: ToPtr(usecases.RuleLength), Message: ToPtr("must contain at least 1 items")},
+			},
+		},
+		{
+			name:           "batch item without a required field",
+			method:         http.MethodPost,
+			target:         "/users:batch",
+			contentType:    "application/json",
+			body:           `{"items":[{"name":"Alice"},{}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("items.1.name"), Rule: ToPtr(usecases.RuleRequired), Message: ToPtr("is required")},
+			},
+		},
+		{
+			name:           "too long header parameter",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			idempotencyKey: strings.Repeat("k", 256),
+			body:           `{"name":"Alice"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("Idempotency-Key"), Rule: ToPtr(usecases.RuleLength), Message: ToPtr("must be at most 255 characters long")},
+			},
+		},
+		{
+			name:           "missing body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.MalformedBody.Code),
+		},
+		{
+			name:           "out of range query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=500",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       int64(errcatalog.Validation.Code),
+			wantDetails: []*models.ValidationErrorDetail{
+				{Field: ToPtr("limit"), Rule: ToPtr(usecases.RuleRange), Message: ToPtr("must be at most 100")},
+			},
+		},
+	}
+
+	swaggerSpec, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
+	if err != nil {
+		t.Fatalf("loads.Embedded() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// usecases не должны вызываться: запрос отклоняется до обработчика
+			h := New(NewMockUseCases(t), nil)
+
+			api := operations.NewUsersAPIAPI(swaggerSpec)
+			api.ServeError = h.ServeError
+			api.JSONConsumer = runtime.ConsumerFunc(h.JSONConsumer)
+			api.CreateUserHandler = operations.CreateUserHandlerFunc(h.CreateUsers)
+			api.CreateUsersBatchHandler = operations.CreateUsersBatchHandlerFunc(h.CreateUsersBatch)
+			api.ListUsersHandler = operations.ListUsersHandlerFunc(h.ListUsers)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, api.Serve(nil)).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			got := readJSONBody[models.ErrorResponse](t, rr)
+			if got.Code == nil || *got.Code != tt.wantCode || got.Error == nil || *got.Error == "" {
+				t.Fatalf("body = %s, want code %d with a message", rr.Body.String(), tt.wantCode)
+			}
+
+			if !reflect.DeepEqual(got.Details, tt.wantDetails) {
+				t.Fatalf("details = %s, want %+v", rr.Body.String(), tt.wantDetails)
+			}
+		})
+	}
+}
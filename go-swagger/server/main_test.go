@@ -0,0 +1,57 @@
+package main
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"shared/audit"
+	"shared/conformance"
+	"shared/repository/memory"
+)
+
+func TestConformance(t *testing.T) {
+	t.Setenv("DEBUG_TOKEN", conformance.DebugToken)
+
+	server, err := newServer(memory.New(), audit.New(), time.Hour)
+	if err != nil {
+		t.Fatalf("newServer() error = %v", err)
+	}
+
+	httpServer := httptest.NewServer(server.GetHandler())
+	defer httpServer.Close()
+
+	conformance.Run(t, httpServer.URL)
+}
+
+// Журнал и пользователи должны храниться одинаково долго, иначе после перезапуска они расходятся
+func TestNewAuditLog(t *testing.T) {
+	auditFile := filepath.Join(t.TempDir(), "audit.log")
+
+	tests := []struct {
+		storage   string
+		auditFile string
+		wantErr   bool
+	}{
+		{storage: "", auditFile: ""},
+		{storage: "memory", auditFile: ""},
+		{storage: "memory", auditFile: auditFile, wantErr: true},
+		{storage: "sqlite", auditFile: "", wantErr: true},
+		{storage: "sqlite", auditFile: auditFile},
+		{storage: "file", auditFile: "", wantErr: true},
+		{storage: "file", auditFile: auditFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.storage+" "+tt.auditFile, func(t *testing.T) {
+			t.Setenv("STORAGE", tt.storage)
+			t.Setenv("AUDIT_FILE", tt.auditFile)
+
+			_, err := newAuditLog()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newAuditLog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
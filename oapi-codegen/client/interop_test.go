@@ -0,0 +1,506 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "client/generated"
+	"client/testserver"
+)
+
+// interopStep - вызов клиента и ожидаемый ответ. Шаги выполняются по порядку против одного сервера
+// с пустым хранилищем.
+type interopStep struct {
+	name   string
+	call   func(ctx context.Context, c *api.ClientWithResponses) (any, error)
+	status int
+	// want - поле ответа с разобранным телом (JSON200, JSON404, ...); пустое для ответов без тела
+	want string
+	// wantCode - ErrorResponse.code ответа с ошибкой; 0 - не проверяется
+	wantCode int
+}
+
+var interopSteps = []interopStep{
+	{
+		name: "create user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUserWithResponse(ctx, &api.CreateUserParams{}, api.CreateUserRequest{Name: "Alice"})
+		},
+		status: http.StatusCreated,
+		want:   "JSON201",
+	},
+	{
+		name: "create user with blank name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUserWithResponse(ctx, &api.CreateUserParams{}, api.CreateUserRequest{Name: " "})
+		},
+		status:   http.StatusBadRequest,
+		want:     "JSON400",
+		wantCode: 3,
+	},
+	{
+		name: "create user with taken name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUserWithResponse(ctx, &api.CreateUserParams{}, api.CreateUserRequest{Name: "Alice"})
+		},
+		status:   http.StatusConflict,
+		want:     "JSON409",
+		wantCode: 16,
+	},
+	{
+		name: "create user with idempotency key",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUserWithResponse(ctx, &api.CreateUserParams{IdempotencyKey: ptr("interop")}, api.CreateUserRequest{Name: "Bob"})
+		},
+		status: http.StatusCreated,
+		want:   "JSON201",
+	},
+	{
+		name: "reuse idempotency key with another body",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUserWithResponse(ctx, &api.CreateUserParams{IdempotencyKey: ptr("interop")}, api.CreateUserRequest{Name: "Carol"})
+		},
+		status:   http.StatusUnprocessableEntity,
+		want:     "JSON422",
+		wantCode: 6,
+	},
+	{
+		name: "create batch",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUsersBatchWithResponse(ctx, api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{{Name: "Carol"}, {Name: " "}}})
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "create batch all or nothing",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUsersBatchWithResponse(ctx, api.CreateUsersBatchRequest{
+				Items:        []api.CreateUserRequest{{Name: "Dave"}, {Name: " "}},
+				AllOrNothing: ptr(true),
+			})
+		},
+		status: http.StatusUnprocessableEntity,
+		want:   "JSON422",
+	},
+	{
+		name: "create batch with taken name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.CreateUsersBatchWithResponse(ctx, api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{{Name: "Eve"}, {Name: "Alice"}}})
+		},
+		status:   http.StatusConflict,
+		want:     "JSON409",
+		wantCode: 16,
+	},
+	{
+		name: "get user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserByIdWithResponse(ctx, 1, &api.GetUserByIdParams{})
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "get unchanged user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserByIdWithResponse(ctx, 1, &api.GetUserByIdParams{IfNoneMatch: ptr(`"1"`)})
+		},
+		status: http.StatusNotModified,
+	},
+	{
+		name: "get missing user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserByIdWithResponse(ctx, 99, &api.GetUserByIdParams{})
+		},
+		status:   http.StatusNotFound,
+		want:     "JSON404",
+		wantCode: 404,
+	},
+	{
+		name: "get missing user as problem details",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserByIdWithResponse(ctx, 99, &api.GetUserByIdParams{}, accept("application/problem+json"))
+		},
+		status:   http.StatusNotFound,
+		want:     "ApplicationproblemJSON404",
+		wantCode: 404,
+	},
+	{
+		name: "replace user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.UpdateUserWithResponse(ctx, 1, &api.UpdateUserParams{IfMatch: `"1"`}, api.UpdateUserRequest{Name: "Alicia"})
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "replace user with stale ETag",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.UpdateUserWithResponse(ctx, 1, &api.UpdateUserParams{IfMatch: `"1"`}, api.UpdateUserRequest{Name: "Alice"})
+		},
+		status:   http.StatusPreconditionFailed,
+		want:     "JSON412",
+		wantCode: 8,
+	},
+	{
+		name: "replace user with blank name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.UpdateUserWithResponse(ctx, 1, &api.UpdateUserParams{IfMatch: "*"}, api.UpdateUserRequest{Name: " "})
+		},
+		status:   http.StatusBadRequest,
+		want:     "JSON400",
+		wantCode: 3,
+	},
+	{
+		name: "replace user with taken name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.UpdateUserWithResponse(ctx, 1, &api.UpdateUserParams{IfMatch: "*"}, api.UpdateUserRequest{Name: "Bob"})
+		},
+		status:   http.StatusConflict,
+		want:     "JSON409",
+		wantCode: 16,
+	},
+	{
+		name: "replace missing user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.UpdateUserWithResponse(ctx, 99, &api.UpdateUserParams{IfMatch: "*"}, api.UpdateUserRequest{Name: "Alice"})
+		},
+		status:   http.StatusNotFound,
+		want:     "JSON404",
+		wantCode: 404,
+	},
+	{
+		name: "patch user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.PatchUserWithResponse(ctx, 2, &api.PatchUserParams{IfMatch: "*"}, api.PatchUserRequest{Name: ptr("Bobby")})
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "patch user with stale ETag",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.PatchUserWithResponse(ctx, 2, &api.PatchUserParams{IfMatch: `"1"`}, api.PatchUserRequest{Name: ptr("Bob")})
+		},
+		status:   http.StatusPreconditionFailed,
+		want:     "JSON412",
+		wantCode: 8,
+	},
+	{
+		name: "patch user with blank name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.PatchUserWithResponse(ctx, 2, &api.PatchUserParams{IfMatch: "*"}, api.PatchUserRequest{Name: ptr(" ")})
+		},
+		status:   http.StatusBadRequest,
+		want:     "JSON400",
+		wantCode: 3,
+	},
+	{
+		name: "patch user to taken name",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.PatchUserWithResponse(ctx, 2, &api.PatchUserParams{IfMatch: "*"}, api.PatchUserRequest{Name: ptr("Alicia")})
+		},
+		status:   http.StatusConflict,
+		want:     "JSON409",
+		wantCode: 16,
+	},
+	{
+		name: "patch missing user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.PatchUserWithResponse(ctx, 99, &api.PatchUserParams{IfMatch: "*"}, api.PatchUserRequest{Name: ptr("Bob")})
+		},
+		status:   http.StatusNotFound,
+		want:     "JSON404",
+		wantCode: 404,
+	},
+	{
+		name: "list users",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.ListUsersWithResponse(ctx, &api.ListUsersParams{Limit: ptr(2), Sort: &[]string{"-name"}})
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "list users with unknown sort field",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.ListUsersWithResponse(ctx, &api.ListUsersParams{Sort: &[]string{"email"}})
+		},
+		status:   http.StatusBadRequest,
+		want:     "JSON400",
+		wantCode: 4,
+	},
+	{
+		name: "delete user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.DeleteUserWithResponse(ctx, 2)
+		},
+		status: http.StatusNoContent,
+	},
+	{
+		name: "get deleted user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserByIdWithResponse(ctx, 2, &api.GetUserByIdParams{})
+		},
+		status:   http.StatusGone,
+		want:     "JSON410",
+		wantCode: 410,
+	},
+	{
+		name: "replace deleted user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.UpdateUserWithResponse(ctx, 2, &api.UpdateUserParams{IfMatch: "*"}, api.UpdateUserRequest{Name: "Bob"})
+		},
+		status:   http.StatusGone,
+		want:     "JSON410",
+		wantCode: 410,
+	},
+	{
+		name: "patch deleted user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.PatchUserWithResponse(ctx, 2, &api.PatchUserParams{IfMatch: "*"}, api.PatchUserRequest{Name: ptr("Bob")})
+		},
+		status:   http.StatusGone,
+		want:     "JSON410",
+		wantCode: 410,
+	},
+	{
+		name: "delete deleted user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.DeleteUserWithResponse(ctx, 2)
+		},
+		status:   http.StatusGone,
+		want:     "JSON410",
+		wantCode: 410,
+	},
+	{
+		name: "delete missing user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.DeleteUserWithResponse(ctx, 99)
+		},
+		status:   http.StatusNotFound,
+		want:     "JSON404",
+		wantCode: 404,
+	},
+	{
+		name: "restore user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.RestoreUserWithResponse(ctx, 2)
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "restore missing user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.RestoreUserWithResponse(ctx, 99)
+		},
+		status:   http.StatusNotFound,
+		want:     "JSON404",
+		wantCode: 404,
+	},
+	{
+		name: "get user history",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserHistoryWithResponse(ctx, 2)
+		},
+		status: http.StatusOK,
+		want:   "JSON200",
+	},
+	{
+		name: "get history of missing user",
+		call: func(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+			return c.GetUserHistoryWithResponse(ctx, 99)
+		},
+		status:   http.StatusNotFound,
+		want:     "JSON404",
+		wantCode: 404,
+	},
+}
+
+// Клиент oapi-codegen против каждого сервера репозитория
+func TestInterop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and starts every server")
+	}
+
+	for _, dir := range testserver.Servers {
+		t.Run(dir, func(t *testing.T) {
+			t.Parallel()
+
+			baseURL := testserver.Start(t, filepath.Join("..", "..", dir))
+
+			client, err := api.NewClientWithResponses(baseURL)
+			if err != nil {
+				t.Fatalf("NewClientWithResponses() error = %v", err)
+			}
+
+			for _, step := range interopSteps {
+				ok := t.Run(step.name, func(t *testing.T) {
+					got, err := step.call(context.Background(), client)
+					checkResponse(t, got, err, step.status, step.want, step.wantCode)
+				})
+				if !ok {
+					t.Fatalf("step %q failed, the rest of the scenario is skipped", step.name)
+				}
+			}
+		})
+	}
+}
+
+// documentedStatus - ответ с ошибкой из спецификации. Серверы отдают его только при сбое (500), гонке (409 с кодом 7)
+// или запросе, который типизированный клиент не отправит (406, 415, неверный параметр), поэтому он
+// воспроизводится заглушкой с телом ErrorResponse.
+type documentedStatus struct {
+	operation string
+	status    int
+	code      int
+	call      func(ctx context.Context, c *api.ClientWithResponses) (any, error)
+}
+
+func getUser(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.GetUserByIdWithResponse(ctx, 1, &api.GetUserByIdParams{})
+}
+
+func updateUser(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.UpdateUserWithResponse(ctx, 1, &api.UpdateUserParams{IfMatch: "*"}, api.UpdateUserRequest{Name: "Alice"})
+}
+
+func patchUser(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.PatchUserWithResponse(ctx, 1, &api.PatchUserParams{IfMatch: "*"}, api.PatchUserRequest{Name: ptr("Alice")})
+}
+
+func deleteUser(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.DeleteUserWithResponse(ctx, 1)
+}
+
+func restoreUser(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.RestoreUserWithResponse(ctx, 1)
+}
+
+func getUserHistory(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.GetUserHistoryWithResponse(ctx, 1)
+}
+
+func listUsers(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.ListUsersWithResponse(ctx, &api.ListUsersParams{})
+}
+
+func createUser(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.CreateUserWithResponse(ctx, &api.CreateUserParams{}, api.CreateUserRequest{Name: "Alice"})
+}
+
+func createUsersBatch(ctx context.Context, c *api.ClientWithResponses) (any, error) {
+	return c.CreateUsersBatchWithResponse(ctx, api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{{Name: "Alice"}}})
+}
+
+var documentedStatuses = []documentedStatus{
+	{operation: "GetUserById", status: 400, code: 9, call: getUser},
+	{operation: "GetUserById", status: 406, code: 14, call: getUser},
+	{operation: "GetUserById", status: 500, code: -1, call: getUser},
+	{operation: "UpdateUser", status: 406, code: 14, call: updateUser},
+	{operation: "UpdateUser", status: 415, code: 13, call: updateUser},
+	{operation: "UpdateUser", status: 500, code: -1, call: updateUser},
+	{operation: "PatchUser", status: 406, code: 14, call: patchUser},
+	{operation: "PatchUser", status: 415, code: 13, call: patchUser},
+	{operation: "PatchUser", status: 500, code: -1, call: patchUser},
+	{operation: "DeleteUser", status: 400, code: 9, call: deleteUser},
+	{operation: "DeleteUser", status: 406, code: 14, call: deleteUser},
+	{operation: "DeleteUser", status: 500, code: -1, call: deleteUser},
+	{operation: "RestoreUser", status: 400, code: 9, call: restoreUser},
+	{operation: "RestoreUser", status: 406, code: 14, call: restoreUser},
+	{operation: "RestoreUser", status: 500, code: -1, call: restoreUser},
+	{operation: "GetUserHistory", status: 400, code: 9, call: getUserHistory},
+	{operation: "GetUserHistory", status: 406, code: 14, call: getUserHistory},
+	{operation: "GetUserHistory", status: 500, code: -1, call: getUserHistory},
+	{operation: "ListUsers", status: 403, code: 15, call: listUsers},
+	{operation: "ListUsers", status: 406, code: 14, call: listUsers},
+	{operation: "ListUsers", status: 500, code: -1, call: listUsers},
+	{operation: "CreateUser", status: 406, code: 14, call: createUser},
+	{operation: "CreateUser", status: 409, code: 7, call: createUser},
+	{operation: "CreateUser", status: 415, code: 13, call: createUser},
+	{operation: "CreateUser", status: 500, code: -1, call: createUser},
+	{operation: "CreateUsersBatch", status: 400, code: 3, call: createUsersBatch},
+	{operation: "CreateUsersBatch", status: 406, code: 14, call: createUsersBatch},
+	{operation: "CreateUsersBatch", status: 415, code: 13, call: createUsersBatch},
+	{operation: "CreateUsersBatch", status: 500, code: -1, call: createUsersBatch},
+}
+
+func TestDocumentedStatuses(t *testing.T) {
+	for _, tt := range documentedStatuses {
+		t.Run(fmt.Sprintf("%s %d", tt.operation, tt.status), func(t *testing.T) {
+			stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+
+				_, _ = fmt.Fprintf(w, `{"code":%d,"error":%q}`, tt.code, http.StatusText(tt.status))
+			}))
+			defer stub.Close()
+
+			client, err := api.NewClientWithResponses(stub.URL)
+			if err != nil {
+				t.Fatalf("NewClientWithResponses() error = %v", err)
+			}
+
+			got, err := tt.call(context.Background(), client)
+			checkResponse(t, got, err, tt.status, fmt.Sprintf("JSON%d", tt.status), tt.code)
+		})
+	}
+}
+
+// checkResponse проверяет, что ответ разобран без ошибки с кодом status, тело попало только в поле want,
+// а у ответа с ошибкой - код wantCode.
+func checkResponse(t *testing.T, got any, err error, status int, want string, wantCode int) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("error = %v, want %d", err, status)
+	}
+
+	response := reflect.ValueOf(got).Elem()
+
+	httpResponse := response.FieldByName("HTTPResponse").Interface().(*http.Response)
+	if httpResponse.StatusCode != status {
+		t.Fatalf("status code = %d, want %d; body: %s", httpResponse.StatusCode, status, response.FieldByName("Body").Bytes())
+	}
+
+	for i := range response.NumField() {
+		name := response.Type().Field(i).Name
+		if !strings.HasPrefix(name, "JSON") && !strings.HasPrefix(name, "Applicationproblem") {
+			continue
+		}
+
+		decoded := !response.Field(i).IsNil()
+		if decoded != (name == want) {
+			t.Fatalf("%s decoded = %v, want body in %q; body: %s", name, decoded, want, response.FieldByName("Body").Bytes())
+		}
+	}
+
+	if wantCode == 0 {
+		return
+	}
+
+	code := response.FieldByName(want).Elem().FieldByName("Code").Int()
+	if code != int64(wantCode) {
+		t.Fatalf("code = %d, want %d", code, wantCode)
+	}
+}
+
+// accept задает заголовок Accept запроса
+func accept(mediaType string) api.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Accept", mediaType)
+
+		return nil
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -13,6 +13,7 @@ import (
 func main() {
 	option1()
 	option2()
+	option3()
 }
 
 const (
@@ -29,7 +30,7 @@ func option1() {
 		panic(err)
 	}
 
-	response, err := client.GetUserById(context.Background(), id)
+	response, err := client.GetUserById(context.Background(), id, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -89,7 +90,7 @@ func option2() {
 		panic(err)
 	}
 
-	response, err := client.GetUserByIdWithResponse(context.Background(), id)
+	response, err := client.GetUserByIdWithResponse(context.Background(), id, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -105,3 +106,35 @@ func option2() {
 		fmt.Printf("%+v\n", string(response.Body))
 	}
 }
+
+// Ошибки в формате application/problem+json
+func option3() {
+	baseClient := http.Client{}
+
+	client, err := api.NewClientWithResponses(host, api.WithHTTPClient(&baseClient))
+	if err != nil {
+		panic(err)
+	}
+
+	acceptProblem := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Accept", "application/problem+json")
+
+		return nil
+	}
+
+	response, err := client.GetUserByIdWithResponse(context.Background(), 0, nil, acceptProblem)
+	if err != nil {
+		panic(err)
+	}
+
+	switch {
+	case response.JSON200 != nil:
+		fmt.Printf("%+v\n", *response.JSON200)
+	case response.ApplicationproblemJSON404 != nil:
+		fmt.Printf("%+v\n", *response.ApplicationproblemJSON404)
+	case response.ApplicationproblemJSON500 != nil:
+		fmt.Printf("%+v\n", *response.ApplicationproblemJSON500)
+	default:
+		fmt.Printf("%+v\n", string(response.Body))
+	}
+}
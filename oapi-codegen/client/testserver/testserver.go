@@ -0,0 +1,98 @@
+package testserver
+
+import (
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Servers - серверы репозитория, пути от его корня
+var Servers = []string{
+	"go-swagger/server",
+	"oapi-codegen/server",
+	"oapi-codegen/server_strict/echo",
+	"oapi-codegen/server_strict/fiber",
+	"oapi-codegen/server_strict/gin",
+	"oapi-codegen/server_strict/net_http",
+	"ogen-go/server",
+}
+
+// startTimeout - сколько ждать, пока сервер начнет принимать соединения
+const startTimeout = 30 * time.Second
+
+// Start собирает сервер из директории dir, запускает его на свободном порту с хранилищем в памяти и возвращает
+// base URL. Серверы - отдельные модули с одним и тем же путем server, поэтому в процесс теста их не встроить:
+// каждый работает в своем процессе, который останавливается в конце теста.
+func Start(t *testing.T, dir string) string {
+	t.Helper()
+
+	binary := filepath.Join(t.TempDir(), "server")
+
+	build := exec.Command("go", "build", "-o", binary, ".")
+	build.Dir = dir
+
+	output, err := build.CombinedOutput()
+	if err != nil {
+		t.Fatalf("build %s: %v\n%s", dir, err, output)
+	}
+
+	addr := freeAddr(t)
+
+	server := exec.Command(binary)
+	server.Dir = dir
+	server.Env = append(os.Environ(), "ADDR="+addr, "STORAGE=memory", "AUDIT_FILE=", "DEBUG_TOKEN=")
+
+	err = server.Start()
+	if err != nil {
+		t.Fatalf("start %s: %v", dir, err)
+	}
+
+	exited := make(chan error, 1)
+
+	go func() {
+		exited <- server.Wait()
+	}()
+
+	t.Cleanup(func() {
+		_ = server.Process.Kill()
+		<-exited
+	})
+
+	deadline := time.Now().Add(startTimeout)
+
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+
+			return "http://" + addr
+		}
+
+		select {
+		case err := <-exited:
+			exited <- err
+			t.Fatalf("%s exited before accepting connections: %v", dir, err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("%s does not accept connections on %s: %v", dir, addr, err)
+		}
+	}
+}
+
+// freeAddr - адрес на 127.0.0.1 с портом, который только что был свободен
+func freeAddr(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
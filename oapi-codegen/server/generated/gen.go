@@ -6,14 +6,77 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ErrorResponseCode.
+const (
+	ErrorResponseCodeAlreadyExists          ErrorResponseCode = 16
+	ErrorResponseCodeForbidden              ErrorResponseCode = 15
+	ErrorResponseCodeGone                   ErrorResponseCode = 410
+	ErrorResponseCodeIdempotencyInProgress  ErrorResponseCode = 7
+	ErrorResponseCodeIdempotencyKeyMismatch ErrorResponseCode = 6
+	ErrorResponseCodeInternal               ErrorResponseCode = -1
+	ErrorResponseCodeInvalidParameter       ErrorResponseCode = 9
+	ErrorResponseCodeInvalidSort            ErrorResponseCode = 4
+	ErrorResponseCodeMalformedBody          ErrorResponseCode = 10
+	ErrorResponseCodeMethodNotAllowed       ErrorResponseCode = 12
+	ErrorResponseCodeNotAcceptable          ErrorResponseCode = 14
+	ErrorResponseCodeNotFound               ErrorResponseCode = 404
+	ErrorResponseCodeNotPublic1             ErrorResponseCode = 1
+	ErrorResponseCodeNotPublic2             ErrorResponseCode = 2
+	ErrorResponseCodePreconditionFailed     ErrorResponseCode = 8
+	ErrorResponseCodeRolledBack             ErrorResponseCode = 5
+	ErrorResponseCodeRouteNotFound          ErrorResponseCode = 11
+	ErrorResponseCodeUnsupportedMediaType   ErrorResponseCode = 13
+	ErrorResponseCodeValidation             ErrorResponseCode = 3
+)
+
+// Defines values for ProblemDetailsCode.
+const (
+	ProblemDetailsCodeAlreadyExists          ProblemDetailsCode = 16
+	ProblemDetailsCodeForbidden              ProblemDetailsCode = 15
+	ProblemDetailsCodeGone                   ProblemDetailsCode = 410
+	ProblemDetailsCodeIdempotencyInProgress  ProblemDetailsCode = 7
+	ProblemDetailsCodeIdempotencyKeyMismatch ProblemDetailsCode = 6
+	ProblemDetailsCodeInternal               ProblemDetailsCode = -1
+	ProblemDetailsCodeInvalidParameter       ProblemDetailsCode = 9
+	ProblemDetailsCodeInvalidSort            ProblemDetailsCode = 4
+	ProblemDetailsCodeMalformedBody          ProblemDetailsCode = 10
+	ProblemDetailsCodeMethodNotAllowed       ProblemDetailsCode = 12
+	ProblemDetailsCodeNotAcceptable          ProblemDetailsCode = 14
+	ProblemDetailsCodeNotFound               ProblemDetailsCode = 404
+	ProblemDetailsCodeNotPublic1             ProblemDetailsCode = 1
+	ProblemDetailsCodeNotPublic2             ProblemDetailsCode = 2
+	ProblemDetailsCodePreconditionFailed     ProblemDetailsCode = 8
+	ProblemDetailsCodeRolledBack             ProblemDetailsCode = 5
+	ProblemDetailsCodeRouteNotFound          ProblemDetailsCode = 11
+	ProblemDetailsCodeUnsupportedMediaType   ProblemDetailsCode = 13
+	ProblemDetailsCodeValidation             ProblemDetailsCode = 3
+)
+
+// Defines values for UserHistoryEntryAction.
+const (
+	Create  UserHistoryEntryAction = "create"
+	Delete  UserHistoryEntryAction = "delete"
+	Restore UserHistoryEntryAction = "restore"
+	Update  UserHistoryEntryAction = "update"
+)
+
 // CreateUserRequest defines model for CreateUserRequest.
 type CreateUserRequest struct {
+	// Name Unique among all users, including deleted ones
 	Name string `json:"name"`
 }
 
@@ -22,29 +85,317 @@ type CreateUserResponse struct {
 	Id int `json:"id"`
 }
 
+// CreateUsersBatchRequest defines model for CreateUsersBatchRequest.
+type CreateUsersBatchRequest struct {
+	AllOrNothing *bool               `json:"allOrNothing,omitempty"`
+	Items        []CreateUserRequest `json:"items"`
+}
+
+// CreateUsersBatchResponse defines model for CreateUsersBatchResponse.
+type CreateUsersBatchResponse struct {
+	// Results Results in the same order as request items
+	Results []CreateUsersBatchResult `json:"results"`
+}
+
+// CreateUsersBatchResult Either id of the created user or error
+type CreateUsersBatchResult struct {
+	Error *ErrorResponse `json:"error,omitempty"`
+	Id    *int           `json:"id,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	Code  int    `json:"code"`
-	Error string `json:"error"`
+	// Code Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+	// * `-1` Internal (HTTP 500) - unexpected error
+	Code ErrorResponseCode `json:"code"`
+
+	// DebugMessage Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)
+	DebugMessage *string `json:"debug_message,omitempty"`
+
+	// Details Field-level violations; set only for validation errors (code 3)
+	Details *[]ValidationErrorDetail `json:"details,omitempty"`
+	Error   string                   `json:"error"`
+
+	// IncidentId Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id
+	IncidentId *string `json:"incident_id,omitempty"`
 }
 
+// ErrorResponseCode Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+// * `404` NotFound (HTTP 404) - user does not exist
+// * `410` Gone (HTTP 410) - user is deleted and can be restored
+// * `1` NotPublic1 (HTTP 500) - internal error 1
+// * `2` NotPublic2 (HTTP 500) - internal error 2
+// * `3` Validation (HTTP 400) - request validation failed, see details
+// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+// * `-1` Internal (HTTP 500) - unexpected error
+type ErrorResponseCode int
+
 // GetUserByIdResponse defines model for GetUserByIdResponse.
 type GetUserByIdResponse struct {
-	Id   int    `json:"id"`
+	// DeletedAt Set only for deleted users, which ListUsers returns with include_deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+}
+
+// ListUsersResponse defines model for ListUsersResponse.
+type ListUsersResponse struct {
+	Items []GetUserByIdResponse `json:"items"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// PatchUserRequest defines model for PatchUserRequest.
+type PatchUserRequest struct {
+	// Name Unique among all users, including deleted ones
+	Name *string `json:"name,omitempty"`
+}
+
+// ProblemDetails RFC 7807 problem details. Returned instead of ErrorResponse when the client prefers
+// application/problem+json in Accept.
+type ProblemDetails struct {
+	// Code Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+	// * `-1` Internal (HTTP 500) - unexpected error
+	Code ProblemDetailsCode `json:"code"`
+
+	// DebugMessage Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)
+	DebugMessage *string `json:"debug_message,omitempty"`
+
+	// Detail Explanation of this occurrence of the problem; error of ErrorResponse
+	Detail *string `json:"detail,omitempty"`
+
+	// Details Field-level violations; set only for validation errors (code 3)
+	Details *[]ValidationErrorDetail `json:"details,omitempty"`
+
+	// IncidentId Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id
+	IncidentId *string `json:"incident_id,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type Problem type URI; about:blank when the status code says it all
+	Type string `json:"type"`
+}
+
+// ProblemDetailsCode Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+// * `404` NotFound (HTTP 404) - user does not exist
+// * `410` Gone (HTTP 410) - user is deleted and can be restored
+// * `1` NotPublic1 (HTTP 500) - internal error 1
+// * `2` NotPublic2 (HTTP 500) - internal error 2
+// * `3` Validation (HTTP 400) - request validation failed, see details
+// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+// * `-1` Internal (HTTP 500) - unexpected error
+type ProblemDetailsCode int
+
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	// Name Unique among all users, including deleted ones
 	Name string `json:"name"`
 }
 
+// UserHistoryChange defines model for UserHistoryChange.
+type UserHistoryChange struct {
+	Field string `json:"field"`
+
+	// From Value before the change; empty if there was none
+	From string `json:"from"`
+
+	// To Value after the change; empty if there is none
+	To string `json:"to"`
+}
+
+// UserHistoryEntry defines model for UserHistoryEntry.
+type UserHistoryEntry struct {
+	Action UserHistoryEntryAction `json:"action"`
+
+	// Actor "operator" for changes made with the operator token in X-Debug-Token; absent for other requests. The token is shared by all operators, so no individual principal is recorded.
+	Actor   *string             `json:"actor,omitempty"`
+	At      time.Time           `json:"at"`
+	Changes []UserHistoryChange `json:"changes"`
+
+	// Hash SHA-256 of the entry including prev_hash, hex encoded
+	Hash string `json:"hash"`
+
+	// PrevHash Hash of the previous entry of the audit log; empty for the first entry
+	PrevHash string `json:"prev_hash"`
+
+	// Seq Position of the entry in the whole audit log, starting with 1
+	Seq int `json:"seq"`
+
+	// Version Version of the user after the change
+	Version int `json:"version"`
+}
+
+// UserHistoryEntryAction defines model for UserHistoryEntry.Action.
+type UserHistoryEntryAction string
+
+// UserHistoryResponse defines model for UserHistoryResponse.
+type UserHistoryResponse struct {
+	Items []UserHistoryEntry `json:"items"`
+}
+
+// ValidationErrorDetail defines model for ValidationErrorDetail.
+type ValidationErrorDetail struct {
+	// Field Request field that failed validation, e.g. name or limit
+	Field   string `json:"field"`
+	Message string `json:"message"`
+
+	// Rule Violated rule - not_blank, length, charset, range, format, required, type or unknown
+	Rule string `json:"rule"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from next_cursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// NamePrefix Only users whose name starts with this prefix (case sensitive)
+	NamePrefix *string `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+
+	// CreatedAfter Only users created strictly after this moment
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// Sort Comma separated sort fields (id, name, created_at), "-" prefix means descending, e.g. sort=name,-id. Unknown or duplicate fields are rejected with code 4. The cursor is bound to the sort it was issued for.
+	Sort *[]string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeDeleted Also return deleted users; they have deleted_at set. Requires the operator token in X-Debug-Token, otherwise the request fails with 403 (code 15)
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// CreateUserParams defines parameters for CreateUser.
+type CreateUserParams struct {
+	// IdempotencyKey Makes retries safe: a repeated request with the same key and body gets the original response, the same key with another body is rejected with 422. Keys expire after a server-side TTL.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUserByIdParams defines parameters for GetUserById.
+type GetUserByIdParams struct {
+	// IfNoneMatch ETags known to the client; if the current one is among them, 304 is returned
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PatchUserParams defines parameters for PatchUser.
+type PatchUserParams struct {
+	// IfMatch ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.
+	IfMatch string `json:"If-Match"`
+}
+
+// UpdateUserParams defines parameters for UpdateUser.
+type UpdateUserParams struct {
+	// IfMatch ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.
+	IfMatch string `json:"If-Match"`
+}
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
+// PatchUserJSONRequestBody defines body for PatchUser for application/json ContentType.
+type PatchUserJSONRequestBody = PatchUserRequest
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UpdateUserRequest
+
+// CreateUsersBatchJSONRequestBody defines body for CreateUsersBatch for application/json ContentType.
+type CreateUsersBatchJSONRequestBody = CreateUsersBatchRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List users
+	// (GET /users)
+	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
 	// Create user
 	// (POST /users)
-	CreateUser(w http.ResponseWriter, r *http.Request)
+	CreateUser(w http.ResponseWriter, r *http.Request, params CreateUserParams)
+	// Delete user
+	// (DELETE /users/{id})
+	DeleteUser(w http.ResponseWriter, r *http.Request, id int)
 	// Get user by ID
 	// (GET /users/{id})
-	GetUserById(w http.ResponseWriter, r *http.Request, id int)
+	GetUserById(w http.ResponseWriter, r *http.Request, id int, params GetUserByIdParams)
+	// Partially update user
+	// (PATCH /users/{id})
+	PatchUser(w http.ResponseWriter, r *http.Request, id int, params PatchUserParams)
+	// Replace user
+	// (PUT /users/{id})
+	UpdateUser(w http.ResponseWriter, r *http.Request, id int, params UpdateUserParams)
+	// Get user change history
+	// (GET /users/{id}/history)
+	GetUserHistory(w http.ResponseWriter, r *http.Request, id int)
+	// Restore deleted user
+	// (POST /users/{id}:restore)
+	RestoreUser(w http.ResponseWriter, r *http.Request, id int)
+	// Create several users at once
+	// (POST /users:batch)
+	CreateUsersBatch(w http.ResponseWriter, r *http.Request)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -56,11 +407,129 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", r.URL.Query(), &params.NamePrefix)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name_prefix", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", r.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", r.URL.Query(), &params.IncludeDeleted)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_deleted", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // CreateUser operation middleware
 func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateUserParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r)
+		siw.Handler.DeleteUser(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -84,8 +553,202 @@ func (siw *ServerInterfaceWrapper) GetUserById(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserByIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserById(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchUser operation middleware
+func (siw *ServerInterfaceWrapper) PatchUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchUserParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = IfMatch
+
+	} else {
+		err := fmt.Errorf("Header parameter If-Match is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "If-Match", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchUser(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateUserParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = IfMatch
+
+	} else {
+		err := fmt.Errorf("Header parameter If-Match is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "If-Match", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUser(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetUserHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserHistory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreUser operation middleware
+func (siw *ServerInterfaceWrapper) RestoreUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUsersBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateUsersBatch(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserById(w, r, id)
+		siw.Handler.CreateUsersBatch(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -215,8 +878,159 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/users", wrapper.ListUsers)
 	m.HandleFunc("POST "+options.BaseURL+"/users", wrapper.CreateUser)
+	m.HandleFunc("DELETE "+options.BaseURL+"/users/{id}", wrapper.DeleteUser)
 	m.HandleFunc("GET "+options.BaseURL+"/users/{id}", wrapper.GetUserById)
+	m.HandleFunc("PATCH "+options.BaseURL+"/users/{id}", wrapper.PatchUser)
+	m.HandleFunc("PUT "+options.BaseURL+"/users/{id}", wrapper.UpdateUser)
+	m.HandleFunc("GET "+options.BaseURL+"/users/{id}/history", wrapper.GetUserHistory)
+	m.HandleFunc("POST "+options.BaseURL+"/users/{id}:restore", wrapper.RestoreUser)
+	m.HandleFunc("POST "+options.BaseURL+"/users:batch", wrapper.CreateUsersBatch)
 
 	return m
 }
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xdeW8buZL/KgXuAhu/pRxJlnMo2D9yzhgzyTMyyewCz0FMdZcsTrrJDsm2LQT+7gsW",
+	"2Ze6FXsGk7zxWP8kdjePqmLxVwdZ7S8s0XmhFSpn2fwLs8kKc0E/PjcoHL63aN7i5xKt8w9FmkontRLZ",
+	"sdEFGifRsvlSZBY5K1qPvjAlcvT/p2gTIwvfi83ZeyU/lwgi1+oMRJZBadFYDlIlWZlKdQYpZugwBa3Q",
+	"Ms7cukA2Z9YZqc7Y1RVnBj+X0mDK5v8Kk3yoW+nFb5g4dsU71NtCK0u0dCmUqf83dpXK4Rma3gwyvWZ8",
+	"+0y4ZPXHZCSy7J/mjXYrzxvJainKzNWt47QLrTMUys8rHeaB+OqH/zS4ZHP2H/eblbwfl/F+fw2vOMvF",
+	"5VHoPBmPOculqn6tJxTGiHVfFNTsZtLYJnODtsyc7SvG2/ACpAK3QrAiR9AmRQPCggnUQ6CA/17ma6K8",
+	"bK+u4bKi8IZ80nJtMvNSuhUakCnoJbGTUMeUtB20ATRGG7apDuHpNWy99I1qAV/x7WrcI7/btbc2iU4H",
+	"dix1Av9uH35AhYYYWRqdE2cYXgsnMn0G99CY+PMeh1SD0g4wlQ4Wa1gJle6fqH/A6Ww8O4U32r3SpUrh",
+	"3o/v3h3DbDzbg1GQUKrRhq6X0rrQZTI+hR+0wqr5ZFw3l7ZGDaFSSISCBYJB67TBlLpPaL7jcpHJZBKH",
+	"OBzTEF5kRokssjKh9tNW++lX20+p/cEp/CoymQovtZojal8p73nzfilkhikHiwgpOiEzG5g8hSNF7X7R",
+	"xnWHKZUti0Ibz6X1b5cSs9QrUyoNJn5cGuPwFN7qLMP0mUg+VUNMp36IhddZ2kRwIYKAK8VcYCJKi7Sk",
+	"IstG2oxUwKXYy3cwNC4sRPKJpnpwCkcp5oV2qJL1T7h+LW1OrTvTttqMfsI1DSUygyJd+/VL4UK6FQhI",
+	"5XKJBpWrREaTPOxMcqSOjT4zaG0tncdtIdNQNYBsziwtWCezDBboOSuMTtDaqCKPTuHYYKJVgO9XtEa1",
+	"tgVOlqPXxF+toIFd2uKlIdpJI8/R2GpBHteLeiyMyNGh6a5sIdyKw+cSzdov5wqFh72ibiwt5NJaT7E2",
+	"kItsqU1e6fX4FF5XT57pdD2sewv/JhHKk7zwOuf3c+pVWRPxHgZINf/LQgCaMPrEK1PpcHivKt10DJJA",
+	"S8NV03rOwkDTU3iNbqXTN9o9zTJ90Yh2fOjH2uzWiDiqfadFTmOFoQ9O4X2zN15jKsW7ddHgxGFfEFo5",
+	"v1QeIEF2ZqnEGvDpaZJg4cQiq0cbPwiMK6ygPfcT0lBkvEKXCoMKo9MyqQY9PIVX2ixkmmKDEQeb3Edj",
+	"ZFsLow04/QmVn+D/Ri9wUZ6N3vkHYdwHp/A07KeXHi83tgYppHeUQDb7zgk/3GINwm9zNNSq7YaJtiNG",
+	"04wmXpEj9nUQsVR4WWDiGxMmnijGGaoyZ/N/zcYzPpuM+YRP+QGf8UP+gD/kj/hj7h9O+GTKJwd8MuOT",
+	"Qz55wEeTxu5Wtoyzy5EfbXQujOfDejNdaSTjzFsFxlmD7+1fpoyzBpkZZy2AZZw1UOlfDYJZ90UDQIyz",
+	"Pl40E9SbnXHW2aE0a2tP+fcbW4NxNqTSga9GKRlntT4xzjo6QISExWIfrjhLvdJ8zNFacTbol6dosrVf",
+	"+WDWYkuv5UJt2LwnYNGBVtnaKySNDLlOEe51lDMi2V7fi+csWr0+Ia+8VRtleI4ZnEud0bLZ1oxLbdqm",
+	"lAiycM8DGhzs3dQ5bFSC/JsXRE7fN+SNT9ZjQapEpqjcR5n22fgFHVHaFVy1Mw8vL/ee0P5elll853en",
+	"VyeT0p6jtxbNud+bfnHAraQFmV4bE3lJsIrwIS/2B3TehX22Pkq3O4Nx938Ubpi5ei1iwyqQu1jJZAU/",
+	"S0tzeJZcaZQNhjnAC36MfRhnflv4KVgqHI6czHFIW4ZdXF7HmF8XCMlsa6RYk/qVQPF3BV1D4h1QLIWX",
+	"7mNSGqtNX8LP6XllYnxTKMQZPgGxsKhcpR+ZsOHFtUqxPXY79hj3Fwzz+4Qavcgwf7ENOd6+eg4PH40f",
+	"QhEaVp71PrwlHSR/xzoUFJV1YiG4WGGQaJJJL9/C4BKNPVGiKDKZEFDcj+P+929Wq8bU75O120VSu0hq",
+	"F0ntIqldJLWLpHaR1C6S+t6R1IC3cVlkQgVEoQ0mLegkYGtS77no0zyJtG46Rrc6dPvLBGieFOuESgbU",
+	"5dgjdFyMCrfcSnjcI4veWqShga0TrhxYC+IivIQYEPbDJyddNkDSLyttPIznuTDrirZIA8HzECHhQY+7",
+	"Vi94//bIBzC6dPNFJtSnxutuEQpWrC1I532na6OaihjioxYGD/73ULDzvkhv8aGmp/tHaZ026+croc4G",
+	"QlVyZQfTFT7O6JP+q8hKhAUutQkua0IDPwHMC7cGSYtvMHq5anjp9bZxxdKh+dqwctuoGyIJbEUmaMZr",
+	"5PNSObPui0ckgb4vlVFlwW1nnJWkGozHzAfzBPih2kvR8CwSNxS3n7DKzThhBC+Bbwu5SLFxqa/xReow",
+	"348QnIoIDXYf3q2w6mbBroTxccSa1K4a1nKwGpQGqVJ5LtNSZFAYD4eFyNowtj+0nCHjc7PETGTvxrmR",
+	"vgYP4PZK2NUAKv34dDQ9fFDhEfoFbu2wwuD5R9+TwwovARX55UM01y0HUFPYVQN4eC51aeNM8akofQie",
+	"6bNKlf0SkZ2QxrrQdmhSi58H0FFb2djnhif65WKls9Z83IOkcZ5VUqPJIKLHaGlgP4YX1UzkyW7uzoER",
+	"N7ah54JXm4g0pZmyUYa2iONqXrNb/6zUWw8Arv7wlYZhL2M73m7eZgiWnF4Hex4yFS3/hwPun+2HeEIb",
+	"yGQu3ZDqtDzN3jtTDhnwX8n5whT8awqy3EeyuBwyVGc+Rk5Wwlh0HIxfMw5hu/MqZEp5MNna+zaflL5Q",
+	"N4ZoIqmhui/bK/KHlmQ0MplgXPdgWtlznRdCrev4qOWnsJBRfnp81FK7OZvsj/fHvpkuUIlCsjk7oEec",
+	"+fCXluk+WWT/0xkSvNXR9lHK5k0OmPrEaMMHR1+Y9FNQRqFKIs9ZtVRB7ToXd6ZjumAj8zJv7tfE34a2",
+	"1+bS/bMQ3pUIeeGQG2wlinvYFHO/Q0SGHh0qewvYm9176SQqDz8Wg3IS8NjKeklL2VF5CfcSYREsKo9i",
+	"57i3hRD/38fQ5Q9TUyXXfOPEZesau6SFXOeo3DYphI4fqX1n+psYuD5Nz3WeC7DotaSbP7RwT6acJMah",
+	"ntbtcThhoxNWCS1HoSz4QVF5wxVRwI/zP9R3JNN9eB82HeUky5CBxmoaYRAM/hayBLQo5DfPgmsQFUVa",
+	"WFCaKaaliE7pyI+T1pY+8ayNt/94WWSUro4O75AUbQjxG+HVaLwlDmiMuXVr2rte3Kwvz6eZ1fGMqHuS",
+	"RNGXz3SfIzRnUWDR+Xz+zfM6PDhQFzKmZKsYy8Nx1OnZ+CAGoZPDbUrcP7ka2Pxbbu1dfSBfkiwcCW06",
+	"HjM6JaDkmf+xfczgjxeau5jXmbz+8RUB7MZO+skvxexPnHbjFtoV74zVPim5+ZgbBzwDfDwTKVSmNaYN",
+	"YNRLKfC4IeiEgV6S+sfHj1uPa6zfC/I5uOXyaeVEoz4Tsx3VJZ3XpRvYPFEKD265FN5oB+18cxDFjLg7",
+	"vPV7oE4c/xJyT0QFzR4zNtGfCTBKAY+2Az5Pc5e17/R0Z3wtPiEd5BuJFqxY4hwEGCyCTR4+MPqEazpC",
+	"pGOCM3QRrI08k574Cg95tweNUSXSqau0G8ZuNp3uw0+4toCXhTRVkkHEXNzIyhTh3buf9yskD7nTBso3",
+	"jrI6UJ6Ly5/JQ2bz6eEhOW/V75O+d/AheMBoHWWj/yy9Gri13XW2nSnxqmdTJt+EgO1GJbRK76BlGTQh",
+	"8d1kDKPmZJF0+A7A6mz8+JZz9/b3HXtLBUV9fE6SeLgHMRV0oxPCSn4Pgvwmh7dcfq1TNqBjNghHx4HL",
+	"g8DldHrbje/NLmCoTtI2GLIgiQd3yAsJBoK0nd6EJMz9LzK9am4Y9rNXr4X5ZFtZyvpKUghw6aF1dEqk",
+	"wDpt/OGqXwdwOl9YpxXOoXUJD4SyF2isv+jEWzcT7Upf0EkTnU0O3U/k5MG8DccAvhMsvOWnTv7qjncx",
+	"uk7VC+o47FSRM+IzUq2oMmWbdn0gQ9Kki/qB5KwvvzcankfF+pua5q+FcLO/gZUNlxn+/k7D5LarJmHC",
+	"SlhYIDb5q8Ckv1Z5d7A+AF/Eej6cZW9h8jdBx15y8eU7cWYhZFKdbt3vfRJPoOurhloFj41O8N0Kcw4H",
+	"41kIPsPd4a3R5HL0RisMtxm/mt/+llnAwTvng3lAHjkgEryABi+fk1DO+2eGN1mQmmE//8GwhXLwWqdy",
+	"KTH93gTt7OHOHu7s4c4efmN7+APG++uLNRy98HwXZCB6RrEuv/l+JrF7CcSGKiIfw3piGyMJ97SBf+zt",
+	"w1Greb244bJHClaqJGZxww2qgYztZLr/FfNZWc6b4/g3yrv2KqFulHa9A0Z8l969QXp3Z2h32ervx90f",
+	"STHfBe9iNrn1KeYbVMb1rHgQwqM7dJZwN9zIY2GcFFm2rpyrKsFSlAMJlqa6YedM/rudyX6lyc6b3HmT",
+	"O29y503uvMmdN7nzJnfe5Pf3Jt9ikYlk+EbG/VWom2rVyGw4eyrc/9wshKsr4MJ3D3SWhsInY90+vDxH",
+	"s67q2qzfbqtRshJSYV2ZUNWynKhOoV0ofwuFbxoicf5lp0qgfUIHwsIFZtk+PI0OpX9rxTmmscD1REnn",
+	"n2X67AzTeXUI6Jm4MNJhKArgnTqBcN3LlkmCmMZ3vllpsFMQLiyIE9W+g1qVn9MlkqbKrv0RkvrbP5G/",
+	"8C2hwVPTWNb2na6V/Hm7YajK705VKOwO53aFA7fv2CpiVWUUNozFvCqNn3+pqwt6n/N22oTP39CAVAMb",
+	"vxBUQbh3OUHpkS76N+lat+5uHebtQu8dvu7wdYevQx44oVrHiW2B63xRXRIYRtXgTtNnB6mkSjoL/nJb",
+	"+JsFc8Dwdwc8Pmz/2wMi5qf24X9jJWD7z1CENBbNEUqNq+54jsp7zJQRsOQDt1JeYbDuSNVnD2VTvy2X",
+	"4Iv7iYPobFfecYXGvvlsOm1VNh/WIUagikzJBRoM8/dtx+afaWDfuk6r+zdAvnOid+sf39gVAu/Ktf6u",
+	"KcunN6yy0qYpVI0f1lmE76pW2cwnNU5diBqodjVZ3xqJqEH7O7h82zrcqWIpi+doRPyCHAgHWiVRfiG3",
+	"FAKg0mRszlbOFfP79zOdiGylrZs/Gj8as6sPV/8/AKTzv8PXawAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
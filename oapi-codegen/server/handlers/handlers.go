@@ -4,169 +4,461 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 
 	api "server/generated"
-	"server/usecases"
+	"shared/errcatalog"
+	"shared/etag"
+	"shared/incident"
+	"shared/usecases"
+	"shared/validation"
 )
 
 type Handlers struct {
-	useCases UseCases
+	useCases  UseCases
+	incidents *incident.Reporter
 }
 
 type UseCases interface {
 	GetUser(ctx context.Context, id int) (usecases.User, error)
 	CreateUsers(ctx context.Context, userRequests usecases.CreateUserRequestDTO) (int, error)
+	CreateUsersBatch(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error)
+	UpdateUser(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO) (usecases.User, error)
+	PatchUser(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO) (usecases.User, error)
+	DeleteUser(ctx context.Context, id int) error
+	RestoreUser(ctx context.Context, id int) (usecases.User, error)
+	UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error)
+	ListUsers(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO) (usecases.UsersPage, error)
 }
 
-func New(useCases UseCases) *Handlers {
+func New(useCases UseCases, incidents *incident.Reporter) *Handlers {
 	return &Handlers{
-		useCases: useCases,
+		useCases:  useCases,
+		incidents: incidents,
 	}
 }
 
-func (h *Handlers) GetUserById(w http.ResponseWriter, r *http.Request, id int) {
+func (h *Handlers) GetUserById(w http.ResponseWriter, r *http.Request, id int, params api.GetUserByIdParams) {
 	user, err := h.useCases.GetUser(r.Context(), id)
 	if err != nil {
-		var response api.ErrorResponse
-
-		switch {
-		case errors.Is(err, usecases.ErrNotFound):
-			response = api.ErrorResponse{
-				Code:  404,
-				Error: "Not Found",
-			}
-			w.WriteHeader(http.StatusNotFound)
-		case errors.Is(err, usecases.ErrNotPublic1):
-			response = api.ErrorResponse{
-				Code:  1,
-				Error: "Internal Server Error 1",
-			}
-			w.WriteHeader(http.StatusInternalServerError)
-		case errors.Is(err, usecases.ErrNotPublic2):
-			response = api.ErrorResponse{
-				Code:  2,
-				Error: "Internal Server Error 2",
-			}
-			w.WriteHeader(http.StatusInternalServerError)
-		default:
-			response = api.ErrorResponse{
-				Code:  -1,
-				Error: "Internal Server Error",
-			}
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		h.writeError(w, r, err, errcatalog.NotFound, errcatalog.Gone, errcatalog.NotPublic1, errcatalog.NotPublic2)
+
+		return
+	}
+
+	w.Header().Set("ETag", etag.Format(user.Version))
+
+	if params.IfNoneMatch != nil && etag.NoneMatch(*params.IfNoneMatch, user.Version) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	response := api.GetUserByIdResponse{
+		Id:   user.ID,
+		Name: user.Name,
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// CreateUser - повторы с Idempotency-Key отрабатывает middleware idempotency, до обработчика они не доходят.
+func (h *Handlers) CreateUser(w http.ResponseWriter, r *http.Request, params api.CreateUserParams) {
+	var request api.CreateUserRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		h.writeBodyError(w, r, err)
+
+		return
+	}
+
+	createUserRequestDTO := usecases.CreateUserRequestDTO{
+		Name: request.Name,
+	}
+
+	id, err := h.useCases.CreateUsers(r.Context(), createUserRequestDTO)
+	if err != nil {
+		h.writeError(w, r, err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		return
+	}
+
+	response := api.CreateUserResponse{
+		Id: id,
+	}
+
+	writeJSON(w, http.StatusCreated, response)
+}
+
+func (h *Handlers) CreateUsersBatch(w http.ResponseWriter, r *http.Request) {
+	var request api.CreateUsersBatchRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		h.writeBodyError(w, r, err)
+
+		return
+	}
+
+	createUsersBatchRequestDTO := usecases.CreateUsersBatchRequestDTO{
+		Items: make([]usecases.CreateUserRequestDTO, 0, len(request.Items)),
+	}
+
+	for _, item := range request.Items {
+		createUsersBatchRequestDTO.Items = append(createUsersBatchRequestDTO.Items, usecases.CreateUserRequestDTO{
+			Name: item.Name,
+		})
+	}
+
+	if request.AllOrNothing != nil {
+		createUsersBatchRequestDTO.AllOrNothing = *request.AllOrNothing
+	}
+
+	batch, err := h.useCases.CreateUsersBatch(r.Context(), createUsersBatchRequestDTO)
+	if err != nil {
+		h.writeError(w, r, err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		return
+	}
+
+	response := api.CreateUsersBatchResponse{
+		Results: make([]api.CreateUsersBatchResult, 0, len(batch.Results)),
+	}
 
-		responseBytes, err := json.Marshal(response)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
+	for _, result := range batch.Results {
+		if result.Err != nil {
+			response.Results = append(response.Results, api.CreateUsersBatchResult{
+				Error: h.batchItemError(r.Context(), result.Err),
+			})
 
-			return
+			continue
 		}
 
-		w.Header().Set("Content-Type", "application/json")
+		id := result.ID
+
+		response.Results = append(response.Results, api.CreateUsersBatchResult{
+			Id: &id,
+		})
+	}
+
+	if batch.RolledBack {
+		writeJSON(w, http.StatusUnprocessableEntity, response)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// batchItemError - ошибка отдельного элемента пакетного создания
+func (h *Handlers) batchItemError(ctx context.Context, err error) *api.ErrorResponse {
+	response := h.errorResponse(ctx, err, errcatalog.Lookup(err, errcatalog.Validation, errcatalog.RolledBack))
+
+	return &response
+}
+
+// writeError отвечает ошибкой из каталога. entries - ошибки, описанные в спецификации операции,
+// остальные отдаются как errcatalog.Internal.
+func (h *Handlers) writeError(w http.ResponseWriter, r *http.Request, err error, entries ...errcatalog.Entry) {
+	h.writeEntry(w, r, err, errcatalog.Lookup(err, entries...))
+}
+
+func (h *Handlers) writeEntry(w http.ResponseWriter, r *http.Request, err error, entry errcatalog.Entry) {
+	writeJSON(w, entry.Status, h.errorResponse(r.Context(), err, entry))
+}
+
+// writeBodyError отвечает на тело запроса, которое не удалось разобрать.
+func (h *Handlers) writeBodyError(w http.ResponseWriter, r *http.Request, err error) {
+	h.writeEntry(w, r, fmt.Errorf("decode request body: %w", err), errcatalog.MalformedBody)
+}
+
+// ParameterError отвечает на ошибку разбора параметров запроса в сгенерированной обертке
+// (StdHTTPServerOptions.ErrorHandlerFunc).
+func (h *Handlers) ParameterError(w http.ResponseWriter, r *http.Request, err error) {
+	h.writeEntry(w, r, err, errcatalog.InvalidParameter)
+}
+
+// RequestError отвечает на запрос, не прошедший проверку по спецификации (validation.Validator.Middleware).
+func (h *Handlers) RequestError(w http.ResponseWriter, r *http.Request, err error) {
+	entry := errcatalog.Validation
+
+	var validationErr *validation.Error
+	if errors.As(err, &validationErr) {
+		entry = validationErr.Entry
+	}
+
+	h.writeEntry(w, r, err, entry)
+}
 
-		_, err = w.Write(responseBytes)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
+// NotFound отвечает на запрос, которому не соответствует ни одна операция (custommethod.ServeMux.NotFound).
+func (h *Handlers) NotFound(w http.ResponseWriter, r *http.Request) {
+	h.writeEntry(w, r, nil, errcatalog.RouteNotFound)
+}
+
+// MethodNotAllowed отвечает на запрос к пути, у которого нет операции с таким методом
+// (custommethod.ServeMux.MethodNotAllowed).
+func (h *Handlers) MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	h.writeEntry(w, r, nil, errcatalog.MethodNotAllowed)
+}
+
+// errorResponse - ответ с ошибкой по записи каталога. Нарушения по полям добавляются только к ошибке валидации;
+// внутренняя ошибка (5xx) регистрируется как инцидент, и клиент получает его ID.
+func (h *Handlers) errorResponse(ctx context.Context, err error, entry errcatalog.Entry) api.ErrorResponse {
+	response := api.ErrorResponse{
+		Code:  api.ErrorResponseCode(entry.Code),
+		Error: entry.Text(err),
+	}
+
+	if entry == errcatalog.Validation {
+		response.Details = validationDetails(err)
+	}
+
+	if entry.Status >= http.StatusInternalServerError {
+		id, debugMessage := h.incidents.Report(ctx, entry.Code, err)
+
+		if id != "" {
+			response.IncidentId = &id
+		}
 
-			return
+		if debugMessage != "" {
+			response.DebugMessage = &debugMessage
 		}
+	}
+
+	return response
+}
+
+// validationDetails - нарушения по полям из ошибки валидации; nil, если ошибка их не содержит
+func validationDetails(err error) *[]api.ValidationErrorDetail {
+	var validationErr *usecases.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	details := make([]api.ValidationErrorDetail, 0, len(validationErr.Fields))
+
+	for _, f := range validationErr.Fields {
+		details = append(details, api.ValidationErrorDetail{
+			Field:   f.Field,
+			Rule:    f.Rule,
+			Message: f.Message,
+		})
+	}
+
+	return &details
+}
+
+func (h *Handlers) UpdateUser(w http.ResponseWriter, r *http.Request, id int, params api.UpdateUserParams) {
+	var request api.UpdateUserRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		h.writeBodyError(w, r, err)
 
 		return
 	}
 
+	updateUserRequestDTO := usecases.UpdateUserRequestDTO{
+		Name:    request.Name,
+		IfMatch: etag.ParseIfMatch(params.IfMatch),
+	}
+
+	user, err := h.useCases.UpdateUser(r.Context(), id, updateUserRequestDTO)
+	if err != nil {
+		h.writeUpdateError(w, r, err)
+
+		return
+	}
+
+	w.Header().Set("ETag", etag.Format(user.Version))
+
 	response := api.GetUserByIdResponse{
 		Id:   user.ID,
 		Name: user.Name,
 	}
 
-	responseBytes, err := json.Marshal(response)
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *Handlers) PatchUser(w http.ResponseWriter, r *http.Request, id int, params api.PatchUserParams) {
+	var request api.PatchUserRequest
+
+	err := json.NewDecoder(r.Body).Decode(&request)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		h.writeBodyError(w, r, err)
 
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
+	patchUserRequestDTO := usecases.PatchUserRequestDTO{
+		Name:    request.Name,
+		IfMatch: etag.ParseIfMatch(params.IfMatch),
+	}
 
-	_, err = w.Write(responseBytes)
+	user, err := h.useCases.PatchUser(r.Context(), id, patchUserRequestDTO)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		h.writeUpdateError(w, r, err)
 
 		return
 	}
+
+	w.Header().Set("ETag", etag.Format(user.Version))
+
+	response := api.GetUserByIdResponse{
+		Id:   user.ID,
+		Name: user.Name,
+	}
+
+	writeJSON(w, http.StatusOK, response)
 }
 
-func (h *Handlers) CreateUser(w http.ResponseWriter, r *http.Request) {
-	var request api.CreateUserRequest
+func (h *Handlers) DeleteUser(w http.ResponseWriter, r *http.Request, id int) {
+	err := h.useCases.DeleteUser(r.Context(), id)
+	if err != nil {
+		h.writeError(w, r, err, errcatalog.NotFound, errcatalog.Gone)
 
-	err := json.NewDecoder(r.Body).Decode(&request)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handlers) RestoreUser(w http.ResponseWriter, r *http.Request, id int) {
+	user, err := h.useCases.RestoreUser(r.Context(), id)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		h.writeError(w, r, err, errcatalog.NotFound)
+
+		return
 	}
 
-	createUserRequestDTO := usecases.CreateUserRequestDTO{
-		Name: request.Name,
+	w.Header().Set("ETag", etag.Format(user.Version))
+
+	response := api.GetUserByIdResponse{
+		Id:   user.ID,
+		Name: user.Name,
 	}
 
-	id, err := h.useCases.CreateUsers(r.Context(), createUserRequestDTO)
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (h *Handlers) GetUserHistory(w http.ResponseWriter, r *http.Request, id int) {
+	history, err := h.useCases.UserHistory(r.Context(), id)
 	if err != nil {
-		var response api.ErrorResponse
-
-		switch {
-		case errors.Is(err, usecases.ErrValidation):
-			response = api.ErrorResponse{
-				Code:  3,
-				Error: err.Error(),
-			}
-			w.WriteHeader(http.StatusBadRequest)
-		default:
-			response = api.ErrorResponse{
-				Code:  -1,
-				Error: "Internal Server Error",
-			}
-			w.WriteHeader(http.StatusInternalServerError)
+		h.writeError(w, r, err, errcatalog.NotFound)
+
+		return
+	}
+
+	response := api.UserHistoryResponse{
+		Items: make([]api.UserHistoryEntry, 0, len(history)),
+	}
+
+	for _, entry := range history {
+		item := api.UserHistoryEntry{
+			Seq:      entry.Seq,
+			Action:   api.UserHistoryEntryAction(entry.Action),
+			At:       entry.At,
+			Version:  entry.Version,
+			Changes:  make([]api.UserHistoryChange, 0, len(entry.Changes)),
+			PrevHash: entry.PrevHash,
+			Hash:     entry.Hash,
 		}
 
-		responseBytes, err := json.Marshal(response)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
+		if entry.Actor != "" {
+			actor := entry.Actor
+			item.Actor = &actor
+		}
 
-			return
+		for _, change := range entry.Changes {
+			item.Changes = append(item.Changes, api.UserHistoryChange{
+				Field: change.Field,
+				From:  change.From,
+				To:    change.To,
+			})
 		}
 
-		w.Header().Set("Content-Type", "application/json")
+		response.Items = append(response.Items, item)
+	}
 
-		_, err = w.Write(responseBytes)
-		if err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
+	writeJSON(w, http.StatusOK, response)
+}
 
-			return
-		}
+func (h *Handlers) ListUsers(w http.ResponseWriter, r *http.Request, params api.ListUsersParams) {
+	var listUsersRequestDTO usecases.ListUsersRequestDTO
 
-		return
+	if params.Limit != nil {
+		listUsersRequestDTO.Limit = *params.Limit
 	}
 
-	response := api.CreateUserResponse{
-		Id: id,
+	if params.Cursor != nil {
+		listUsersRequestDTO.Cursor = *params.Cursor
 	}
 
-	responseBytes, err := json.Marshal(response)
+	if params.NamePrefix != nil {
+		listUsersRequestDTO.NamePrefix = *params.NamePrefix
+	}
+
+	if params.CreatedAfter != nil {
+		listUsersRequestDTO.CreatedAfter = *params.CreatedAfter
+	}
+
+	if params.Sort != nil {
+		listUsersRequestDTO.Sort = *params.Sort
+	}
+
+	if params.IncludeDeleted != nil {
+		listUsersRequestDTO.IncludeDeleted = *params.IncludeDeleted
+	}
+
+	page, err := h.useCases.ListUsers(r.Context(), listUsersRequestDTO)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		h.writeError(w, r, err, errcatalog.InvalidSort, errcatalog.Validation, errcatalog.Forbidden)
 
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
+	response := api.ListUsersResponse{
+		Items: make([]api.GetUserByIdResponse, 0, len(page.Users)),
+	}
+
+	for _, user := range page.Users {
+		item := api.GetUserByIdResponse{
+			Id:   user.ID,
+			Name: user.Name,
+		}
+
+		if user.Deleted() {
+			deletedAt := user.DeletedAt
+			item.DeletedAt = &deletedAt
+		}
 
-	_, err = w.Write(responseBytes)
+		response.Items = append(response.Items, item)
+	}
+
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// writeUpdateError - общая обработка ошибок UpdateUser и PatchUser
+func (h *Handlers) writeUpdateError(w http.ResponseWriter, r *http.Request, err error) {
+	h.writeError(w, r, err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, response any) {
+	responseBytes, err := json.Marshal(response)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 
 		return
 	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	_, _ = w.Write(responseBytes)
 }
@@ -4,15 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	api "server/generated"
-	"server/usecases"
+	"shared/custommethod"
+	"shared/incident"
+	"shared/usecases"
+	"shared/validation"
 )
 
 func readJSONBody[T any](t *testing.T, rr *httptest.ResponseRecorder) T {
@@ -35,14 +41,56 @@ func readJSONBody[T any](t *testing.T, rr *httptest.ResponseRecorder) T {
 	return got
 }
 
+// specValidator - проверка по спецификации, общая для тестов
+var specValidator = sync.OnceValues(func() (*validation.Validator, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	return validation.New(spec)
+})
+
+// checkResponse проваливает тест, если ответ обработчика операции operationID не соответствует спецификации.
+func checkResponse(t *testing.T, operationID string, rr *httptest.ResponseRecorder) {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	err = validator.ValidateResponse(operationID, rr.Code, rr.Header(), rr.Body.Bytes())
+	if err != nil {
+		t.Errorf("%v; body: %s", err, rr.Body.String())
+	}
+}
+
+// checkResponses - checkResponse для ответов, прошедших через роутер: операция определяется по запросу.
+func checkResponses(t *testing.T, next http.Handler) http.Handler {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	return validator.ResponseMiddleware(func(r *http.Request, err error) {
+		t.Errorf("%s %s: %v", r.Method, r.URL, err)
+	})(next)
+}
+
 func TestHTTPHandlers_GetUserById(t *testing.T) {
 	type fields struct {
 		setup func(t *testing.T) UseCases
 	}
 	type args struct {
-		id int
+		id          int
+		ifNoneMatch *string
 	}
 
+	etag := `"3"`
+
 	tests := []struct {
 		name           string
 		fields         fields
@@ -50,6 +98,7 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 		wantStatusCode int
 		wantCT         string
 		wantBody       any
+		wantETag       string
 	}{
 		{
 			name: "happy path",
@@ -59,7 +108,7 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 
 					m.EXPECT().
 						GetUser(mock.Anything, 1).
-						Return(usecases.User{ID: 1, Name: "Alice"}, nil).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
 						Once()
 
 					return m
@@ -72,6 +121,25 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 				Id:   1,
 				Name: "Alice",
 			},
+			wantETag: etag,
+		},
+		{
+			name: "not modified",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 1).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifNoneMatch: &etag},
+			wantStatusCode: http.StatusNotModified,
+			wantETag:       etag,
 		},
 		{
 			name: "not found",
@@ -95,6 +163,28 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 				Error: "Not Found",
 			},
 		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 6).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 6},
+			wantStatusCode: http.StatusGone,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  410,
+				Error: "Gone",
+			},
+		},
 		{
 			name: "internal error 1",
 			fields: fields{
@@ -172,7 +262,9 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 			req := httptest.NewRequest(http.MethodGet, "/users/", nil)
 			rr := httptest.NewRecorder()
 
-			h.GetUserById(rr, req, tt.args.id)
+			h.GetUserById(rr, req, tt.args.id, api.GetUserByIdParams{IfNoneMatch: tt.args.ifNoneMatch})
+
+			checkResponse(t, "GetUserById", rr)
 
 			if rr.Code != tt.wantStatusCode {
 				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
@@ -183,6 +275,10 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
 			}
 
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
 			switch want := tt.wantBody.(type) {
 			case api.GetUserByIdResponse:
 				got := readJSONBody[api.GetUserByIdResponse](t, rr)
@@ -194,6 +290,10 @@ func TestHTTPHandlers_GetUserById(t *testing.T) {
 				if !reflect.DeepEqual(got, want) {
 					t.Fatalf("body = %+v, want %+v", got, want)
 				}
+			case nil:
+				if rr.Body.Len() != 0 {
+					t.Fatalf("body = %q, want empty", rr.Body.String())
+				}
 			default:
 				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
 			}
@@ -235,7 +335,7 @@ func TestHTTPHandlers_CreateUser(t *testing.T) {
 				},
 			},
 			args:           args{body: api.CreateUserRequest{Name: "Alice"}},
-			wantStatusCode: http.StatusOK, // обычный сервер возвращает 200
+			wantStatusCode: http.StatusCreated,
 			wantCT:         "application/json",
 			wantBody: api.CreateUserResponse{
 				Id: 10,
@@ -266,6 +366,38 @@ func TestHTTPHandlers_CreateUser(t *testing.T) {
 				Error: usecases.ErrValidation.Error(),
 			},
 		},
+		{
+			name: "validation error with details",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsers(
+							mock.Anything,
+							usecases.CreateUserRequestDTO{Name: "<script>"},
+						).
+						Return(0, &usecases.ValidationError{
+							Fields: []usecases.FieldError{
+								{Field: "name", Rule: usecases.RuleCharset, Message: "may contain only letters, digits, spaces and - ' . _"},
+							},
+						}).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{body: api.CreateUserRequest{Name: "<script>"}},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  3,
+				Error: "validation error: name may contain only letters, digits, spaces and - ' . _",
+				Details: &[]api.ValidationErrorDetail{
+					{Field: "name", Rule: "charset", Message: "may contain only letters, digits, spaces and - ' . _"},
+				},
+			},
+		},
 		{
 			name: "internal server error",
 			fields: fields{
@@ -310,7 +442,9 @@ func TestHTTPHandlers_CreateUser(t *testing.T) {
 
 			rr := httptest.NewRecorder()
 
-			h.CreateUser(rr, req)
+			h.CreateUser(rr, req, api.CreateUserParams{})
+
+			checkResponse(t, "CreateUser", rr)
 
 			if rr.Code != tt.wantStatusCode {
 				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
@@ -338,3 +472,1670 @@ func TestHTTPHandlers_CreateUser(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPHandlers_CreateUsersBatch(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		body any
+	}
+
+	allOrNothing := true
+	id := 10
+	items := []api.CreateUserRequest{{Name: "Alice"}, {Name: ""}}
+	itemsDTO := []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: ""}}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+	}{
+		{
+			name: "partial success",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(
+							mock.Anything,
+							usecases.CreateUsersBatchRequestDTO{Items: itemsDTO},
+						).
+						Return(usecases.CreateUsersBatchResult{
+							Results: []usecases.CreateUserResult{{ID: 10}, {Err: usecases.ErrValidation}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{body: api.CreateUsersBatchRequest{Items: items}},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.CreateUsersBatchResponse{
+				Results: []api.CreateUsersBatchResult{
+					{Id: &id},
+					{Error: &api.ErrorResponse{Code: 3, Error: usecases.ErrValidation.Error()}},
+				},
+			},
+		},
+		{
+			name: "all or nothing rolled back",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(
+							mock.Anything,
+							usecases.CreateUsersBatchRequestDTO{Items: itemsDTO, AllOrNothing: true},
+						).
+						Return(usecases.CreateUsersBatchResult{
+							Results:    []usecases.CreateUserResult{{Err: usecases.ErrRolledBack}, {Err: usecases.ErrValidation}},
+							RolledBack: true,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{body: api.CreateUsersBatchRequest{Items: items, AllOrNothing: &allOrNothing}},
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantCT:         "application/json",
+			wantBody: api.CreateUsersBatchResponse{
+				Results: []api.CreateUsersBatchResult{
+					{Error: &api.ErrorResponse{Code: 5, Error: usecases.ErrRolledBack.Error()}},
+					{Error: &api.ErrorResponse{Code: 3, Error: usecases.ErrValidation.Error()}},
+				},
+			},
+		},
+		{
+			name: "empty batch",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(
+							mock.Anything,
+							usecases.CreateUsersBatchRequestDTO{Items: []usecases.CreateUserRequestDTO{}},
+						).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{body: api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{}}},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(
+							mock.Anything,
+							usecases.CreateUsersBatchRequestDTO{Items: itemsDTO},
+						).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{body: api.CreateUsersBatchRequest{Items: items}},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			bodyBytes, err := json.Marshal(tt.args.body)
+			if err != nil {
+				t.Fatalf("failed to marshal request body: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/users:batch", bytes.NewReader(bodyBytes))
+			req = req.WithContext(context.Background())
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			h.CreateUsersBatch(rr, req)
+
+			checkResponse(t, "CreateUsersBatch", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case api.CreateUsersBatchResponse:
+				got := readJSONBody[api.CreateUsersBatchResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_UpdateUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id      int
+		ifMatch string
+		body    any
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+		wantETag       string
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, body: api.UpdateUserRequest{Name: "Bob"}},
+			wantETag:       `"2"`,
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.GetUserByIdResponse{
+				Id:   1,
+				Name: "Bob",
+			},
+		},
+		{
+			name: "validation error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: ""},
+						).
+						Return(usecases.User{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, body: api.UpdateUserRequest{Name: ""}},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							2,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2, body: api.UpdateUserRequest{Name: "Bob"}},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+		},
+		{
+			name: "precondition failed",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, body: api.UpdateUserRequest{Name: "Bob"}},
+			wantStatusCode: http.StatusPreconditionFailed,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  8,
+				Error: "Precondition Failed",
+			},
+		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							4,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 4, body: api.UpdateUserRequest{Name: "Bob"}},
+			wantStatusCode: http.StatusGone,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  410,
+				Error: "Gone",
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							3,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotPublic1).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3, body: api.UpdateUserRequest{Name: "Bob"}},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			bodyBytes, err := json.Marshal(tt.args.body)
+			if err != nil {
+				t.Fatalf("failed to marshal request body: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/users/", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			h.UpdateUser(rr, req, tt.args.id, api.UpdateUserParams{IfMatch: tt.args.ifMatch})
+
+			checkResponse(t, "UpdateUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case api.GetUserByIdResponse:
+				got := readJSONBody[api.GetUserByIdResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_PatchUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id      int
+		ifMatch string
+		body    any
+	}
+
+	name := "Bob"
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+		wantETag       string
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, body: api.PatchUserRequest{Name: &name}},
+			wantETag:       `"2"`,
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.GetUserByIdResponse{
+				Id:   1,
+				Name: "Bob",
+			},
+		},
+		{
+			name: "empty patch",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{IfMatch: usecases.VersionMatch{Any: true}},
+						).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 4}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: "*", body: api.PatchUserRequest{}},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.GetUserByIdResponse{
+				Id:   1,
+				Name: "Alice",
+			},
+			wantETag: `"4"`,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							2,
+							usecases.PatchUserRequestDTO{Name: &name},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2, body: api.PatchUserRequest{Name: &name}},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+		},
+		{
+			name: "precondition failed",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1, ifMatch: `"1"`, body: api.PatchUserRequest{Name: &name}},
+			wantStatusCode: http.StatusPreconditionFailed,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  8,
+				Error: "Precondition Failed",
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							3,
+							usecases.PatchUserRequestDTO{Name: &name},
+						).
+						Return(usecases.User{}, usecases.ErrNotPublic2).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3, body: api.PatchUserRequest{Name: &name}},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			bodyBytes, err := json.Marshal(tt.args.body)
+			if err != nil {
+				t.Fatalf("failed to marshal request body: %v", err)
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/users/", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+
+			rr := httptest.NewRecorder()
+
+			h.PatchUser(rr, req, tt.args.id, api.PatchUserParams{IfMatch: tt.args.ifMatch})
+
+			checkResponse(t, "PatchUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case api.GetUserByIdResponse:
+				got := readJSONBody[api.GetUserByIdResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_DeleteUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id int
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 1).
+						Return(nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1},
+			wantStatusCode: http.StatusNoContent,
+			wantCT:         "",
+			wantBody:       nil,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 2).
+						Return(usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+		},
+		{
+			name: "already deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 4).
+						Return(usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 4},
+			wantStatusCode: http.StatusGone,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  410,
+				Error: "Gone",
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 3).
+						Return(usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/users/", nil)
+			rr := httptest.NewRecorder()
+
+			h.DeleteUser(rr, req, tt.args.id)
+
+			checkResponse(t, "DeleteUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case nil:
+				if rr.Body.Len() != 0 {
+					t.Fatalf("body = %q, want empty", rr.Body.String())
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_RestoreUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id int
+	}
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+		wantETag       string
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 1).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.GetUserByIdResponse{
+				Id:   1,
+				Name: "Alice",
+			},
+			wantETag: `"3"`,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 2).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 3).
+						Return(usecases.User{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/users/", nil)
+			rr := httptest.NewRecorder()
+
+			h.RestoreUser(rr, req, tt.args.id)
+
+			checkResponse(t, "RestoreUser", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Fatalf("ETag = %q, want %q", got, tt.wantETag)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case api.GetUserByIdResponse:
+				got := readJSONBody[api.GetUserByIdResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// Путь с пользовательским методом ":restore" должен доходить до RestoreUser с числовым id.
+func TestHTTPHandlers_RestoreUser_Routing(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		RestoreUser(mock.Anything, 7).
+		Return(usecases.User{ID: 7, Name: "Alice", Version: 2}, nil).
+		Once()
+
+	handler := api.HandlerFromMux(New(m, nil), custommethod.NewServeMux())
+
+	req := httptest.NewRequest(http.MethodPost, "/users/7:restore", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, handler).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+func TestHTTPHandlers_GetUserHistory(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		id int
+	}
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	actor := "admin"
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 1).
+						Return([]usecases.AuditEntry{
+							{
+								Seq:     1,
+								UserID:  1,
+								Action:  usecases.AuditActionCreate,
+								Actor:   actor,
+								At:      at,
+								Version: 1,
+								Changes: []usecases.AuditChange{{Field: "name", To: "Alice"}},
+								Hash:    "h1",
+							},
+							{
+								Seq:      3,
+								UserID:   1,
+								Action:   usecases.AuditActionDelete,
+								At:       at,
+								Version:  2,
+								Changes:  []usecases.AuditChange{},
+								PrevHash: "h2",
+								Hash:     "h3",
+							},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 1},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.UserHistoryResponse{
+				Items: []api.UserHistoryEntry{
+					{
+						Seq:     1,
+						Action:  api.Create,
+						Actor:   &actor,
+						At:      at,
+						Version: 1,
+						Changes: []api.UserHistoryChange{{Field: "name", To: "Alice"}},
+						Hash:    "h1",
+					},
+					{
+						Seq:      3,
+						Action:   api.Delete,
+						At:       at,
+						Version:  2,
+						Changes:  []api.UserHistoryChange{},
+						PrevHash: "h2",
+						Hash:     "h3",
+					},
+				},
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 2).
+						Return(nil, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 2},
+			wantStatusCode: http.StatusNotFound,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 3).
+						Return(nil, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{id: 3},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users/", nil)
+			rr := httptest.NewRecorder()
+
+			h.GetUserHistory(rr, req, tt.args.id)
+
+			checkResponse(t, "GetUserHistory", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case api.UserHistoryResponse:
+				got := readJSONBody[api.UserHistoryResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_GetUserHistory_Routing(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		UserHistory(mock.Anything, 7).
+		Return([]usecases.AuditEntry{}, nil).
+		Once()
+
+	handler := api.HandlerFromMux(New(m, nil), custommethod.NewServeMux())
+
+	req := httptest.NewRequest(http.MethodGet, "/users/7/history", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, handler).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+func TestHTTPHandlers_ListUsers(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		params api.ListUsersParams
+	}
+
+	limit := 2
+	cursor := "eyJhZnRlcl9pZCI6Mn0"
+	nextCursor := "eyJhZnRlcl9pZCI6NH0"
+	namePrefix := "Al"
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sort := []string{"name", "-id"}
+	includeDeleted := true
+	deletedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		fields         fields
+		args           args
+		wantStatusCode int
+		wantCT         string
+		wantBody       any
+	}{
+		{
+			name: "first page",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{}},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.ListUsersResponse{
+				Items: []api.GetUserByIdResponse{{Id: 1, Name: "Alice"}},
+			},
+		},
+		{
+			name: "page with cursor",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 2, Cursor: cursor}).
+						Return(usecases.UsersPage{
+							Users:      []usecases.User{{ID: 3, Name: "Carol"}, {ID: 4, Name: "Dave"}},
+							NextCursor: nextCursor,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{Limit: &limit, Cursor: &cursor}},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.ListUsersResponse{
+				Items:      []api.GetUserByIdResponse{{Id: 3, Name: "Carol"}, {Id: 4, Name: "Dave"}},
+				NextCursor: &nextCursor,
+			},
+		},
+		{
+			name: "filters and sort",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+							NamePrefix:   namePrefix,
+							CreatedAfter: createdAfter,
+							Sort:         sort,
+						}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{params: api.ListUsersParams{
+				NamePrefix:   &namePrefix,
+				CreatedAfter: &createdAfter,
+				Sort:         &sort,
+			}},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.ListUsersResponse{
+				Items: []api.GetUserByIdResponse{{Id: 1, Name: "Alice"}},
+			},
+		},
+		{
+			name: "include deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{IncludeDeleted: true}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob", DeletedAt: deletedAt}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{IncludeDeleted: &includeDeleted}},
+			wantStatusCode: http.StatusOK,
+			wantCT:         "application/json",
+			wantBody: api.ListUsersResponse{
+				Items: []api.GetUserByIdResponse{{Id: 1, Name: "Alice"}, {Id: 2, Name: "Bob", DeletedAt: &deletedAt}},
+			},
+		},
+		{
+			name: "invalid sort",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Sort: []string{"email"}}).
+						Return(usecases.UsersPage{}, usecases.ErrInvalidSort).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{Sort: &[]string{"email"}}},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  4,
+				Error: usecases.ErrInvalidSort.Error(),
+			},
+		},
+		{
+			name: "include deleted without operator token",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{IncludeDeleted: true}).
+						Return(usecases.UsersPage{}, usecases.ErrForbidden).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{IncludeDeleted: &includeDeleted}},
+			wantStatusCode: http.StatusForbidden,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  15,
+				Error: "Forbidden",
+			},
+		},
+		{
+			name: "invalid cursor",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Cursor: cursor}).
+						Return(usecases.UsersPage{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{Cursor: &cursor}},
+			wantStatusCode: http.StatusBadRequest,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{}).
+						Return(usecases.UsersPage{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args:           args{params: api.ListUsersParams{}},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCT:         "application/json",
+			wantBody: api.ErrorResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/users", nil)
+			rr := httptest.NewRecorder()
+
+			h.ListUsers(rr, req, tt.args.params)
+
+			checkResponse(t, "ListUsers", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			ct := rr.Header().Get("Content-Type")
+			if ct != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", ct, tt.wantCT)
+			}
+
+			switch want := tt.wantBody.(type) {
+			case api.ListUsersResponse:
+				got := readJSONBody[api.ListUsersResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			case api.ErrorResponse:
+				got := readJSONBody[api.ErrorResponse](t, rr)
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("body = %+v, want %+v", got, want)
+				}
+			default:
+				t.Fatalf("unsupported wantBody type: %T", tt.wantBody)
+			}
+		})
+	}
+}
+
+// Параметры фильтрации и сортировки должны разбираться сгенерированным биндингом запроса.
+func TestHTTPHandlers_ListUsers_QueryBinding(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+			Limit:          5,
+			NamePrefix:     "Al",
+			CreatedAfter:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Sort:           []string{"name", "-id"},
+			IncludeDeleted: true,
+		}).
+		Return(usecases.UsersPage{}, nil).
+		Once()
+
+	handler := api.HandlerFromMux(New(m, nil), custommethod.NewServeMux())
+
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=5&name_prefix=Al&created_after=2025-01-01T00:00:00Z&sort=name,-id&include_deleted=true", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, handler).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+func TestHTTPHandlers_Incident(t *testing.T) {
+	notPublic := fmt.Errorf("load user: %w", usecases.ErrNotPublic1)
+
+	tests := []struct {
+		name             string
+		err              error
+		debug            bool
+		wantStatusCode   int
+		wantIncident     bool
+		wantDebugMessage string
+	}{
+		{
+			name:           "internal error is recorded",
+			err:            notPublic,
+			wantStatusCode: http.StatusInternalServerError,
+			wantIncident:   true,
+		},
+		{
+			name:             "debug mode returns the underlying message",
+			err:              notPublic,
+			debug:            true,
+			wantStatusCode:   http.StatusInternalServerError,
+			wantIncident:     true,
+			wantDebugMessage: "load user: we can't expose this text 1",
+		},
+		{
+			name:           "client error is not an incident",
+			err:            usecases.ErrNotFound,
+			debug:          true,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockUseCases(t)
+
+			m.EXPECT().
+				GetUser(mock.Anything, 1).
+				Return(usecases.User{}, tt.err).
+				Once()
+
+			sink := incident.NewMemory()
+			h := New(m, incident.New(sink))
+
+			req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			if tt.debug {
+				req = req.WithContext(incident.WithDebug(req.Context()))
+			}
+
+			rr := httptest.NewRecorder()
+
+			h.GetUserById(rr, req, 1, api.GetUserByIdParams{})
+
+			checkResponse(t, "GetUserById", rr)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			got := readJSONBody[api.ErrorResponse](t, rr)
+
+			var debugMessage string
+			if got.DebugMessage != nil {
+				debugMessage = *got.DebugMessage
+			}
+
+			if debugMessage != tt.wantDebugMessage {
+				t.Fatalf("debug_message = %q, want %q", debugMessage, tt.wantDebugMessage)
+			}
+
+			if !tt.wantIncident {
+				if got.IncidentId != nil {
+					t.Fatalf("incident_id = %q, want none", *got.IncidentId)
+				}
+
+				return
+			}
+
+			if got.IncidentId == nil {
+				t.Fatal("incident_id is missing")
+			}
+
+			recorded, err := sink.Get(*got.IncidentId)
+			if err != nil {
+				t.Fatalf("sink.Get() error = %v", err)
+			}
+
+			wantChain := []string{tt.err.Error(), usecases.ErrNotPublic1.Error()}
+			if recorded.Code != 1 || !reflect.DeepEqual(recorded.Chain, wantChain) {
+				t.Fatalf("incident = %+v, want code 1 and chain %q", recorded, wantChain)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_FrameworkErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		wantStatusCode int
+		wantCode       api.ErrorResponseCode
+		wantAllow      string
+	}{
+		{
+			name:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/users/abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=many",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "malformed body",
+			method:         http.MethodPost,
+			target:         "/users",
+			body:           `{"name":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeMalformedBody,
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodGet,
+			target:         "/accounts",
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       api.ErrorResponseCodeRouteNotFound,
+		},
+		{
+			name:           "unknown custom method",
+			method:         http.MethodPost,
+			target:         "/users/1:archive",
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       api.ErrorResponseCodeRouteNotFound,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPut,
+			target:         "/users",
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantCode:       api.ErrorResponseCodeMethodNotAllowed,
+			wantAllow:      "GET, HEAD, POST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(NewMockUseCases(t), nil)
+
+			router := custommethod.NewServeMux()
+			router.NotFound = http.HandlerFunc(h.NotFound)
+			router.MethodNotAllowed = http.HandlerFunc(h.MethodNotAllowed)
+
+			handler := api.HandlerWithOptions(h, api.StdHTTPServerOptions{
+				BaseRouter:       router,
+				ErrorHandlerFunc: h.ParameterError,
+			})
+
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, handler).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			got := readJSONBody[api.ErrorResponse](t, rr)
+			if got.Code != tt.wantCode || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantCode)
+			}
+
+			if allow := rr.Header().Get("Allow"); allow != tt.wantAllow {
+				t.Fatalf("Allow = %q, want %q", allow, tt.wantAllow)
+			}
+		})
+	}
+}
+
+func TestHTTPHandlers_RequestValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		body           string
+		wantStatusCode int
+		wantCode       api.ErrorResponseCode
+		wantDetails    *[]api.ValidationErrorDetail
+	}{
+		{
+			name:           "missing required field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeValidation,
+			wantDetails:    &[]api.ValidationErrorDetail{{Field: "name", Rule: usecases.RuleRequired, Message: "is required"}},
+		},
+		{
+			name:           "unknown field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":"Alice","admin":true}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeValidation,
+			wantDetails:    &[]api.ValidationErrorDetail{{Field: "admin", Rule: usecases.RuleUnknown, Message: "is not allowed"}},
+		},
+		{
+			name:           "missing body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeMalformedBody,
+		},
+		{
+			name:           "unsupported content type",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "text/plain",
+			body:           `{"name":"Alice"}`,
+			wantStatusCode: http.StatusUnsupportedMediaType,
+			wantCode:       api.ErrorResponseCodeUnsupportedMediaType,
+		},
+		{
+			name:           "out of range query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=500",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeValidation,
+			wantDetails:    &[]api.ValidationErrorDetail{{Field: "limit", Rule: usecases.RuleRange, Message: "must be at most 100"}},
+		},
+	}
+
+	spec, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	validator, err := validation.New(spec)
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// usecases не должны вызываться: запрос отклоняется до обработчика
+			h := New(NewMockUseCases(t), nil)
+
+			handler := validator.Middleware(h.RequestError)(api.HandlerWithOptions(h, api.StdHTTPServerOptions{
+				BaseRouter:       custommethod.NewServeMux(),
+				ErrorHandlerFunc: h.ParameterError,
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewBufferString(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, handler).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			got := readJSONBody[api.ErrorResponse](t, rr)
+			if got.Code != tt.wantCode || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantCode)
+			}
+
+			if !reflect.DeepEqual(got.Details, tt.wantDetails) {
+				t.Fatalf("details = %+v, want %+v", got.Details, tt.wantDetails)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package adapter
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"shared/idempotency"
+)
+
+// Idempotency - idempotency.Middleware в виде middleware echo.
+func Idempotency(store *idempotency.Store, routes ...idempotency.Route) echo.MiddlewareFunc {
+	return echo.WrapMiddleware(idempotency.Middleware(store, routes...))
+}
@@ -0,0 +1,62 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"shared/idempotency"
+)
+
+// createUser - операция, которую middleware делает идемпотентной в тестах
+var createUser = idempotency.Route{Method: http.MethodPost, Path: "/users"}
+
+func TestIdempotency(t *testing.T) {
+	calls := 0
+
+	e := echo.New()
+	e.Use(Idempotency(idempotency.NewStore(time.Hour, 100), createUser))
+	e.POST("/users", func(c echo.Context) error {
+		calls++
+
+		return c.JSON(http.StatusCreated, map[string]int{"id": calls})
+	})
+
+	send := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		req.Header.Set(idempotency.Header, "key-1")
+
+		rr := httptest.NewRecorder()
+		e.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	first := send(`{"name":"Alice"}`)
+	second := send(`{"name":"Alice"}`)
+
+	if first.Code != http.StatusCreated || second.Code != http.StatusCreated {
+		t.Fatalf("status = %d, %d, want %d", first.Code, second.Code, http.StatusCreated)
+	}
+
+	if second.Body.String() != first.Body.String() {
+		t.Fatalf("replay body = %q, want %q", second.Body.String(), first.Body.String())
+	}
+
+	if got := second.Header().Get(idempotency.ReplayedHeader); got != "true" {
+		t.Fatalf("replay %s = %q, want %q", idempotency.ReplayedHeader, got, "true")
+	}
+
+	mismatch := send(`{"name":"Bob"}`)
+	if mismatch.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("mismatch status = %d, want %d", mismatch.Code, http.StatusUnprocessableEntity)
+	}
+
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+}
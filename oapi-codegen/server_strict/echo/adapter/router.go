@@ -0,0 +1,134 @@
+package adapter
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"shared/custommethod"
+)
+
+// Router - роутер для RegisterHandlers поверх *echo.Echo, понимающий шаблоны "/users/:id:restore".
+type Router struct {
+	echo   *echo.Echo
+	routes map[string]*echoRoute
+}
+
+type echoRoute struct {
+	*custommethod.Route[echo.HandlerFunc]
+	echoRoute *echo.Route
+}
+
+func NewRouter(e *echo.Echo) *Router {
+	return &Router{
+		echo:   e,
+		routes: make(map[string]*echoRoute),
+	}
+}
+
+func (r *Router) CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodConnect, path, h, m...)
+}
+
+func (r *Router) DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodDelete, path, h, m...)
+}
+
+func (r *Router) GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodGet, path, h, m...)
+}
+
+func (r *Router) HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodHead, path, h, m...)
+}
+
+func (r *Router) OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodOptions, path, h, m...)
+}
+
+func (r *Router) PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPatch, path, h, m...)
+}
+
+func (r *Router) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPost, path, h, m...)
+}
+
+func (r *Router) PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodPut, path, h, m...)
+}
+
+func (r *Router) TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	return r.add(http.MethodTrace, path, h, m...)
+}
+
+func (r *Router) add(method string, path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	base, param, customMethod, ok := splitColonPattern(path)
+	if !ok {
+		return r.echo.Add(method, path, h, m...)
+	}
+
+	// middleware маршрута применяем сами: echo видит только общий обработчик шаблона
+	for i := len(m) - 1; i >= 0; i-- {
+		h = m[i](h)
+	}
+
+	key := method + " " + base
+
+	rt, exists := r.routes[key]
+	if !exists {
+		rt = &echoRoute{
+			Route: custommethod.NewRoute[echo.HandlerFunc](param),
+		}
+		r.routes[key] = rt
+
+		rt.echoRoute = r.echo.Add(method, base, func(c echo.Context) error {
+			handler, value, ok := rt.Match(c.Param(rt.Param))
+			if ok {
+				setEchoParam(c, rt.Param, value)
+			}
+
+			if handler == nil {
+				return echo.ErrNotFound
+			}
+
+			return handler(c)
+		})
+	}
+
+	rt.Add(customMethod, h)
+
+	return rt.echoRoute
+}
+
+func setEchoParam(c echo.Context, name string, value string) {
+	values := slices.Clone(c.ParamValues())
+
+	for i, n := range c.ParamNames() {
+		if n == name && i < len(values) {
+			values[i] = value
+		}
+	}
+
+	c.SetParamValues(values...)
+}
+
+// splitColonPattern разбирает шаблон, последний сегмент которого - параметр ":id" или ":id:method".
+func splitColonPattern(pattern string) (base string, param string, method string, ok bool) {
+	i := strings.LastIndexByte(pattern, '/')
+	segment := pattern[i+1:]
+
+	rest, ok := strings.CutPrefix(segment, ":")
+	if !ok {
+		return "", "", "", false
+	}
+
+	param, method, _ = strings.Cut(rest, ":")
+	if param == "" {
+		return "", "", "", false
+	}
+
+	return pattern[:i+1] + ":" + param, param, method, true
+}
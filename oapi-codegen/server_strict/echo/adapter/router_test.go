@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRouter(t *testing.T) {
+	e := echo.New()
+	r := NewRouter(e)
+
+	handler := func(name string) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return c.String(http.StatusOK, name+" "+c.Param("id"))
+		}
+	}
+
+	r.GET("/users/:id", handler("get"))
+	r.POST("/users/:id:restore", handler("restore"))
+	r.POST("/users:batch", handler("batch"))
+
+	tests := []struct {
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{method: http.MethodGet, path: "/users/5", wantStatus: http.StatusOK, wantBody: "get 5"},
+		{method: http.MethodPost, path: "/users/5:restore", wantStatus: http.StatusOK, wantBody: "restore 5"},
+		{method: http.MethodPost, path: "/users:batch", wantStatus: http.StatusOK, wantBody: "batch "},
+		{method: http.MethodPost, path: "/users/5:unknown", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			e.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+
+			if tt.wantBody != "" && rr.Body.String() != tt.wantBody {
+				t.Fatalf("body = %q, want %q", rr.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
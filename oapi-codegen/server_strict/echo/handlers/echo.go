@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"shared/errcatalog"
+)
+
+// EchoErrorHandler - echo.HTTPErrorHandler: ошибки echo и сгенерированной обертки (разбор параметров и тела,
+// маршрутизация) отдаются в виде ErrorResponse.
+func (h *Handlers) EchoErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	entry, reason := echoErrorEntry(err)
+	response := h.errorResponse(c.Request().Context(), reason, entry)
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(entry.Status)
+	} else {
+		err = c.JSON(entry.Status, response)
+	}
+
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+// echoErrorEntry выбирает запись каталога для ошибки echo. reason - ошибка для ErrorResponse: у echo.HTTPError
+// это ее сообщение, без кода и внутренней ошибки.
+func echoErrorEntry(err error) (errcatalog.Entry, error) {
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) {
+		return errcatalog.Internal, err
+	}
+
+	reason := errors.New(fmt.Sprint(httpErr.Message))
+
+	switch httpErr.Code {
+	case http.StatusBadRequest:
+		// echo.Context.Bind сохраняет исходную ошибку разбора тела в Internal, обертка для параметров - нет
+		if httpErr.Internal != nil {
+			return errcatalog.MalformedBody, reason
+		}
+
+		return errcatalog.InvalidParameter, reason
+	case http.StatusNotFound:
+		return errcatalog.RouteNotFound, reason
+	case http.StatusMethodNotAllowed:
+		return errcatalog.MethodNotAllowed, reason
+	case http.StatusUnsupportedMediaType:
+		return errcatalog.UnsupportedMediaType, reason
+	default:
+		return errcatalog.Internal, err
+	}
+}
@@ -2,15 +2,64 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	api "server/generated"
-	"server/usecases"
+	"shared/incident"
+	"shared/usecases"
+	"shared/validation"
 )
 
+// specValidator - проверка по спецификации, общая для тестов
+var specValidator = sync.OnceValues(func() (*validation.Validator, error) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	return validation.New(spec)
+})
+
+// validateResponse проваливает тест, если записанный ответ операции operationID не соответствует спецификации.
+// Ответы strict-обработчиков записывает checkResponse - своя для каждого фреймворка.
+func validateResponse(t *testing.T, operationID string, statusCode int, header http.Header, body []byte) {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	err = validator.ValidateResponse(operationID, statusCode, header, body)
+	if err != nil {
+		t.Errorf("%v; body: %s", err, body)
+	}
+}
+
+// checkResponses - проверка ответов, прошедших через net/http-обработчик: операция определяется по запросу.
+func checkResponses(t *testing.T, next http.Handler) http.Handler {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	return validator.ResponseMiddleware(func(r *http.Request, err error) {
+		t.Errorf("%s %s: %v", r.Method, r.URL, err)
+	})(next)
+}
+
 func TestHandlers_GetUserById(t *testing.T) {
 	type fields struct {
 		setup func(t *testing.T) UseCases
@@ -19,6 +68,9 @@ func TestHandlers_GetUserById(t *testing.T) {
 		ctx     context.Context
 		request api.GetUserByIdRequestObject
 	}
+
+	etag := `"3"`
+
 	tests := []struct {
 		name    string
 		fields  fields
@@ -36,8 +88,9 @@ func TestHandlers_GetUserById(t *testing.T) {
 						GetUser(mock.Anything, 1).
 						Return(
 							usecases.User{
-								ID:   1,
-								Name: "Alice",
+								ID:      1,
+								Name:    "Alice",
+								Version: 3,
 							},
 							nil,
 						).
@@ -53,8 +106,50 @@ func TestHandlers_GetUserById(t *testing.T) {
 				},
 			},
 			want: api.GetUserById200JSONResponse{
-				Id:   1,
-				Name: "Alice",
+				Body: api.GetUserByIdResponse{
+					Id:   1,
+					Name: "Alice",
+				},
+				Headers: api.GetUserById200ResponseHeaders{
+					ETag: `"3"`,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not modified",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 1).
+						Return(
+							usecases.User{
+								ID:      1,
+								Name:    "Alice",
+								Version: 3,
+							},
+							nil,
+						).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.GetUserByIdRequestObject{
+					Id: 1,
+					Params: api.GetUserByIdParams{
+						IfNoneMatch: &etag,
+					},
+				},
+			},
+			want: api.GetUserById304Response{
+				Headers: api.GetUserById304ResponseHeaders{
+					ETag: `"3"`,
+				},
 			},
 			wantErr: false,
 		},
@@ -87,6 +182,32 @@ func TestHandlers_GetUserById(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 6).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.GetUserByIdRequestObject{
+					Id: 6,
+				},
+			},
+			want: api.GetUserById410JSONResponse{
+				Code:  410,
+				Error: "Gone",
+			},
+			wantErr: false,
+		},
 		{
 			name: "internal error 1",
 			fields: fields{
@@ -190,6 +311,8 @@ func TestHandlers_GetUserById(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetUserById() got = %v, want %v", got, tt.want)
 			}
+
+			checkResponse(t, "GetUserById", got)
 		})
 	}
 }
@@ -270,6 +393,44 @@ func TestHandlers_CreateUser(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "validation error with details",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsers(
+							mock.Anything,
+							usecases.CreateUserRequestDTO{Name: "<script>"},
+						).
+						Return(0, &usecases.ValidationError{
+							Fields: []usecases.FieldError{
+								{Field: "name", Rule: usecases.RuleCharset, Message: "may contain only letters, digits, spaces and - ' . _"},
+							},
+						}).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.CreateUserRequestObject{
+					Body: &api.CreateUserJSONRequestBody{
+						Name: "<script>",
+					},
+				},
+			},
+			want: api.CreateUser400JSONResponse{
+				Code:  3,
+				Error: "validation error: name may contain only letters, digits, spaces and - ' . _",
+				Details: &[]api.ValidationErrorDetail{
+					{Field: "name", Rule: "charset", Message: "may contain only letters, digits, spaces and - ' . _"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "internal server error",
 			fields: fields{
@@ -318,6 +479,1516 @@ func TestHandlers_CreateUser(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("CreateUser() got = %v, want %v", got, tt.want)
 			}
+
+			checkResponse(t, "CreateUser", got)
+		})
+	}
+}
+
+func TestHandlers_CreateUsersBatch(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.CreateUsersBatchRequestObject
+	}
+
+	allOrNothing := true
+	id := 10
+	items := []api.CreateUserRequest{{Name: "Alice"}, {Name: ""}}
+	itemsDTO := []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: ""}}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.CreateUsersBatchResponseObject
+		wantErr bool
+	}{
+		{
+			name: "partial success",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{
+							Results: []usecases.CreateUserResult{{ID: 10}, {Err: usecases.ErrValidation}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.CreateUsersBatchRequestObject{
+					Body: &api.CreateUsersBatchRequest{Items: items},
+				},
+			},
+			want: api.CreateUsersBatch200JSONResponse{
+				Results: []api.CreateUsersBatchResult{
+					{Id: &id},
+					{Error: &api.ErrorResponse{Code: 3, Error: usecases.ErrValidation.Error()}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "all or nothing rolled back",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO, AllOrNothing: true}).
+						Return(usecases.CreateUsersBatchResult{
+							Results:    []usecases.CreateUserResult{{Err: usecases.ErrRolledBack}, {Err: usecases.ErrValidation}},
+							RolledBack: true,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.CreateUsersBatchRequestObject{
+					Body: &api.CreateUsersBatchRequest{Items: items, AllOrNothing: &allOrNothing},
+				},
+			},
+			want: api.CreateUsersBatch422JSONResponse{
+				Results: []api.CreateUsersBatchResult{
+					{Error: &api.ErrorResponse{Code: 5, Error: usecases.ErrRolledBack.Error()}},
+					{Error: &api.ErrorResponse{Code: 3, Error: usecases.ErrValidation.Error()}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty batch",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: []usecases.CreateUserRequestDTO{}}).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.CreateUsersBatchRequestObject{
+					Body: &api.CreateUsersBatchRequest{},
+				},
+			},
+			want: api.CreateUsersBatch400JSONResponse{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.CreateUsersBatchRequestObject{
+					Body: &api.CreateUsersBatchRequest{Items: items},
+				},
+			},
+			want: api.CreateUsersBatch500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.CreateUsersBatch(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateUsersBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateUsersBatch() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "CreateUsersBatch", got)
+		})
+	}
+}
+
+func TestHandlers_UpdateUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.UpdateUserRequestObject
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.UpdateUserResponseObject
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.UpdateUserRequestObject{
+					Id: 1,
+					Params: api.UpdateUserParams{
+						IfMatch: `"1"`,
+					},
+					Body: &api.UpdateUserJSONRequestBody{
+						Name: "Bob",
+					},
+				},
+			},
+			want: api.UpdateUser200JSONResponse{
+				Body: api.GetUserByIdResponse{
+					Id:   1,
+					Name: "Bob",
+				},
+				Headers: api.UpdateUser200ResponseHeaders{
+					ETag: `"2"`,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "validation error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: ""},
+						).
+						Return(usecases.User{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.UpdateUserRequestObject{
+					Id: 1,
+					Body: &api.UpdateUserJSONRequestBody{
+						Name: "",
+					},
+				},
+			},
+			want: api.UpdateUser400JSONResponse{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							2,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.UpdateUserRequestObject{
+					Id: 2,
+					Body: &api.UpdateUserJSONRequestBody{
+						Name: "Bob",
+					},
+				},
+			},
+			want: api.UpdateUser404JSONResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							4,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.UpdateUserRequestObject{
+					Id: 4,
+					Body: &api.UpdateUserJSONRequestBody{
+						Name: "Bob",
+					},
+				},
+			},
+			want: api.UpdateUser410JSONResponse{
+				Code:  410,
+				Error: "Gone",
+			},
+			wantErr: false,
+		},
+		{
+			name: "precondition failed",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.UpdateUserRequestObject{
+					Id: 1,
+					Params: api.UpdateUserParams{
+						IfMatch: `"1"`,
+					},
+					Body: &api.UpdateUserJSONRequestBody{
+						Name: "Bob",
+					},
+				},
+			},
+			want: api.UpdateUser412JSONResponse{
+				Code:  8,
+				Error: "Precondition Failed",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							3,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotPublic1).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.UpdateUserRequestObject{
+					Id: 3,
+					Body: &api.UpdateUserJSONRequestBody{
+						Name: "Bob",
+					},
+				},
+			},
+			want: api.UpdateUser500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.UpdateUser(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "UpdateUser", got)
+		})
+	}
+}
+
+func TestHandlers_PatchUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.PatchUserRequestObject
+	}
+
+	name := "Bob"
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.PatchUserResponseObject
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.PatchUserRequestObject{
+					Id: 1,
+					Params: api.PatchUserParams{
+						IfMatch: `"1"`,
+					},
+					Body: &api.PatchUserJSONRequestBody{
+						Name: &name,
+					},
+				},
+			},
+			want: api.PatchUser200JSONResponse{
+				Body: api.GetUserByIdResponse{
+					Id:   1,
+					Name: "Bob",
+				},
+				Headers: api.PatchUser200ResponseHeaders{
+					ETag: `"2"`,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty patch",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{IfMatch: usecases.VersionMatch{Any: true}},
+						).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 4}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.PatchUserRequestObject{
+					Id: 1,
+					Params: api.PatchUserParams{
+						IfMatch: "*",
+					},
+					Body: &api.PatchUserJSONRequestBody{},
+				},
+			},
+			want: api.PatchUser200JSONResponse{
+				Body: api.GetUserByIdResponse{
+					Id:   1,
+					Name: "Alice",
+				},
+				Headers: api.PatchUser200ResponseHeaders{
+					ETag: `"4"`,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							2,
+							usecases.PatchUserRequestDTO{Name: &name},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.PatchUserRequestObject{
+					Id: 2,
+					Body: &api.PatchUserJSONRequestBody{
+						Name: &name,
+					},
+				},
+			},
+			want: api.PatchUser404JSONResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "precondition failed",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.PatchUserRequestObject{
+					Id: 1,
+					Params: api.PatchUserParams{
+						IfMatch: `"1"`,
+					},
+					Body: &api.PatchUserJSONRequestBody{
+						Name: &name,
+					},
+				},
+			},
+			want: api.PatchUser412JSONResponse{
+				Code:  8,
+				Error: "Precondition Failed",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							3,
+							usecases.PatchUserRequestDTO{Name: &name},
+						).
+						Return(usecases.User{}, usecases.ErrNotPublic2).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.PatchUserRequestObject{
+					Id: 3,
+					Body: &api.PatchUserJSONRequestBody{
+						Name: &name,
+					},
+				},
+			},
+			want: api.PatchUser500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.PatchUser(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PatchUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PatchUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "PatchUser", got)
+		})
+	}
+}
+
+func TestHandlers_DeleteUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.DeleteUserRequestObject
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.DeleteUserResponseObject
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 1).
+						Return(nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.DeleteUserRequestObject{
+					Id: 1,
+				},
+			},
+			want:    api.DeleteUser204Response{},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 2).
+						Return(usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.DeleteUserRequestObject{
+					Id: 2,
+				},
+			},
+			want: api.DeleteUser404JSONResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "already deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 4).
+						Return(usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.DeleteUserRequestObject{
+					Id: 4,
+				},
+			},
+			want: api.DeleteUser410JSONResponse{
+				Code:  410,
+				Error: "Gone",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 3).
+						Return(usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.DeleteUserRequestObject{
+					Id: 3,
+				},
+			},
+			want: api.DeleteUser500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.DeleteUser(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeleteUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "DeleteUser", got)
+		})
+	}
+}
+
+func TestHandlers_RestoreUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.RestoreUserRequestObject
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.RestoreUserResponseObject
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 1).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.RestoreUserRequestObject{
+					Id: 1,
+				},
+			},
+			want: api.RestoreUser200JSONResponse{
+				Body: api.GetUserByIdResponse{
+					Id:   1,
+					Name: "Alice",
+				},
+				Headers: api.RestoreUser200ResponseHeaders{
+					ETag: `"3"`,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 2).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.RestoreUserRequestObject{
+					Id: 2,
+				},
+			},
+			want: api.RestoreUser404JSONResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 3).
+						Return(usecases.User{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.RestoreUserRequestObject{
+					Id: 3,
+				},
+			},
+			want: api.RestoreUser500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.RestoreUser(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestoreUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestoreUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "RestoreUser", got)
+		})
+	}
+}
+
+func TestHandlers_GetUserHistory(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.GetUserHistoryRequestObject
+	}
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	actor := "admin"
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.GetUserHistoryResponseObject
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 1).
+						Return([]usecases.AuditEntry{
+							{
+								Seq:     1,
+								UserID:  1,
+								Action:  usecases.AuditActionCreate,
+								Actor:   actor,
+								At:      at,
+								Version: 1,
+								Changes: []usecases.AuditChange{{Field: "name", To: "Alice"}},
+								Hash:    "h1",
+							},
+							{
+								Seq:      3,
+								UserID:   1,
+								Action:   usecases.AuditActionDelete,
+								At:       at,
+								Version:  2,
+								Changes:  []usecases.AuditChange{},
+								PrevHash: "h2",
+								Hash:     "h3",
+							},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.GetUserHistoryRequestObject{
+					Id: 1,
+				},
+			},
+			want: api.GetUserHistory200JSONResponse{
+				Items: []api.UserHistoryEntry{
+					{
+						Seq:     1,
+						Action:  api.Create,
+						Actor:   &actor,
+						At:      at,
+						Version: 1,
+						Changes: []api.UserHistoryChange{{Field: "name", To: "Alice"}},
+						Hash:    "h1",
+					},
+					{
+						Seq:      3,
+						Action:   api.Delete,
+						At:       at,
+						Version:  2,
+						Changes:  []api.UserHistoryChange{},
+						PrevHash: "h2",
+						Hash:     "h3",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 2).
+						Return(nil, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.GetUserHistoryRequestObject{
+					Id: 2,
+				},
+			},
+			want: api.GetUserHistory404JSONResponse{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 3).
+						Return(nil, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.GetUserHistoryRequestObject{
+					Id: 3,
+				},
+			},
+			want: api.GetUserHistory500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.GetUserHistory(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetUserHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetUserHistory() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "GetUserHistory", got)
+		})
+	}
+}
+
+func TestHandlers_ListUsers(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request api.ListUsersRequestObject
+	}
+
+	limit := 2
+	cursor := "eyJhZnRlcl9pZCI6Mn0"
+	nextCursor := "eyJhZnRlcl9pZCI6NH0"
+	namePrefix := "Al"
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sort := []string{"name", "-id"}
+	includeDeleted := true
+	deletedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.ListUsersResponseObject
+		wantErr bool
+	}{
+		{
+			name: "first page",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: api.ListUsersRequestObject{},
+			},
+			want: api.ListUsers200JSONResponse{
+				Items: []api.GetUserByIdResponse{{Id: 1, Name: "Alice"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "page with cursor",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 2, Cursor: cursor}).
+						Return(usecases.UsersPage{
+							Users:      []usecases.User{{ID: 3, Name: "Carol"}, {ID: 4, Name: "Dave"}},
+							NextCursor: nextCursor,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.ListUsersRequestObject{
+					Params: api.ListUsersParams{
+						Limit:  &limit,
+						Cursor: &cursor,
+					},
+				},
+			},
+			want: api.ListUsers200JSONResponse{
+				Items:      []api.GetUserByIdResponse{{Id: 3, Name: "Carol"}, {Id: 4, Name: "Dave"}},
+				NextCursor: &nextCursor,
+			},
+			wantErr: false,
+		},
+		{
+			name: "filters, sort and include deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+							NamePrefix:     namePrefix,
+							CreatedAfter:   createdAfter,
+							Sort:           sort,
+							IncludeDeleted: true,
+						}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Alina", DeletedAt: deletedAt}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.ListUsersRequestObject{
+					Params: api.ListUsersParams{
+						NamePrefix:     &namePrefix,
+						CreatedAfter:   &createdAfter,
+						Sort:           &sort,
+						IncludeDeleted: &includeDeleted,
+					},
+				},
+			},
+			want: api.ListUsers200JSONResponse{
+				Items: []api.GetUserByIdResponse{{Id: 1, Name: "Alice"}, {Id: 2, Name: "Alina", DeletedAt: &deletedAt}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid sort",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Sort: []string{"email"}}).
+						Return(usecases.UsersPage{}, usecases.ErrInvalidSort).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.ListUsersRequestObject{
+					Params: api.ListUsersParams{
+						Sort: &[]string{"email"},
+					},
+				},
+			},
+			want: api.ListUsers400JSONResponse{
+				Code:  4,
+				Error: usecases.ErrInvalidSort.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "include deleted without operator token",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{IncludeDeleted: true}).
+						Return(usecases.UsersPage{}, usecases.ErrForbidden).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.ListUsersRequestObject{
+					Params: api.ListUsersParams{
+						IncludeDeleted: &includeDeleted,
+					},
+				},
+			},
+			want: api.ListUsers403JSONResponse{
+				Code:  15,
+				Error: "Forbidden",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid cursor",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Cursor: cursor}).
+						Return(usecases.UsersPage{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				request: api.ListUsersRequestObject{
+					Params: api.ListUsersParams{
+						Cursor: &cursor,
+					},
+				},
+			},
+			want: api.ListUsers400JSONResponse{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{}).
+						Return(usecases.UsersPage{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: api.ListUsersRequestObject{},
+			},
+			want: api.ListUsers500JSONResponse{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.ListUsers(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListUsers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListUsers() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "ListUsers", got)
+		})
+	}
+}
+
+func TestHandlers_Incident(t *testing.T) {
+	notPublic := fmt.Errorf("load user: %w", usecases.ErrNotPublic1)
+
+	tests := []struct {
+		name             string
+		err              error
+		debug            bool
+		wantIncident     bool
+		wantDebugMessage string
+	}{
+		{
+			name:         "internal error is recorded",
+			err:          notPublic,
+			wantIncident: true,
+		},
+		{
+			name:             "debug mode returns the underlying message",
+			err:              notPublic,
+			debug:            true,
+			wantIncident:     true,
+			wantDebugMessage: "load user: we can't expose this text 1",
+		},
+		{
+			name:  "client error is not an incident",
+			err:   usecases.ErrNotFound,
+			debug: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockUseCases(t)
+
+			m.EXPECT().
+				GetUser(mock.Anything, 1).
+				Return(usecases.User{}, tt.err).
+				Once()
+
+			sink := incident.NewMemory()
+			h := New(m, incident.New(sink))
+
+			ctx := context.Background()
+			if tt.debug {
+				ctx = incident.WithDebug(ctx)
+			}
+
+			response, err := h.GetUserById(ctx, api.GetUserByIdRequestObject{Id: 1})
+			if err != nil {
+				t.Fatalf("GetUserById() error = %v", err)
+			}
+
+			checkResponse(t, "GetUserById", response)
+
+			var got api.ErrorResponse
+
+			switch r := response.(type) {
+			case api.GetUserById500JSONResponse:
+				got = api.ErrorResponse(r)
+			case api.GetUserById404JSONResponse:
+				got = api.ErrorResponse(r)
+			default:
+				t.Fatalf("GetUserById() = %T, want error response", response)
+			}
+
+			var debugMessage string
+			if got.DebugMessage != nil {
+				debugMessage = *got.DebugMessage
+			}
+
+			if debugMessage != tt.wantDebugMessage {
+				t.Fatalf("debug_message = %q, want %q", debugMessage, tt.wantDebugMessage)
+			}
+
+			if !tt.wantIncident {
+				if got.IncidentId != nil {
+					t.Fatalf("incident_id = %q, want none", *got.IncidentId)
+				}
+
+				return
+			}
+
+			if got.IncidentId == nil {
+				t.Fatal("incident_id is missing")
+			}
+
+			recorded, err := sink.Get(*got.IncidentId)
+			if err != nil {
+				t.Fatalf("sink.Get() error = %v", err)
+			}
+
+			wantChain := []string{tt.err.Error(), usecases.ErrNotPublic1.Error()}
+			if recorded.Code != 1 || !reflect.DeepEqual(recorded.Chain, wantChain) {
+				t.Fatalf("incident = %+v, want code 1 and chain %q", recorded, wantChain)
+			}
+		})
+	}
+}
+
+func TestHandlers_RequestError(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		body           string
+		wantStatusCode int
+		wantCode       api.ErrorResponseCode
+		wantDetails    *[]api.ValidationErrorDetail
+	}{
+		{
+			name:           "missing required field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeValidation,
+			wantDetails:    &[]api.ValidationErrorDetail{{Field: "name", Rule: usecases.RuleRequired, Message: "is required"}},
+		},
+		{
+			name:           "unknown field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":"Alice","admin":true}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeValidation,
+			wantDetails:    &[]api.ValidationErrorDetail{{Field: "admin", Rule: usecases.RuleUnknown, Message: "is not allowed"}},
+		},
+		{
+			name:           "missing body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeMalformedBody,
+		},
+		{
+			name:           "unsupported content type",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "text/plain",
+			body:           `{"name":"Alice"}`,
+			wantStatusCode: http.StatusUnsupportedMediaType,
+			wantCode:       api.ErrorResponseCodeUnsupportedMediaType,
+		},
+		{
+			name:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/users/abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "out of range query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=500",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeValidation,
+			wantDetails:    &[]api.ValidationErrorDetail{{Field: "limit", Rule: usecases.RuleRange, Message: "must be at most 100"}},
+		},
+	}
+
+	spec, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	validator, err := validation.New(spec)
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(NewMockUseCases(t), nil)
+
+			handler := validator.Middleware(h.RequestError)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Fatal("request reached the handler")
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, handler).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			var got api.ErrorResponse
+
+			err := json.Unmarshal(rr.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatalf("failed to unmarshal response body: %v; raw: %q", err, rr.Body.String())
+			}
+
+			if got.Code != tt.wantCode || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantCode)
+			}
+
+			if !reflect.DeepEqual(got.Details, tt.wantDetails) {
+				t.Fatalf("details = %+v, want %+v", got.Details, tt.wantDetails)
+			}
 		})
 	}
 }
@@ -6,7 +6,7 @@ package handlers
 
 import (
 	"context"
-	"server/usecases"
+	"shared/usecases"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -104,6 +104,129 @@ func (_c *MockUseCases_CreateUsers_Call) RunAndReturn(run func(ctx context.Conte
 	return _c
 }
 
+// CreateUsersBatch provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) CreateUsersBatch(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error) {
+	ret := _mock.Called(ctx, createUsersBatchRequestDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUsersBatch")
+	}
+
+	var r0 usecases.CreateUsersBatchResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error)); ok {
+		return returnFunc(ctx, createUsersBatchRequestDTO)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, usecases.CreateUsersBatchRequestDTO) usecases.CreateUsersBatchResult); ok {
+		r0 = returnFunc(ctx, createUsersBatchRequestDTO)
+	} else {
+		r0 = ret.Get(0).(usecases.CreateUsersBatchResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, usecases.CreateUsersBatchRequestDTO) error); ok {
+		r1 = returnFunc(ctx, createUsersBatchRequestDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCases_CreateUsersBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUsersBatch'
+type MockUseCases_CreateUsersBatch_Call struct {
+	*mock.Call
+}
+
+// CreateUsersBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO
+func (_e *MockUseCases_Expecter) CreateUsersBatch(ctx interface{}, createUsersBatchRequestDTO interface{}) *MockUseCases_CreateUsersBatch_Call {
+	return &MockUseCases_CreateUsersBatch_Call{Call: _e.mock.On("CreateUsersBatch", ctx, createUsersBatchRequestDTO)}
+}
+
+func (_c *MockUseCases_CreateUsersBatch_Call) Run(run func(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO)) *MockUseCases_CreateUsersBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 usecases.CreateUsersBatchRequestDTO
+		if args[1] != nil {
+			arg1 = args[1].(usecases.CreateUsersBatchRequestDTO)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_CreateUsersBatch_Call) Return(createUsersBatchResult usecases.CreateUsersBatchResult, err error) *MockUseCases_CreateUsersBatch_Call {
+	_c.Call.Return(createUsersBatchResult, err)
+	return _c
+}
+
+func (_c *MockUseCases_CreateUsersBatch_Call) RunAndReturn(run func(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error)) *MockUseCases_CreateUsersBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) DeleteUser(ctx context.Context, id int) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUseCases_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type MockUseCases_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockUseCases_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockUseCases_DeleteUser_Call {
+	return &MockUseCases_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+}
+
+func (_c *MockUseCases_DeleteUser_Call) Run(run func(ctx context.Context, id int)) *MockUseCases_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_DeleteUser_Call) Return(err error) *MockUseCases_DeleteUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUseCases_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockUseCases_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUser provides a mock function for the type MockUseCases
 func (_mock *MockUseCases) GetUser(ctx context.Context, id int) (usecases.User, error) {
 	ret := _mock.Called(ctx, id)
@@ -169,3 +292,347 @@ func (_c *MockUseCases_GetUser_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListUsers provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) ListUsers(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO) (usecases.UsersPage, error) {
+	ret := _mock.Called(ctx, listUsersRequestDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUsers")
+	}
+
+	var r0 usecases.UsersPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, usecases.ListUsersRequestDTO) (usecases.UsersPage, error)); ok {
+		return returnFunc(ctx, listUsersRequestDTO)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, usecases.ListUsersRequestDTO) usecases.UsersPage); ok {
+		r0 = returnFunc(ctx, listUsersRequestDTO)
+	} else {
+		r0 = ret.Get(0).(usecases.UsersPage)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, usecases.ListUsersRequestDTO) error); ok {
+		r1 = returnFunc(ctx, listUsersRequestDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCases_ListUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUsers'
+type MockUseCases_ListUsers_Call struct {
+	*mock.Call
+}
+
+// ListUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listUsersRequestDTO usecases.ListUsersRequestDTO
+func (_e *MockUseCases_Expecter) ListUsers(ctx interface{}, listUsersRequestDTO interface{}) *MockUseCases_ListUsers_Call {
+	return &MockUseCases_ListUsers_Call{Call: _e.mock.On("ListUsers", ctx, listUsersRequestDTO)}
+}
+
+func (_c *MockUseCases_ListUsers_Call) Run(run func(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO)) *MockUseCases_ListUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 usecases.ListUsersRequestDTO
+		if args[1] != nil {
+			arg1 = args[1].(usecases.ListUsersRequestDTO)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_ListUsers_Call) Return(usersPage usecases.UsersPage, err error) *MockUseCases_ListUsers_Call {
+	_c.Call.Return(usersPage, err)
+	return _c
+}
+
+func (_c *MockUseCases_ListUsers_Call) RunAndReturn(run func(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO) (usecases.UsersPage, error)) *MockUseCases_ListUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchUser provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) PatchUser(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO) (usecases.User, error) {
+	ret := _mock.Called(ctx, id, patchUserRequestDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchUser")
+	}
+
+	var r0 usecases.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, usecases.PatchUserRequestDTO) (usecases.User, error)); ok {
+		return returnFunc(ctx, id, patchUserRequestDTO)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, usecases.PatchUserRequestDTO) usecases.User); ok {
+		r0 = returnFunc(ctx, id, patchUserRequestDTO)
+	} else {
+		r0 = ret.Get(0).(usecases.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, usecases.PatchUserRequestDTO) error); ok {
+		r1 = returnFunc(ctx, id, patchUserRequestDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCases_PatchUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchUser'
+type MockUseCases_PatchUser_Call struct {
+	*mock.Call
+}
+
+// PatchUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - patchUserRequestDTO usecases.PatchUserRequestDTO
+func (_e *MockUseCases_Expecter) PatchUser(ctx interface{}, id interface{}, patchUserRequestDTO interface{}) *MockUseCases_PatchUser_Call {
+	return &MockUseCases_PatchUser_Call{Call: _e.mock.On("PatchUser", ctx, id, patchUserRequestDTO)}
+}
+
+func (_c *MockUseCases_PatchUser_Call) Run(run func(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO)) *MockUseCases_PatchUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 usecases.PatchUserRequestDTO
+		if args[2] != nil {
+			arg2 = args[2].(usecases.PatchUserRequestDTO)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_PatchUser_Call) Return(user usecases.User, err error) *MockUseCases_PatchUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUseCases_PatchUser_Call) RunAndReturn(run func(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO) (usecases.User, error)) *MockUseCases_PatchUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) RestoreUser(ctx context.Context, id int) (usecases.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 usecases.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (usecases.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) usecases.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(usecases.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCases_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type MockUseCases_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockUseCases_Expecter) RestoreUser(ctx interface{}, id interface{}) *MockUseCases_RestoreUser_Call {
+	return &MockUseCases_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, id)}
+}
+
+func (_c *MockUseCases_RestoreUser_Call) Run(run func(ctx context.Context, id int)) *MockUseCases_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_RestoreUser_Call) Return(user usecases.User, err error) *MockUseCases_RestoreUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUseCases_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, id int) (usecases.User, error)) *MockUseCases_RestoreUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateUser provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) UpdateUser(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO) (usecases.User, error) {
+	ret := _mock.Called(ctx, id, updateUserRequestDTO)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUser")
+	}
+
+	var r0 usecases.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, usecases.UpdateUserRequestDTO) (usecases.User, error)); ok {
+		return returnFunc(ctx, id, updateUserRequestDTO)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, usecases.UpdateUserRequestDTO) usecases.User); ok {
+		r0 = returnFunc(ctx, id, updateUserRequestDTO)
+	} else {
+		r0 = ret.Get(0).(usecases.User)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, usecases.UpdateUserRequestDTO) error); ok {
+		r1 = returnFunc(ctx, id, updateUserRequestDTO)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCases_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockUseCases_UpdateUser_Call struct {
+	*mock.Call
+}
+
+// UpdateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - updateUserRequestDTO usecases.UpdateUserRequestDTO
+func (_e *MockUseCases_Expecter) UpdateUser(ctx interface{}, id interface{}, updateUserRequestDTO interface{}) *MockUseCases_UpdateUser_Call {
+	return &MockUseCases_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, updateUserRequestDTO)}
+}
+
+func (_c *MockUseCases_UpdateUser_Call) Run(run func(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO)) *MockUseCases_UpdateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 usecases.UpdateUserRequestDTO
+		if args[2] != nil {
+			arg2 = args[2].(usecases.UpdateUserRequestDTO)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_UpdateUser_Call) Return(user usecases.User, err error) *MockUseCases_UpdateUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockUseCases_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO) (usecases.User, error)) *MockUseCases_UpdateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UserHistory provides a mock function for the type MockUseCases
+func (_mock *MockUseCases) UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserHistory")
+	}
+
+	var r0 []usecases.AuditEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]usecases.AuditEntry, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []usecases.AuditEntry); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]usecases.AuditEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCases_UserHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UserHistory'
+type MockUseCases_UserHistory_Call struct {
+	*mock.Call
+}
+
+// UserHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockUseCases_Expecter) UserHistory(ctx interface{}, id interface{}) *MockUseCases_UserHistory_Call {
+	return &MockUseCases_UserHistory_Call{Call: _e.mock.On("UserHistory", ctx, id)}
+}
+
+func (_c *MockUseCases_UserHistory_Call) Run(run func(ctx context.Context, id int)) *MockUseCases_UserHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCases_UserHistory_Call) Return(auditEntrys []usecases.AuditEntry, err error) *MockUseCases_UserHistory_Call {
+	_c.Call.Return(auditEntrys, err)
+	return _c
+}
+
+func (_c *MockUseCases_UserHistory_Call) RunAndReturn(run func(ctx context.Context, id int) ([]usecases.AuditEntry, error)) *MockUseCases_UserHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
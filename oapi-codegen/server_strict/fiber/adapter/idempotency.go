@@ -0,0 +1,90 @@
+package adapter
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"shared/errcatalog"
+	"shared/idempotency"
+)
+
+// Idempotency - idempotency.Middleware для fiber. fiber работает поверх fasthttp, а не net/http, поэтому логика
+// Middleware повторена на его типах: ответ снимается с c.Response() после выполнения остальной цепочки.
+func Idempotency(store *idempotency.Store, routes ...idempotency.Route) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(idempotency.Header)
+		if key == "" || !idempotency.Applies(c.Method(), c.Path(), routes) {
+			return c.Next()
+		}
+
+		err := idempotency.ValidateKey(key)
+		if err != nil {
+			return writeFiberError(c, errcatalog.Validation, err.Error())
+		}
+
+		// fasthttp уже прочитал тело целиком (в пределах fiber.Config.BodyLimit), остается сверить его размер
+		if len(c.Body()) > idempotency.MaxBodySize {
+			return writeFiberError(c, errcatalog.Validation, "request body is too large")
+		}
+
+		storeKey := idempotency.StoreKey(c.Method(), c.Path(), key)
+
+		response, replay, err := store.Begin(storeKey, idempotency.Fingerprint(c.Body()))
+		if err != nil {
+			return writeFiberError(c, idempotency.StoreError(err), err.Error())
+		}
+
+		if replay {
+			for name, values := range response.Header {
+				for _, value := range values {
+					c.Response().Header.Add(name, value)
+				}
+			}
+
+			c.Set(idempotency.ReplayedHeader, "true")
+
+			return c.Status(response.StatusCode).Send(response.Body)
+		}
+
+		completed := false
+
+		defer func() {
+			if !completed {
+				store.Release(storeKey)
+			}
+		}()
+
+		err = c.Next()
+		if err != nil {
+			return err
+		}
+
+		statusCode := c.Response().StatusCode()
+		if statusCode >= http.StatusInternalServerError {
+			return nil
+		}
+
+		header := make(http.Header)
+
+		c.Response().Header.VisitAll(func(name []byte, value []byte) {
+			header.Add(string(name), string(value))
+		})
+
+		store.Complete(storeKey, idempotency.Response{
+			StatusCode: statusCode,
+			Header:     header,
+			Body:       append([]byte(nil), c.Response().Body()...),
+		})
+
+		completed = true
+
+		return nil
+	}
+}
+
+func writeFiberError(c *fiber.Ctx, entry errcatalog.Entry, message string) error {
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+	return c.Status(entry.Status).Send(idempotency.ErrorBody(entry, message))
+}
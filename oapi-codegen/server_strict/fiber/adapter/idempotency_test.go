@@ -0,0 +1,133 @@
+package adapter
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"shared/idempotency"
+)
+
+// createUser - операция, которую middleware делает идемпотентной в тестах
+var createUser = idempotency.Route{Method: http.MethodPost, Path: "/users"}
+
+func TestIdempotency(t *testing.T) {
+	calls := 0
+
+	app := fiber.New()
+	app.Use(Idempotency(idempotency.NewStore(time.Hour, 100), createUser))
+	app.Post("/users", func(c *fiber.Ctx) error {
+		calls++
+
+		c.Set("X-Request-Number", "1")
+
+		return c.Status(http.StatusCreated).JSON(fiber.Map{"id": calls})
+	})
+
+	send := func(key string, body string) (*http.Response, string) {
+		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		req.Header.Set(idempotency.Header, key)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+
+		return resp, string(respBody)
+	}
+
+	first, firstBody := send("key-1", `{"name":"Alice"}`)
+	second, secondBody := send("key-1", `{"name":"Alice"}`)
+
+	if first.StatusCode != http.StatusCreated || second.StatusCode != http.StatusCreated {
+		t.Fatalf("status = %d, %d, want %d", first.StatusCode, second.StatusCode, http.StatusCreated)
+	}
+
+	if secondBody != firstBody {
+		t.Fatalf("replay body = %q, want %q", secondBody, firstBody)
+	}
+
+	if got := second.Header.Get("Content-Type"); got != first.Header.Get("Content-Type") {
+		t.Fatalf("replay Content-Type = %q, want %q", got, first.Header.Get("Content-Type"))
+	}
+
+	if got := second.Header.Get("X-Request-Number"); got != "1" {
+		t.Fatalf("replay X-Request-Number = %q, want %q", got, "1")
+	}
+
+	if got := second.Header.Get(idempotency.ReplayedHeader); got != "true" {
+		t.Fatalf("replay %s = %q, want %q", idempotency.ReplayedHeader, got, "true")
+	}
+
+	mismatch, _ := send("key-1", `{"name":"Bob"}`)
+	if mismatch.StatusCode != http.StatusUnprocessableEntity {
+		t.Fatalf("mismatch status = %d, want %d", mismatch.StatusCode, http.StatusUnprocessableEntity)
+	}
+
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+
+	// ключ длиннее 255 символов, предела ValidateKey
+	long, _ := send(strings.Repeat("k", 256), `{"name":"Alice"}`)
+	if long.StatusCode != http.StatusBadRequest {
+		t.Fatalf("long key status = %d, want %d", long.StatusCode, http.StatusBadRequest)
+	}
+
+	large, _ := send("key-2", `{"name":"`+strings.Repeat("a", idempotency.MaxBodySize)+`"}`)
+	if large.StatusCode != http.StatusBadRequest {
+		t.Fatalf("large body status = %d, want %d", large.StatusCode, http.StatusBadRequest)
+	}
+
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+}
+
+func TestIdempotency_ServerErrorIsNotStored(t *testing.T) {
+	calls := 0
+
+	app := fiber.New()
+	app.Use(Idempotency(idempotency.NewStore(time.Hour, 100), createUser))
+	app.Post("/users", func(c *fiber.Ctx) error {
+		calls++
+
+		if calls == 1 {
+			return c.SendStatus(http.StatusInternalServerError)
+		}
+
+		return c.SendStatus(http.StatusCreated)
+	})
+
+	for _, want := range []int{http.StatusInternalServerError, http.StatusCreated, http.StatusCreated} {
+		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"Alice"}`))
+		req.Header.Set(idempotency.Header, "key-1")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+
+		_ = resp.Body.Close()
+
+		if resp.StatusCode != want {
+			t.Fatalf("status = %d, want %d", resp.StatusCode, want)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
@@ -0,0 +1,18 @@
+package adapter
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"shared/incident"
+)
+
+// Incident - incident.Middleware для fiber. Режим оператора включается в c.UserContext(): его strict-обработчики получают как ctx.
+func Incident(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if incident.Authorized(c.Get(incident.DebugHeader), token) {
+			c.SetUserContext(incident.Operate(c.UserContext()))
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,41 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"shared/incident"
+	"shared/usecases"
+)
+
+func TestIncident(t *testing.T) {
+	app := fiber.New()
+	app.Use(Incident("secret"))
+	app.Get("/debug", func(c *fiber.Ctx) error {
+		return c.SendString(strconv.FormatBool(incident.Debug(c.UserContext())) + " " + usecases.ActorFromContext(c.UserContext()))
+	})
+
+	for header, want := range map[string]string{"secret": "true " + incident.Operator, "guess": "false ", "": "false "} {
+		req := httptest.NewRequest(http.MethodGet, "/debug", nil)
+		if header != "" {
+			req.Header.Set(incident.DebugHeader, header)
+		}
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+
+		body := make([]byte, 16)
+		n, _ := resp.Body.Read(body)
+		resp.Body.Close()
+
+		if got := string(body[:n]); got != want {
+			t.Fatalf("%s = %q: Debug() and actor = %q, want %q", incident.DebugHeader, header, got, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package adapter
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+
+	"shared/problem"
+)
+
+// Problem - problem.Middleware для fiber, в том числе с ответом 406 (см. problem.Acceptable). fiber работает поверх fasthttp, а не net/http, поэтому ответ переписывается
+// прямо в c.Response() после выполнения остальной цепочки.
+func Problem() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Append(fiber.HeaderVary, fiber.HeaderAccept)
+
+		if !problem.Acceptable(c.Get(fiber.HeaderAccept)) {
+			c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+			return c.Status(http.StatusNotAcceptable).Send(problem.NotAcceptableBody())
+		}
+
+		if !problem.Preferred(c.Get(fiber.HeaderAccept)) {
+			return c.Next()
+		}
+
+		err := c.Next()
+		if err != nil {
+			return err
+		}
+
+		statusCode := c.Response().StatusCode()
+		contentType, _, _ := mime.ParseMediaType(string(c.Response().Header.ContentType()))
+
+		if statusCode < http.StatusBadRequest || (contentType != fiber.MIMEApplicationJSON && contentType != problem.ContentType) {
+			return nil
+		}
+
+		details, ok := problem.FromErrorResponse(c.Response().Body(), statusCode, c.Path())
+		if !ok {
+			return nil
+		}
+
+		body, err := json.Marshal(details)
+		if err != nil {
+			return err
+		}
+
+		c.Set(fiber.HeaderContentType, problem.ContentType)
+
+		return c.Send(body)
+	}
+}
@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"shared/problem"
+)
+
+func TestProblem(t *testing.T) {
+	app := fiber.New()
+	app.Use(Problem())
+	app.Get("/users/:id", func(c *fiber.Ctx) error {
+		if c.Params("id") != "1" {
+			return c.Status(http.StatusNotFound).JSON(fiber.Map{"code": 404, "error": "Not Found"})
+		}
+
+		return c.JSON(fiber.Map{"id": 1})
+	})
+
+	send := func(path string, accept string) (*http.Response, string) {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept", accept)
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+
+		return resp, string(body)
+	}
+
+	resp, body := send("/users/2", problem.ContentType)
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	if got := resp.Header.Get("Content-Type"); got != problem.ContentType {
+		t.Fatalf("Content-Type = %q, want %q", got, problem.ContentType)
+	}
+
+	want := `{"type":"about:blank","title":"Not Found","status":404,"detail":"Not Found","instance":"/users/2","code":404}`
+	if body != want {
+		t.Fatalf("body = %s, want %s", body, want)
+	}
+
+	if got := resp.Header.Get("Vary"); got != "Accept" {
+		t.Fatalf("Vary = %q, want %q", got, "Accept")
+	}
+
+	resp, body = send("/users/2", "application/json")
+
+	if got := resp.Header.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("Content-Type without preference = %q, want %q", got, "application/json")
+	}
+
+	if want := `{"code":404,"error":"Not Found"}`; body != want {
+		t.Fatalf("body without preference = %s, want %s", body, want)
+	}
+
+	resp, body = send("/users/1", problem.ContentType+", application/json")
+
+	if resp.StatusCode != http.StatusOK || body != `{"id":1}` {
+		t.Fatalf("success = %d %s, want %d %s", resp.StatusCode, body, http.StatusOK, `{"id":1}`)
+	}
+
+	resp, body = send("/users/1", "text/html")
+
+	if want := `{"code":14,"error":"Not Acceptable"}`; resp.StatusCode != http.StatusNotAcceptable || body != want {
+		t.Fatalf("unacceptable Accept = %d %s, want %d %s", resp.StatusCode, body, http.StatusNotAcceptable, want)
+	}
+
+	if got := resp.Header.Get("Content-Type"); got != "application/json" {
+		t.Fatalf("Content-Type of 406 = %q, want %q", got, "application/json")
+	}
+}
@@ -0,0 +1,87 @@
+package adapter
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Router - fiber.Router, понимающий шаблоны "/users/:id:restore". Fiber сам умеет литеральное
+// двоеточие после параметра, если оно экранировано, поэтому шаблон достаточно переписать.
+type Router struct {
+	fiber.Router
+}
+
+func NewRouter(router fiber.Router) *Router {
+	return &Router{
+		Router: router,
+	}
+}
+
+func (r *Router) Get(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Get(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Head(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Head(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Post(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Post(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Put(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Put(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Delete(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Delete(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Connect(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Connect(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Options(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Options(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Trace(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Trace(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Patch(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Patch(escapeFiberPattern(path), handlers...)
+}
+
+func (r *Router) Add(method string, path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Add(method, escapeFiberPattern(path), handlers...)
+}
+
+// escapeFiberPattern экранирует двоеточие пользовательского метода: "/users/:id:restore" -> "/users/:id\:restore".
+func escapeFiberPattern(pattern string) string {
+	base, _, method, ok := splitColonPattern(pattern)
+	if !ok || method == "" {
+		return pattern
+	}
+
+	return base + `\:` + method
+}
+
+// splitColonPattern разбирает шаблон, последний сегмент которого - параметр ":id" или ":id:method".
+func splitColonPattern(pattern string) (base string, param string, method string, ok bool) {
+	i := strings.LastIndexByte(pattern, '/')
+	segment := pattern[i+1:]
+
+	rest, ok := strings.CutPrefix(segment, ":")
+	if !ok {
+		return "", "", "", false
+	}
+
+	param, method, _ = strings.Cut(rest, ":")
+	if param == "" {
+		return "", "", "", false
+	}
+
+	return pattern[:i+1] + ":" + param, param, method, true
+}
@@ -0,0 +1,60 @@
+package adapter
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestRouter(t *testing.T) {
+	app := fiber.New()
+	r := NewRouter(app)
+
+	handler := func(name string) fiber.Handler {
+		return func(c *fiber.Ctx) error {
+			return c.SendString(name + " " + c.Params("id"))
+		}
+	}
+
+	r.Get("/users/:id", handler("get"))
+	r.Post("/users/:id:restore", handler("restore"))
+	r.Post("/users:batch", handler("batch"))
+
+	tests := []struct {
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{method: http.MethodGet, path: "/users/5", wantStatus: http.StatusOK, wantBody: "get 5"},
+		{method: http.MethodPost, path: "/users/5:restore", wantStatus: http.StatusOK, wantBody: "restore 5"},
+		{method: http.MethodPost, path: "/users:batch", wantStatus: http.StatusOK, wantBody: "batch "},
+		{method: http.MethodPost, path: "/users/5:unknown", wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			if err != nil {
+				t.Fatalf("Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("read body: %v", err)
+			}
+
+			if tt.wantBody != "" && string(body) != tt.wantBody {
+				t.Fatalf("body = %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package adapter
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
+
+	"shared/incident"
+	"shared/validation"
+)
+
+// Validate - validation.Middleware для fiber. Запрос проверяется в виде net/http-запроса, собранного из fasthttp; ошибка *Error
+// возвращается из цепочки, ответ на нее дает handlers.Fiber, поэтому он должен стоять раньше.
+func Validate(v *validation.Validator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var r http.Request
+
+		err := fasthttpadaptor.ConvertRequest(c.Context(), &r, true)
+		if err != nil {
+			return err
+		}
+
+		err = v.Validate(r.WithContext(c.UserContext()))
+		if err != nil {
+			return err
+		}
+
+		return c.Next()
+	}
+}
+
+// LogResponses - validation.LogResponses для fiber. Ставится после Incident, чтобы видеть отладочный режим,
+// и раньше handlers.Fiber, чтобы проверять и ответы на ошибки, которые вернула цепочка.
+func LogResponses(v *validation.Validator, logger *slog.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !incident.Debug(c.UserContext()) || c.Method() == fiber.MethodHead {
+			return c.Next()
+		}
+
+		var r http.Request
+
+		err := fasthttpadaptor.ConvertRequest(c.Context(), &r, true)
+		if err != nil {
+			return c.Next()
+		}
+
+		operationID, ok := v.FindOperation(&r)
+		if !ok {
+			return c.Next()
+		}
+
+		err = c.Next()
+		if err != nil {
+			return err
+		}
+
+		header := make(http.Header)
+
+		c.Response().Header.VisitAll(func(name []byte, value []byte) {
+			header.Add(string(name), string(value))
+		})
+
+		err = v.ValidateResponse(operationID, c.Response().StatusCode(), header, c.Response().Body())
+		if err != nil {
+			validation.LogResponseError(c.UserContext(), logger, c.Method(), c.Path(), err)
+		}
+
+		return nil
+	}
+}
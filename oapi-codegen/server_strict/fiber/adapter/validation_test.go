@@ -0,0 +1,129 @@
+package adapter
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	api "server/generated"
+	"shared/errcatalog"
+	"shared/incident"
+	"shared/validation"
+)
+
+func newValidator(t *testing.T) *validation.Validator {
+	t.Helper()
+
+	spec, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	v, err := validation.New(spec)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return v
+}
+
+func TestValidate(t *testing.T) {
+	v := newValidator(t)
+
+	var gotErr error
+
+	app := fiber.New(fiber.Config{
+		ErrorHandler: func(c *fiber.Ctx, err error) error {
+			gotErr = err
+
+			return c.SendStatus(http.StatusBadRequest)
+		},
+	})
+	app.Use(Validate(v))
+	app.Post("/users", func(c *fiber.Ctx) error {
+		return c.Send(c.Body())
+	})
+
+	send := func(body string) (*http.Response, string) {
+		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+
+		defer resp.Body.Close()
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+
+		return resp, string(respBody)
+	}
+
+	resp, body := send(`{"name":"Alice"}`)
+	if resp.StatusCode != http.StatusOK || body != `{"name":"Alice"}` {
+		t.Fatalf("status = %d, body = %q; want 200 and the original body", resp.StatusCode, body)
+	}
+
+	resp, _ = send(`{"name":"Alice","admin":true}`)
+
+	var validationErr *validation.Error
+	if resp.StatusCode != http.StatusBadRequest || !errors.As(gotErr, &validationErr) || validationErr.Entry != errcatalog.Validation {
+		t.Fatalf("status = %d, error = %v; want 400 with a validation error", resp.StatusCode, gotErr)
+	}
+}
+
+func TestLogResponses(t *testing.T) {
+	v := newValidator(t)
+
+	var logs bytes.Buffer
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		if c.Get("X-Debug") != "" {
+			c.SetUserContext(incident.WithDebug(c.UserContext()))
+		}
+
+		return c.Next()
+	})
+	app.Use(LogResponses(v, slog.New(slog.NewJSONHandler(&logs, nil))))
+	app.Post("/users", func(c *fiber.Ctx) error {
+		return c.SendStatus(http.StatusOK)
+	})
+
+	send := func(debug bool) {
+		req := httptest.NewRequest(http.MethodPost, "/users", nil)
+		if debug {
+			req.Header.Set("X-Debug", "1")
+		}
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+
+		resp.Body.Close()
+	}
+
+	send(false)
+
+	if logs.Len() != 0 {
+		t.Fatalf("logs = %q outside debug mode, want none", logs.String())
+	}
+
+	send(true)
+
+	if !strings.Contains(logs.String(), "response does not match the spec") {
+		t.Fatalf("logs = %q in debug mode, want the violation", logs.String())
+	}
+}
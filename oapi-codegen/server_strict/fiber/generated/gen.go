@@ -4,15 +4,79 @@
 package api
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gofiber/fiber/v2"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ErrorResponseCode.
+const (
+	ErrorResponseCodeAlreadyExists          ErrorResponseCode = 16
+	ErrorResponseCodeForbidden              ErrorResponseCode = 15
+	ErrorResponseCodeGone                   ErrorResponseCode = 410
+	ErrorResponseCodeIdempotencyInProgress  ErrorResponseCode = 7
+	ErrorResponseCodeIdempotencyKeyMismatch ErrorResponseCode = 6
+	ErrorResponseCodeInternal               ErrorResponseCode = -1
+	ErrorResponseCodeInvalidParameter       ErrorResponseCode = 9
+	ErrorResponseCodeInvalidSort            ErrorResponseCode = 4
+	ErrorResponseCodeMalformedBody          ErrorResponseCode = 10
+	ErrorResponseCodeMethodNotAllowed       ErrorResponseCode = 12
+	ErrorResponseCodeNotAcceptable          ErrorResponseCode = 14
+	ErrorResponseCodeNotFound               ErrorResponseCode = 404
+	ErrorResponseCodeNotPublic1             ErrorResponseCode = 1
+	ErrorResponseCodeNotPublic2             ErrorResponseCode = 2
+	ErrorResponseCodePreconditionFailed     ErrorResponseCode = 8
+	ErrorResponseCodeRolledBack             ErrorResponseCode = 5
+	ErrorResponseCodeRouteNotFound          ErrorResponseCode = 11
+	ErrorResponseCodeUnsupportedMediaType   ErrorResponseCode = 13
+	ErrorResponseCodeValidation             ErrorResponseCode = 3
+)
+
+// Defines values for ProblemDetailsCode.
+const (
+	ProblemDetailsCodeAlreadyExists          ProblemDetailsCode = 16
+	ProblemDetailsCodeForbidden              ProblemDetailsCode = 15
+	ProblemDetailsCodeGone                   ProblemDetailsCode = 410
+	ProblemDetailsCodeIdempotencyInProgress  ProblemDetailsCode = 7
+	ProblemDetailsCodeIdempotencyKeyMismatch ProblemDetailsCode = 6
+	ProblemDetailsCodeInternal               ProblemDetailsCode = -1
+	ProblemDetailsCodeInvalidParameter       ProblemDetailsCode = 9
+	ProblemDetailsCodeInvalidSort            ProblemDetailsCode = 4
+	ProblemDetailsCodeMalformedBody          ProblemDetailsCode = 10
+	ProblemDetailsCodeMethodNotAllowed       ProblemDetailsCode = 12
+	ProblemDetailsCodeNotAcceptable          ProblemDetailsCode = 14
+	ProblemDetailsCodeNotFound               ProblemDetailsCode = 404
+	ProblemDetailsCodeNotPublic1             ProblemDetailsCode = 1
+	ProblemDetailsCodeNotPublic2             ProblemDetailsCode = 2
+	ProblemDetailsCodePreconditionFailed     ProblemDetailsCode = 8
+	ProblemDetailsCodeRolledBack             ProblemDetailsCode = 5
+	ProblemDetailsCodeRouteNotFound          ProblemDetailsCode = 11
+	ProblemDetailsCodeUnsupportedMediaType   ProblemDetailsCode = 13
+	ProblemDetailsCodeValidation             ProblemDetailsCode = 3
+)
+
+// Defines values for UserHistoryEntryAction.
+const (
+	Create  UserHistoryEntryAction = "create"
+	Delete  UserHistoryEntryAction = "delete"
+	Restore UserHistoryEntryAction = "restore"
+	Update  UserHistoryEntryAction = "update"
+)
+
 // CreateUserRequest defines model for CreateUserRequest.
 type CreateUserRequest struct {
+	// Name Unique among all users, including deleted ones
 	Name string `json:"name"`
 }
 
@@ -21,29 +85,317 @@ type CreateUserResponse struct {
 	Id int `json:"id"`
 }
 
+// CreateUsersBatchRequest defines model for CreateUsersBatchRequest.
+type CreateUsersBatchRequest struct {
+	AllOrNothing *bool               `json:"allOrNothing,omitempty"`
+	Items        []CreateUserRequest `json:"items"`
+}
+
+// CreateUsersBatchResponse defines model for CreateUsersBatchResponse.
+type CreateUsersBatchResponse struct {
+	// Results Results in the same order as request items
+	Results []CreateUsersBatchResult `json:"results"`
+}
+
+// CreateUsersBatchResult Either id of the created user or error
+type CreateUsersBatchResult struct {
+	Error *ErrorResponse `json:"error,omitempty"`
+	Id    *int           `json:"id,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	Code  int    `json:"code"`
-	Error string `json:"error"`
+	// Code Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+	// * `-1` Internal (HTTP 500) - unexpected error
+	Code ErrorResponseCode `json:"code"`
+
+	// DebugMessage Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)
+	DebugMessage *string `json:"debug_message,omitempty"`
+
+	// Details Field-level violations; set only for validation errors (code 3)
+	Details *[]ValidationErrorDetail `json:"details,omitempty"`
+	Error   string                   `json:"error"`
+
+	// IncidentId Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id
+	IncidentId *string `json:"incident_id,omitempty"`
 }
 
+// ErrorResponseCode Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+// * `404` NotFound (HTTP 404) - user does not exist
+// * `410` Gone (HTTP 410) - user is deleted and can be restored
+// * `1` NotPublic1 (HTTP 500) - internal error 1
+// * `2` NotPublic2 (HTTP 500) - internal error 2
+// * `3` Validation (HTTP 400) - request validation failed, see details
+// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+// * `-1` Internal (HTTP 500) - unexpected error
+type ErrorResponseCode int
+
 // GetUserByIdResponse defines model for GetUserByIdResponse.
 type GetUserByIdResponse struct {
-	Id   int    `json:"id"`
+	// DeletedAt Set only for deleted users, which ListUsers returns with include_deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+}
+
+// ListUsersResponse defines model for ListUsersResponse.
+type ListUsersResponse struct {
+	Items []GetUserByIdResponse `json:"items"`
+
+	// NextCursor Cursor of the next page; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// PatchUserRequest defines model for PatchUserRequest.
+type PatchUserRequest struct {
+	// Name Unique among all users, including deleted ones
+	Name *string `json:"name,omitempty"`
+}
+
+// ProblemDetails RFC 7807 problem details. Returned instead of ErrorResponse when the client prefers
+// application/problem+json in Accept.
+type ProblemDetails struct {
+	// Code Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+	// * `-1` Internal (HTTP 500) - unexpected error
+	Code ProblemDetailsCode `json:"code"`
+
+	// DebugMessage Underlying error message of an internal error; set only in debug mode (X-Debug-Token header)
+	DebugMessage *string `json:"debug_message,omitempty"`
+
+	// Detail Explanation of this occurrence of the problem; error of ErrorResponse
+	Detail *string `json:"detail,omitempty"`
+
+	// Details Field-level violations; set only for validation errors (code 3)
+	Details *[]ValidationErrorDetail `json:"details,omitempty"`
+
+	// IncidentId Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id
+	IncidentId *string `json:"incident_id,omitempty"`
+
+	// Instance Path of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type Problem type URI; about:blank when the status code says it all
+	Type string `json:"type"`
+}
+
+// ProblemDetailsCode Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+// * `404` NotFound (HTTP 404) - user does not exist
+// * `410` Gone (HTTP 410) - user is deleted and can be restored
+// * `1` NotPublic1 (HTTP 500) - internal error 1
+// * `2` NotPublic2 (HTTP 500) - internal error 2
+// * `3` Validation (HTTP 400) - request validation failed, see details
+// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was rolled back
+// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being processed
+// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted one
+// * `-1` Internal (HTTP 500) - unexpected error
+type ProblemDetailsCode int
+
+// UpdateUserRequest defines model for UpdateUserRequest.
+type UpdateUserRequest struct {
+	// Name Unique among all users, including deleted ones
 	Name string `json:"name"`
 }
 
+// UserHistoryChange defines model for UserHistoryChange.
+type UserHistoryChange struct {
+	Field string `json:"field"`
+
+	// From Value before the change; empty if there was none
+	From string `json:"from"`
+
+	// To Value after the change; empty if there is none
+	To string `json:"to"`
+}
+
+// UserHistoryEntry defines model for UserHistoryEntry.
+type UserHistoryEntry struct {
+	Action UserHistoryEntryAction `json:"action"`
+
+	// Actor "operator" for changes made with the operator token in X-Debug-Token; absent for other requests. The token is shared by all operators, so no individual principal is recorded.
+	Actor   *string             `json:"actor,omitempty"`
+	At      time.Time           `json:"at"`
+	Changes []UserHistoryChange `json:"changes"`
+
+	// Hash SHA-256 of the entry including prev_hash, hex encoded
+	Hash string `json:"hash"`
+
+	// PrevHash Hash of the previous entry of the audit log; empty for the first entry
+	PrevHash string `json:"prev_hash"`
+
+	// Seq Position of the entry in the whole audit log, starting with 1
+	Seq int `json:"seq"`
+
+	// Version Version of the user after the change
+	Version int `json:"version"`
+}
+
+// UserHistoryEntryAction defines model for UserHistoryEntry.Action.
+type UserHistoryEntryAction string
+
+// UserHistoryResponse defines model for UserHistoryResponse.
+type UserHistoryResponse struct {
+	Items []UserHistoryEntry `json:"items"`
+}
+
+// ValidationErrorDetail defines model for ValidationErrorDetail.
+type ValidationErrorDetail struct {
+	// Field Request field that failed validation, e.g. name or limit
+	Field   string `json:"field"`
+	Message string `json:"message"`
+
+	// Rule Violated rule - not_blank, length, charset, range, format, required, type or unknown
+	Rule string `json:"rule"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Opaque cursor from next_cursor of the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// NamePrefix Only users whose name starts with this prefix (case sensitive)
+	NamePrefix *string `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+
+	// CreatedAfter Only users created strictly after this moment
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// Sort Comma separated sort fields (id, name, created_at), "-" prefix means descending, e.g. sort=name,-id. Unknown or duplicate fields are rejected with code 4. The cursor is bound to the sort it was issued for.
+	Sort *[]string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// IncludeDeleted Also return deleted users; they have deleted_at set. Requires the operator token in X-Debug-Token, otherwise the request fails with 403 (code 15)
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// CreateUserParams defines parameters for CreateUser.
+type CreateUserParams struct {
+	// IdempotencyKey Makes retries safe: a repeated request with the same key and body gets the original response, the same key with another body is rejected with 422. Keys expire after a server-side TTL.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUserByIdParams defines parameters for GetUserById.
+type GetUserByIdParams struct {
+	// IfNoneMatch ETags known to the client; if the current one is among them, 304 is returned
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PatchUserParams defines parameters for PatchUser.
+type PatchUserParams struct {
+	// IfMatch ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.
+	IfMatch string `json:"If-Match"`
+}
+
+// UpdateUserParams defines parameters for UpdateUser.
+type UpdateUserParams struct {
+	// IfMatch ETag of the user as last read by the client (or *). If the user has been changed since, the update is rejected with 412.
+	IfMatch string `json:"If-Match"`
+}
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
+// PatchUserJSONRequestBody defines body for PatchUser for application/json ContentType.
+type PatchUserJSONRequestBody = PatchUserRequest
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UpdateUserRequest
+
+// CreateUsersBatchJSONRequestBody defines body for CreateUsersBatch for application/json ContentType.
+type CreateUsersBatchJSONRequestBody = CreateUsersBatchRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List users
+	// (GET /users)
+	ListUsers(c *fiber.Ctx, params ListUsersParams) error
 	// Create user
 	// (POST /users)
-	CreateUser(c *fiber.Ctx) error
+	CreateUser(c *fiber.Ctx, params CreateUserParams) error
+	// Delete user
+	// (DELETE /users/{id})
+	DeleteUser(c *fiber.Ctx, id int) error
 	// Get user by ID
 	// (GET /users/{id})
-	GetUserById(c *fiber.Ctx, id int) error
+	GetUserById(c *fiber.Ctx, id int, params GetUserByIdParams) error
+	// Partially update user
+	// (PATCH /users/{id})
+	PatchUser(c *fiber.Ctx, id int, params PatchUserParams) error
+	// Replace user
+	// (PUT /users/{id})
+	UpdateUser(c *fiber.Ctx, id int, params UpdateUserParams) error
+	// Get user change history
+	// (GET /users/{id}/history)
+	GetUserHistory(c *fiber.Ctx, id int) error
+	// Restore deleted user
+	// (POST /users/{id}:restore)
+	RestoreUser(c *fiber.Ctx, id int) error
+	// Create several users at once
+	// (POST /users:batch)
+	CreateUsersBatch(c *fiber.Ctx) error
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -53,120 +405,1388 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc fiber.Handler
 
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *fiber.Ctx) error {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", query, &params.Cursor)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cursor: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", query, &params.NamePrefix)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter name_prefix: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", query, &params.CreatedAfter)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter created_after: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", query, &params.Sort)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sort: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", query, &params.IncludeDeleted)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter include_deleted: %w", err).Error())
+	}
+
+	return siw.Handler.ListUsers(c, params)
+}
+
 // CreateUser operation middleware
 func (siw *ServerInterfaceWrapper) CreateUser(c *fiber.Ctx) error {
 
-	return siw.Handler.CreateUser(c)
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateUserParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if values, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found && len(values) > 0 {
+		value := values[0]
+		var IdempotencyKey string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", value, &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err).Error())
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	return siw.Handler.CreateUser(c, params)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	return siw.Handler.DeleteUser(c, id)
+}
+
+// GetUserById operation middleware
+func (siw *ServerInterfaceWrapper) GetUserById(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserByIdParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if values, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found && len(values) > 0 {
+		value := values[0]
+		var IfNoneMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", value, &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err).Error())
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	return siw.Handler.GetUserById(c, id, params)
+}
+
+// PatchUser operation middleware
+func (siw *ServerInterfaceWrapper) PatchUser(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchUserParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "If-Match" -------------
+	if values, found := headers[http.CanonicalHeaderKey("If-Match")]; found && len(values) > 0 {
+		value := values[0]
+		var IfMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = IfMatch
+
+	} else {
+		err = fmt.Errorf("Header parameter If-Match is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.PatchUser(c, id, params)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateUserParams
+
+	headers := c.GetReqHeaders()
+
+	// ------------- Required header parameter "If-Match" -------------
+	if values, found := headers[http.CanonicalHeaderKey("If-Match")]; found && len(values) > 0 {
+		value := values[0]
+		var IfMatch string
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", value, &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter If-Match: %w", err).Error())
+		}
+
+		params.IfMatch = IfMatch
+
+	} else {
+		err = fmt.Errorf("Header parameter If-Match is required, but not found: %w", err)
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return siw.Handler.UpdateUser(c, id, params)
+}
+
+// GetUserHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetUserHistory(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	return siw.Handler.GetUserHistory(c, id)
+}
+
+// RestoreUser operation middleware
+func (siw *ServerInterfaceWrapper) RestoreUser(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	return siw.Handler.RestoreUser(c, id)
+}
+
+// CreateUsersBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateUsersBatch(c *fiber.Ctx) error {
+
+	return siw.Handler.CreateUsersBatch(c)
+}
+
+// FiberServerOptions provides options for the Fiber server.
+type FiberServerOptions struct {
+	BaseURL     string
+	Middlewares []MiddlewareFunc
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router fiber.Router, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	for _, m := range options.Middlewares {
+		router.Use(fiber.Handler(m))
+	}
+
+	router.Get(options.BaseURL+"/users", wrapper.ListUsers)
+
+	router.Post(options.BaseURL+"/users", wrapper.CreateUser)
+
+	router.Delete(options.BaseURL+"/users/:id", wrapper.DeleteUser)
+
+	router.Get(options.BaseURL+"/users/:id", wrapper.GetUserById)
+
+	router.Patch(options.BaseURL+"/users/:id", wrapper.PatchUser)
+
+	router.Put(options.BaseURL+"/users/:id", wrapper.UpdateUser)
+
+	router.Get(options.BaseURL+"/users/:id/history", wrapper.GetUserHistory)
+
+	router.Post(options.BaseURL+"/users/:id:restore", wrapper.RestoreUser)
+
+	router.Post(options.BaseURL+"/users:batch", wrapper.CreateUsersBatch)
+
+}
+
+type ListUsersRequestObject struct {
+	Params ListUsersParams
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(ctx *fiber.Ctx) error
+}
+
+type ListUsers200JSONResponse ListUsersResponse
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers400JSONResponse ErrorResponse
+
+func (response ListUsers400JSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response ListUsers400ApplicationProblemPlusJSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers403JSONResponse ErrorResponse
+
+func (response ListUsers403JSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers403ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response ListUsers403ApplicationProblemPlusJSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(403)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers406JSONResponse ErrorResponse
+
+func (response ListUsers406JSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response ListUsers406ApplicationProblemPlusJSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers500JSONResponse ErrorResponse
+
+func (response ListUsers500JSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type ListUsers500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response ListUsers500ApplicationProblemPlusJSONResponse) VisitListUsersResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUserRequestObject struct {
+	Params CreateUserParams
+	Body   *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(ctx *fiber.Ctx) error
+}
+
+type CreateUser201JSONResponse CreateUserResponse
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(201)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser400JSONResponse ErrorResponse
+
+func (response CreateUser400JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUser400ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser406JSONResponse ErrorResponse
+
+func (response CreateUser406JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUser406ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser409JSONResponse ErrorResponse
+
+func (response CreateUser409JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser409ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUser409ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser415JSONResponse ErrorResponse
+
+func (response CreateUser415JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser415ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUser415ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser422JSONResponse ErrorResponse
+
+func (response CreateUser422JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser422ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUser422ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(422)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser500JSONResponse ErrorResponse
+
+func (response CreateUser500JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type CreateUser500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUser500ApplicationProblemPlusJSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUserRequestObject struct {
+	Id int `json:"id"`
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(ctx *fiber.Ctx) error
+}
+
+type DeleteUser204Response struct {
+}
+
+func (response DeleteUser204Response) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Status(204)
+	return nil
+}
+
+type DeleteUser400JSONResponse ErrorResponse
+
+func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteUser400ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser404JSONResponse ErrorResponse
+
+func (response DeleteUser404JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteUser404ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser406JSONResponse ErrorResponse
+
+func (response DeleteUser406JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteUser406ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser410JSONResponse ErrorResponse
+
+func (response DeleteUser410JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser410ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteUser410ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser500JSONResponse ErrorResponse
+
+func (response DeleteUser500JSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type DeleteUser500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteUser500ApplicationProblemPlusJSONResponse) VisitDeleteUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserByIdRequestObject struct {
+	Id     int `json:"id"`
+	Params GetUserByIdParams
+}
+
+type GetUserByIdResponseObject interface {
+	VisitGetUserByIdResponse(ctx *fiber.Ctx) error
+}
+
+type GetUserById200ResponseHeaders struct {
+	ETag string
+}
+
+type GetUserById200JSONResponse struct {
+	Body    GetUserByIdResponse
+	Headers GetUserById200ResponseHeaders
+}
+
+func (response GetUserById200JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type GetUserById304ResponseHeaders struct {
+	ETag string
+}
+
+type GetUserById304Response struct {
+	Headers GetUserById304ResponseHeaders
+}
+
+func (response GetUserById304Response) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Status(304)
+	return nil
+}
+
+type GetUserById400JSONResponse ErrorResponse
+
+func (response GetUserById400JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserById400ApplicationProblemPlusJSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById404JSONResponse ErrorResponse
+
+func (response GetUserById404JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserById404ApplicationProblemPlusJSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById406JSONResponse ErrorResponse
+
+func (response GetUserById406JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserById406ApplicationProblemPlusJSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById410JSONResponse ErrorResponse
+
+func (response GetUserById410JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById410ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserById410ApplicationProblemPlusJSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById500JSONResponse ErrorResponse
+
+func (response GetUserById500JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserById500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserById500ApplicationProblemPlusJSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUserRequestObject struct {
+	Id     int `json:"id"`
+	Params PatchUserParams
+	Body   *PatchUserJSONRequestBody
+}
+
+type PatchUserResponseObject interface {
+	VisitPatchUserResponse(ctx *fiber.Ctx) error
+}
+
+type PatchUser200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchUser200JSONResponse struct {
+	Body    GetUserByIdResponse
+	Headers PatchUser200ResponseHeaders
+}
+
+func (response PatchUser200JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type PatchUser400JSONResponse ErrorResponse
+
+func (response PatchUser400JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser400ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser404JSONResponse ErrorResponse
+
+func (response PatchUser404JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser404ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser406JSONResponse ErrorResponse
+
+func (response PatchUser406JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser406ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser409JSONResponse ErrorResponse
+
+func (response PatchUser409JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser409ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser409ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser410JSONResponse ErrorResponse
+
+func (response PatchUser410JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser410ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser410ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser412JSONResponse ErrorResponse
+
+func (response PatchUser412JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(412)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser412ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser412ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(412)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser415JSONResponse ErrorResponse
+
+func (response PatchUser415JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser415ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser415ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser500JSONResponse ErrorResponse
+
+func (response PatchUser500JSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type PatchUser500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response PatchUser500ApplicationProblemPlusJSONResponse) VisitPatchUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUserRequestObject struct {
+	Id     int `json:"id"`
+	Params UpdateUserParams
+	Body   *UpdateUserJSONRequestBody
+}
+
+type UpdateUserResponseObject interface {
+	VisitUpdateUserResponse(ctx *fiber.Ctx) error
+}
+
+type UpdateUser200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateUser200JSONResponse struct {
+	Body    GetUserByIdResponse
+	Headers UpdateUser200ResponseHeaders
+}
+
+func (response UpdateUser200JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type UpdateUser400JSONResponse ErrorResponse
+
+func (response UpdateUser400JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser400ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser404JSONResponse ErrorResponse
+
+func (response UpdateUser404JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser404ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser406JSONResponse ErrorResponse
+
+func (response UpdateUser406JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser406ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser409JSONResponse ErrorResponse
+
+func (response UpdateUser409JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser409ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser409ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(409)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser410JSONResponse ErrorResponse
+
+func (response UpdateUser410JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser410ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser410ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(410)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser412JSONResponse ErrorResponse
+
+func (response UpdateUser412JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(412)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser412ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser412ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(412)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser415JSONResponse ErrorResponse
+
+func (response UpdateUser415JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser415ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser415ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser500JSONResponse ErrorResponse
+
+func (response UpdateUser500JSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type UpdateUser500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response UpdateUser500ApplicationProblemPlusJSONResponse) VisitUpdateUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistoryRequestObject struct {
+	Id int `json:"id"`
+}
+
+type GetUserHistoryResponseObject interface {
+	VisitGetUserHistoryResponse(ctx *fiber.Ctx) error
+}
+
+type GetUserHistory200JSONResponse UserHistoryResponse
+
+func (response GetUserHistory200JSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory400JSONResponse ErrorResponse
+
+func (response GetUserHistory400JSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserHistory400ApplicationProblemPlusJSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory404JSONResponse ErrorResponse
+
+func (response GetUserHistory404JSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserHistory404ApplicationProblemPlusJSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory406JSONResponse ErrorResponse
+
+func (response GetUserHistory406JSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserHistory406ApplicationProblemPlusJSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory500JSONResponse ErrorResponse
+
+func (response GetUserHistory500JSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type GetUserHistory500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetUserHistory500ApplicationProblemPlusJSONResponse) VisitGetUserHistoryResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUserRequestObject struct {
+	Id int `json:"id"`
+}
+
+type RestoreUserResponseObject interface {
+	VisitRestoreUserResponse(ctx *fiber.Ctx) error
+}
+
+type RestoreUser200ResponseHeaders struct {
+	ETag string
+}
+
+type RestoreUser200JSONResponse struct {
+	Body    GetUserByIdResponse
+	Headers RestoreUser200ResponseHeaders
+}
+
+func (response RestoreUser200JSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("ETag", fmt.Sprint(response.Headers.ETag))
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
+
+	return ctx.JSON(&response.Body)
+}
+
+type RestoreUser400JSONResponse ErrorResponse
+
+func (response RestoreUser400JSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response RestoreUser400ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser404JSONResponse ErrorResponse
+
+func (response RestoreUser404JSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response RestoreUser404ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(404)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser406JSONResponse ErrorResponse
+
+func (response RestoreUser406JSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response RestoreUser406ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser500JSONResponse ErrorResponse
+
+func (response RestoreUser500JSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
+}
+
+type RestoreUser500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response RestoreUser500ApplicationProblemPlusJSONResponse) VisitRestoreUserResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(500)
+
+	return ctx.JSON(&response)
 }
 
-// GetUserById operation middleware
-func (siw *ServerInterfaceWrapper) GetUserById(c *fiber.Ctx) error {
+type CreateUsersBatchRequestObject struct {
+	Body *CreateUsersBatchJSONRequestBody
+}
 
-	var err error
+type CreateUsersBatchResponseObject interface {
+	VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error
+}
 
-	// ------------- Path parameter "id" -------------
-	var id int
+type CreateUsersBatch200JSONResponse CreateUsersBatchResponse
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
-	}
+func (response CreateUsersBatch200JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(200)
 
-	return siw.Handler.GetUserById(c, id)
+	return ctx.JSON(&response)
 }
 
-// FiberServerOptions provides options for the Fiber server.
-type FiberServerOptions struct {
-	BaseURL     string
-	Middlewares []MiddlewareFunc
-}
+type CreateUsersBatch400JSONResponse ErrorResponse
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router fiber.Router, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, FiberServerOptions{})
+func (response CreateUsersBatch400JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(400)
+
+	return ctx.JSON(&response)
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, options FiberServerOptions) {
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+type CreateUsersBatch400ApplicationProblemPlusJSONResponse ProblemDetails
 
-	for _, m := range options.Middlewares {
-		router.Use(fiber.Handler(m))
-	}
+func (response CreateUsersBatch400ApplicationProblemPlusJSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(400)
 
-	router.Post(options.BaseURL+"/users", wrapper.CreateUser)
+	return ctx.JSON(&response)
+}
 
-	router.Get(options.BaseURL+"/users/:id", wrapper.GetUserById)
+type CreateUsersBatch406JSONResponse ErrorResponse
 
-}
+func (response CreateUsersBatch406JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/json")
+	ctx.Status(406)
 
-type CreateUserRequestObject struct {
-	Body *CreateUserJSONRequestBody
+	return ctx.JSON(&response)
 }
 
-type CreateUserResponseObject interface {
-	VisitCreateUserResponse(ctx *fiber.Ctx) error
+type CreateUsersBatch406ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateUsersBatch406ApplicationProblemPlusJSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(406)
+
+	return ctx.JSON(&response)
 }
 
-type CreateUser201JSONResponse CreateUserResponse
+type CreateUsersBatch409JSONResponse ErrorResponse
 
-func (response CreateUser201JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+func (response CreateUsersBatch409JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(201)
+	ctx.Status(409)
 
 	return ctx.JSON(&response)
 }
 
-type CreateUser400JSONResponse ErrorResponse
+type CreateUsersBatch409ApplicationProblemPlusJSONResponse ProblemDetails
 
-func (response CreateUser400JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(400)
+func (response CreateUsersBatch409ApplicationProblemPlusJSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(409)
 
 	return ctx.JSON(&response)
 }
 
-type CreateUser500JSONResponse ErrorResponse
+type CreateUsersBatch415JSONResponse ErrorResponse
 
-func (response CreateUser500JSONResponse) VisitCreateUserResponse(ctx *fiber.Ctx) error {
+func (response CreateUsersBatch415JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(500)
+	ctx.Status(415)
 
 	return ctx.JSON(&response)
 }
 
-type GetUserByIdRequestObject struct {
-	Id int `json:"id"`
-}
+type CreateUsersBatch415ApplicationProblemPlusJSONResponse ProblemDetails
 
-type GetUserByIdResponseObject interface {
-	VisitGetUserByIdResponse(ctx *fiber.Ctx) error
+func (response CreateUsersBatch415ApplicationProblemPlusJSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
+	ctx.Status(415)
+
+	return ctx.JSON(&response)
 }
 
-type GetUserById200JSONResponse GetUserByIdResponse
+type CreateUsersBatch422JSONResponse CreateUsersBatchResponse
 
-func (response GetUserById200JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+func (response CreateUsersBatch422JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(200)
+	ctx.Status(422)
 
 	return ctx.JSON(&response)
 }
 
-type GetUserById404JSONResponse ErrorResponse
+type CreateUsersBatch500JSONResponse ErrorResponse
 
-func (response GetUserById404JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
+func (response CreateUsersBatch500JSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
 	ctx.Response().Header.Set("Content-Type", "application/json")
-	ctx.Status(404)
+	ctx.Status(500)
 
 	return ctx.JSON(&response)
 }
 
-type GetUserById500JSONResponse ErrorResponse
+type CreateUsersBatch500ApplicationProblemPlusJSONResponse ProblemDetails
 
-func (response GetUserById500JSONResponse) VisitGetUserByIdResponse(ctx *fiber.Ctx) error {
-	ctx.Response().Header.Set("Content-Type", "application/json")
+func (response CreateUsersBatch500ApplicationProblemPlusJSONResponse) VisitCreateUsersBatchResponse(ctx *fiber.Ctx) error {
+	ctx.Response().Header.Set("Content-Type", "application/problem+json")
 	ctx.Status(500)
 
 	return ctx.JSON(&response)
@@ -174,12 +1794,33 @@ func (response GetUserById500JSONResponse) VisitGetUserByIdResponse(ctx *fiber.C
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// List users
+	// (GET /users)
+	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
 	// Create user
 	// (POST /users)
 	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Delete user
+	// (DELETE /users/{id})
+	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
 	// Get user by ID
 	// (GET /users/{id})
 	GetUserById(ctx context.Context, request GetUserByIdRequestObject) (GetUserByIdResponseObject, error)
+	// Partially update user
+	// (PATCH /users/{id})
+	PatchUser(ctx context.Context, request PatchUserRequestObject) (PatchUserResponseObject, error)
+	// Replace user
+	// (PUT /users/{id})
+	UpdateUser(ctx context.Context, request UpdateUserRequestObject) (UpdateUserResponseObject, error)
+	// Get user change history
+	// (GET /users/{id}/history)
+	GetUserHistory(ctx context.Context, request GetUserHistoryRequestObject) (GetUserHistoryResponseObject, error)
+	// Restore deleted user
+	// (POST /users/{id}:restore)
+	RestoreUser(ctx context.Context, request RestoreUserRequestObject) (RestoreUserResponseObject, error)
+	// Create several users at once
+	// (POST /users:batch)
+	CreateUsersBatch(ctx context.Context, request CreateUsersBatchRequestObject) (CreateUsersBatchResponseObject, error)
 }
 
 type StrictHandlerFunc func(ctx *fiber.Ctx, args interface{}) (interface{}, error)
@@ -195,10 +1836,39 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// ListUsers operation middleware
+func (sh *strictHandler) ListUsers(ctx *fiber.Ctx, params ListUsersParams) error {
+	var request ListUsersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUsers(ctx.UserContext(), request.(ListUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(ListUsersResponseObject); ok {
+		if err := validResponse.VisitListUsersResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // CreateUser operation middleware
-func (sh *strictHandler) CreateUser(ctx *fiber.Ctx) error {
+func (sh *strictHandler) CreateUser(ctx *fiber.Ctx, params CreateUserParams) error {
 	var request CreateUserRequestObject
 
+	request.Params = params
+
 	var body CreateUserJSONRequestBody
 	if err := ctx.BodyParser(&body); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
@@ -226,11 +1896,39 @@ func (sh *strictHandler) CreateUser(ctx *fiber.Ctx) error {
 	return nil
 }
 
+// DeleteUser operation middleware
+func (sh *strictHandler) DeleteUser(ctx *fiber.Ctx, id int) error {
+	var request DeleteUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUser(ctx.UserContext(), request.(DeleteUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(DeleteUserResponseObject); ok {
+		if err := validResponse.VisitDeleteUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetUserById operation middleware
-func (sh *strictHandler) GetUserById(ctx *fiber.Ctx, id int) error {
+func (sh *strictHandler) GetUserById(ctx *fiber.Ctx, id int, params GetUserByIdParams) error {
 	var request GetUserByIdRequestObject
 
 	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
 		return sh.ssi.GetUserById(ctx.UserContext(), request.(GetUserByIdRequestObject))
@@ -252,3 +1950,300 @@ func (sh *strictHandler) GetUserById(ctx *fiber.Ctx, id int) error {
 	}
 	return nil
 }
+
+// PatchUser operation middleware
+func (sh *strictHandler) PatchUser(ctx *fiber.Ctx, id int, params PatchUserParams) error {
+	var request PatchUserRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body PatchUserJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchUser(ctx.UserContext(), request.(PatchUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(PatchUserResponseObject); ok {
+		if err := validResponse.VisitPatchUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// UpdateUser operation middleware
+func (sh *strictHandler) UpdateUser(ctx *fiber.Ctx, id int, params UpdateUserParams) error {
+	var request UpdateUserRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateUserJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateUser(ctx.UserContext(), request.(UpdateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(UpdateUserResponseObject); ok {
+		if err := validResponse.VisitUpdateUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUserHistory operation middleware
+func (sh *strictHandler) GetUserHistory(ctx *fiber.Ctx, id int) error {
+	var request GetUserHistoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserHistory(ctx.UserContext(), request.(GetUserHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(GetUserHistoryResponseObject); ok {
+		if err := validResponse.VisitGetUserHistoryResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RestoreUser operation middleware
+func (sh *strictHandler) RestoreUser(ctx *fiber.Ctx, id int) error {
+	var request RestoreUserRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreUser(ctx.UserContext(), request.(RestoreUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreUser")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(RestoreUserResponseObject); ok {
+		if err := validResponse.VisitRestoreUserResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CreateUsersBatch operation middleware
+func (sh *strictHandler) CreateUsersBatch(ctx *fiber.Ctx) error {
+	var request CreateUsersBatchRequestObject
+
+	var body CreateUsersBatchJSONRequestBody
+	if err := ctx.BodyParser(&body); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	request.Body = &body
+
+	handler := func(ctx *fiber.Ctx, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUsersBatch(ctx.UserContext(), request.(CreateUsersBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUsersBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if validResponse, ok := response.(CreateUsersBatchResponseObject); ok {
+		if err := validResponse.VisitCreateUsersBatchResponse(ctx); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xdeW8buZL/KgXuAhu/pRxJlnMo2D9yzhgzyTMyyewCz0FMdZcsTrrJDsm2LQT+7gsW",
+	"2Ze6FXsGk7zxWP8kdjePqmLxVwdZ7S8s0XmhFSpn2fwLs8kKc0E/PjcoHL63aN7i5xKt8w9FmkontRLZ",
+	"sdEFGifRsvlSZBY5K1qPvjAlcvT/p2gTIwvfi83ZeyU/lwgi1+oMRJZBadFYDlIlWZlKdQYpZugwBa3Q",
+	"Ms7cukA2Z9YZqc7Y1RVnBj+X0mDK5v8Kk3yoW+nFb5g4dsU71NtCK0u0dCmUqf83dpXK4Rma3gwyvWZ8",
+	"+0y4ZPXHZCSy7J/mjXYrzxvJainKzNWt47QLrTMUys8rHeaB+OqH/zS4ZHP2H/eblbwfl/F+fw2vOMvF",
+	"5VHoPBmPOculqn6tJxTGiHVfFNTsZtLYJnODtsyc7SvG2/ACpAK3QrAiR9AmRQPCggnUQ6CA/17ma6K8",
+	"bK+u4bKi8IZ80nJtMvNSuhUakCnoJbGTUMeUtB20ATRGG7apDuHpNWy99I1qAV/x7WrcI7/btbc2iU4H",
+	"dix1Av9uH35AhYYYWRqdE2cYXgsnMn0G99CY+PMeh1SD0g4wlQ4Wa1gJle6fqH/A6Ww8O4U32r3SpUrh",
+	"3o/v3h3DbDzbg1GQUKrRhq6X0rrQZTI+hR+0wqr5ZFw3l7ZGDaFSSISCBYJB67TBlLpPaL7jcpHJZBKH",
+	"OBzTEF5kRokssjKh9tNW++lX20+p/cEp/CoymQovtZojal8p73nzfilkhikHiwgpOiEzG5g8hSNF7X7R",
+	"xnWHKZUti0Ibz6X1b5cSs9QrUyoNJn5cGuPwFN7qLMP0mUg+VUNMp36IhddZ2kRwIYKAK8VcYCJKi7Sk",
+	"IstG2oxUwKXYy3cwNC4sRPKJpnpwCkcp5oV2qJL1T7h+LW1OrTvTttqMfsI1DSUygyJd+/VL4UK6FQhI",
+	"5XKJBpWrREaTPOxMcqSOjT4zaG0tncdtIdNQNYBsziwtWCezDBboOSuMTtDaqCKPTuHYYKJVgO9XtEa1",
+	"tgVOlqPXxF+toIFd2uKlIdpJI8/R2GpBHteLeiyMyNGh6a5sIdyKw+cSzdov5wqFh72ibiwt5NJaT7E2",
+	"kItsqU1e6fX4FF5XT57pdD2sewv/JhHKk7zwOuf3c+pVWRPxHgZINf/LQgCaMPrEK1PpcHivKt10DJJA",
+	"S8NV03rOwkDTU3iNbqXTN9o9zTJ90Yh2fOjH2uzWiDiqfadFTmOFoQ9O4X2zN15jKsW7ddHgxGFfEFo5",
+	"v1QeIEF2ZqnEGvDpaZJg4cQiq0cbPwiMK6ygPfcT0lBkvEKXCoMKo9MyqQY9PIVX2ixkmmKDEQeb3Edj",
+	"ZFsLow04/QmVn+D/Ri9wUZ6N3vkHYdwHp/A07KeXHi83tgYppHeUQDb7zgk/3GINwm9zNNSq7YaJtiNG",
+	"04wmXpEj9nUQsVR4WWDiGxMmnijGGaoyZ/N/zcYzPpuM+YRP+QGf8UP+gD/kj/hj7h9O+GTKJwd8MuOT",
+	"Qz55wEeTxu5Wtoyzy5EfbXQujOfDejNdaSTjzFsFxlmD7+1fpoyzBpkZZy2AZZw1UOlfDYJZ90UDQIyz",
+	"Pl40E9SbnXHW2aE0a2tP+fcbW4NxNqTSga9GKRlntT4xzjo6QISExWIfrjhLvdJ8zNFacTbol6dosrVf",
+	"+WDWYkuv5UJt2LwnYNGBVtnaKySNDLlOEe51lDMi2V7fi+csWr0+Ia+8VRtleI4ZnEud0bLZ1oxLbdqm",
+	"lAiycM8DGhzs3dQ5bFSC/JsXRE7fN+SNT9ZjQapEpqjcR5n22fgFHVHaFVy1Mw8vL/ee0P5elll853en",
+	"VyeT0p6jtxbNud+bfnHAraQFmV4bE3lJsIrwIS/2B3TehX22Pkq3O4Nx938Ubpi5ei1iwyqQu1jJZAU/",
+	"S0tzeJZcaZQNhjnAC36MfRhnflv4KVgqHI6czHFIW4ZdXF7HmF8XCMlsa6RYk/qVQPF3BV1D4h1QLIWX",
+	"7mNSGqtNX8LP6XllYnxTKMQZPgGxsKhcpR+ZsOHFtUqxPXY79hj3Fwzz+4Qavcgwf7ENOd6+eg4PH40f",
+	"QhEaVp71PrwlHSR/xzoUFJV1YiG4WGGQaJJJL9/C4BKNPVGiKDKZEFDcj+P+929Wq8bU75O120VSu0hq",
+	"F0ntIqldJLWLpHaR1C6S+t6R1IC3cVlkQgVEoQ0mLegkYGtS77no0zyJtG46Rrc6dPvLBGieFOuESgbU",
+	"5dgjdFyMCrfcSnjcI4veWqShga0TrhxYC+IivIQYEPbDJyddNkDSLyttPIznuTDrirZIA8HzECHhQY+7",
+	"Vi94//bIBzC6dPNFJtSnxutuEQpWrC1I532na6OaihjioxYGD/73ULDzvkhv8aGmp/tHaZ026+croc4G",
+	"QlVyZQfTFT7O6JP+q8hKhAUutQkua0IDPwHMC7cGSYtvMHq5anjp9bZxxdKh+dqwctuoGyIJbEUmaMZr",
+	"5PNSObPui0ckgb4vlVFlwW1nnJWkGozHzAfzBPih2kvR8CwSNxS3n7DKzThhBC+Bbwu5SLFxqa/xReow",
+	"348QnIoIDXYf3q2w6mbBroTxccSa1K4a1nKwGpQGqVJ5LtNSZFAYD4eFyNowtj+0nCHjc7PETGTvxrmR",
+	"vgYP4PZK2NUAKv34dDQ9fFDhEfoFbu2wwuD5R9+TwwovARX55UM01y0HUFPYVQN4eC51aeNM8akofQie",
+	"6bNKlf0SkZ2QxrrQdmhSi58H0FFb2djnhif65WKls9Z83IOkcZ5VUqPJIKLHaGlgP4YX1UzkyW7uzoER",
+	"N7ah54JXm4g0pZmyUYa2iONqXrNb/6zUWw8Arv7wlYZhL2M73m7eZgiWnF4Hex4yFS3/hwPun+2HeEIb",
+	"yGQu3ZDqtDzN3jtTDhnwX8n5whT8awqy3EeyuBwyVGc+Rk5Wwlh0HIxfMw5hu/MqZEp5MNna+zaflL5Q",
+	"N4ZoIqmhui/bK/KHlmQ0MplgXPdgWtlznRdCrev4qOWnsJBRfnp81FK7OZvsj/fHvpkuUIlCsjk7oEec",
+	"+fCXluk+WWT/0xkSvNXR9lHK5k0OmPrEaMMHR1+Y9FNQRqFKIs9ZtVRB7ToXd6ZjumAj8zJv7tfE34a2",
+	"1+bS/bMQ3pUIeeGQG2wlinvYFHO/Q0SGHh0qewvYm9176SQqDz8Wg3IS8NjKeklL2VF5CfcSYREsKo9i",
+	"57i3hRD/38fQ5Q9TUyXXfOPEZesau6SFXOeo3DYphI4fqX1n+psYuD5Nz3WeC7DotaSbP7RwT6acJMah",
+	"ntbtcThhoxNWCS1HoSz4QVF5wxVRwI/zP9R3JNN9eB82HeUky5CBxmoaYRAM/hayBLQo5DfPgmsQFUVa",
+	"WFCaKaaliE7pyI+T1pY+8ayNt/94WWSUro4O75AUbQjxG+HVaLwlDmiMuXVr2rte3Kwvz6eZ1fGMqHuS",
+	"RNGXz3SfIzRnUWDR+Xz+zfM6PDhQFzKmZKsYy8Nx1OnZ+CAGoZPDbUrcP7ka2Pxbbu1dfSBfkiwcCW06",
+	"HjM6JaDkmf+xfczgjxeau5jXmbz+8RUB7MZO+skvxexPnHbjFtoV74zVPim5+ZgbBzwDfDwTKVSmNaYN",
+	"YNRLKfC4IeiEgV6S+sfHj1uPa6zfC/I5uOXyaeVEoz4Tsx3VJZ3XpRvYPFEKD265FN5oB+18cxDFjLg7",
+	"vPV7oE4c/xJyT0QFzR4zNtGfCTBKAY+2Az5Pc5e17/R0Z3wtPiEd5BuJFqxY4hwEGCyCTR4+MPqEazpC",
+	"pGOCM3QRrI08k574Cg95tweNUSXSqau0G8ZuNp3uw0+4toCXhTRVkkHEXNzIyhTh3buf9yskD7nTBso3",
+	"jrI6UJ6Ly5/JQ2bz6eEhOW/V75O+d/AheMBoHWWj/yy9Gri13XW2nSnxqmdTJt+EgO1GJbRK76BlGTQh",
+	"8d1kDKPmZJF0+A7A6mz8+JZz9/b3HXtLBUV9fE6SeLgHMRV0oxPCSn4Pgvwmh7dcfq1TNqBjNghHx4HL",
+	"g8DldHrbje/NLmCoTtI2GLIgiQd3yAsJBoK0nd6EJMz9LzK9am4Y9rNXr4X5ZFtZyvpKUghw6aF1dEqk",
+	"wDpt/OGqXwdwOl9YpxXOoXUJD4SyF2isv+jEWzcT7Upf0EkTnU0O3U/k5MG8DccAvhMsvOWnTv7qjncx",
+	"uk7VC+o47FSRM+IzUq2oMmWbdn0gQ9Kki/qB5KwvvzcankfF+pua5q+FcLO/gZUNlxn+/k7D5LarJmHC",
+	"SlhYIDb5q8Ckv1Z5d7A+AF/Eej6cZW9h8jdBx15y8eU7cWYhZFKdbt3vfRJPoOurhloFj41O8N0Kcw4H",
+	"41kIPsPd4a3R5HL0RisMtxm/mt/+llnAwTvng3lAHjkgEryABi+fk1DO+2eGN1mQmmE//8GwhXLwWqdy",
+	"KTH93gTt7OHOHu7s4c4efmN7+APG++uLNRy98HwXZCB6RrEuv/l+JrF7CcSGKiIfw3piGyMJ97SBf+zt",
+	"w1Greb244bJHClaqJGZxww2qgYztZLr/FfNZWc6b4/g3yrv2KqFulHa9A0Z8l969QXp3Z2h32ervx90f",
+	"STHfBe9iNrn1KeYbVMb1rHgQwqM7dJZwN9zIY2GcFFm2rpyrKsFSlAMJlqa6YedM/rudyX6lyc6b3HmT",
+	"O29y503uvMmdN7nzJnfe5Pf3Jt9ikYlk+EbG/VWom2rVyGw4eyrc/9wshKsr4MJ3D3SWhsInY90+vDxH",
+	"s67q2qzfbqtRshJSYV2ZUNWynKhOoV0ofwuFbxoicf5lp0qgfUIHwsIFZtk+PI0OpX9rxTmmscD1REnn",
+	"n2X67AzTeXUI6Jm4MNJhKArgnTqBcN3LlkmCmMZ3vllpsFMQLiyIE9W+g1qVn9MlkqbKrv0RkvrbP5G/",
+	"8C2hwVPTWNb2na6V/Hm7YajK705VKOwO53aFA7fv2CpiVWUUNozFvCqNn3+pqwt6n/N22oTP39CAVAMb",
+	"vxBUQbh3OUHpkS76N+lat+5uHebtQu8dvu7wdYevQx44oVrHiW2B63xRXRIYRtXgTtNnB6mkSjoL/nJb",
+	"+JsFc8Dwdwc8Pmz/2wMi5qf24X9jJWD7z1CENBbNEUqNq+54jsp7zJQRsOQDt1JeYbDuSNVnD2VTvy2X",
+	"4Iv7iYPobFfecYXGvvlsOm1VNh/WIUagikzJBRoM8/dtx+afaWDfuk6r+zdAvnOid+sf39gVAu/Ktf6u",
+	"KcunN6yy0qYpVI0f1lmE76pW2cwnNU5diBqodjVZ3xqJqEH7O7h82zrcqWIpi+doRPyCHAgHWiVRfiG3",
+	"FAKg0mRszlbOFfP79zOdiGylrZs/Gj8as6sPV/8/AKTzv8PXawAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"shared/errcatalog"
+	"shared/validation"
+)
+
+// Fiber - middleware fiber, отвечающий ErrorResponse на ошибки, которые вернула цепочка: ошибки fiber
+// (ненайденный маршрут или метод), проверки по спецификации и сгенерированной обертки (разбор параметров и тела). Ставится последним,
+// чтобы ответ прошел через остальные middleware, как обычный ответ обработчика.
+func (h *Handlers) Fiber() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		err := c.Next()
+		if err == nil {
+			return nil
+		}
+
+		entry, reason := fiberErrorEntry(err)
+
+		return c.Status(entry.Status).JSON(h.errorResponse(c.UserContext(), reason, entry))
+	}
+}
+
+// fiberErrorEntry выбирает запись каталога для ошибки fiber или проверки по спецификации. reason - ошибка
+// для ErrorResponse: у *fiber.Error это ее сообщение без кода.
+func fiberErrorEntry(err error) (errcatalog.Entry, error) {
+	var validationErr *validation.Error
+	if errors.As(err, &validationErr) {
+		return validationErr.Entry, err
+	}
+
+	var fiberErr *fiber.Error
+	if !errors.As(err, &fiberErr) {
+		return errcatalog.Internal, err
+	}
+
+	reason := errors.New(fiberErr.Message)
+
+	switch fiberErr.Code {
+	case http.StatusBadRequest:
+		if isParameterError(fiberErr.Message) {
+			return errcatalog.InvalidParameter, reason
+		}
+
+		return errcatalog.MalformedBody, reason
+	case http.StatusNotFound:
+		return errcatalog.RouteNotFound, reason
+	case http.StatusMethodNotAllowed:
+		return errcatalog.MethodNotAllowed, reason
+	default:
+		return errcatalog.Internal, err
+	}
+}
+
+// isParameterError отличает ошибки разбора параметров от ошибок разбора тела: обертка fiber отдает и те, и другие
+// как fiber.ErrBadRequest, а параметры описывает только такими сообщениями.
+func isParameterError(message string) bool {
+	return strings.HasPrefix(message, "Invalid format for ") || strings.HasPrefix(message, "Header parameter ")
+}
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"server/adapter"
+	api "server/generated"
+	"shared/usecases"
+	"shared/validation"
+)
+
+func TestHandlers_Fiber(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		body           string
+		wantStatusCode int
+		wantCode       api.ErrorResponseCode
+	}{
+		{
+			name:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/users/abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=many",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "malformed body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    fiber.MIMEApplicationJSON,
+			body:           `{"name":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeMalformedBody,
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodGet,
+			target:         "/accounts",
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       api.ErrorResponseCodeRouteNotFound,
+		},
+		{
+			// для fiber "1:archive" - значение параметра id, а путь /users/:id есть только для других методов
+			name:           "unknown custom method",
+			method:         http.MethodPost,
+			target:         "/users/1:archive",
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantCode:       api.ErrorResponseCodeMethodNotAllowed,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPut,
+			target:         "/users",
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantCode:       api.ErrorResponseCodeMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(NewMockUseCases(t), nil)
+
+			mux := fiber.New()
+			mux.Use(h.Fiber())
+			api.RegisterHandlers(adapter.NewRouter(mux), api.NewStrictHandler(h, nil))
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set(fiber.HeaderContentType, tt.contentType)
+			}
+
+			resp, err := mux.Test(req)
+			if err != nil {
+				t.Fatalf("Test() error = %v", err)
+			}
+
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", resp.StatusCode, tt.wantStatusCode, body)
+			}
+
+			var got api.ErrorResponse
+
+			err = json.Unmarshal(body, &got)
+			if err != nil {
+				t.Fatalf("failed to unmarshal response body: %v; raw: %q", err, body)
+			}
+
+			if got.Code != tt.wantCode || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHandlers_FiberRequestValidation(t *testing.T) {
+	spec, err := api.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger() error = %v", err)
+	}
+
+	validator, err := validation.New(spec)
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	h := New(NewMockUseCases(t), nil)
+
+	mux := fiber.New()
+	mux.Use(h.Fiber())
+	mux.Use(adapter.Validate(validator))
+	api.RegisterHandlers(adapter.NewRouter(mux), api.NewStrictHandler(h, nil))
+
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"Alice","admin":true}`))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+
+	resp, err := mux.Test(req)
+	if err != nil {
+		t.Fatalf("Test() error = %v", err)
+	}
+
+	defer resp.Body.Close()
+
+	var got api.ErrorResponse
+
+	err = json.NewDecoder(resp.Body).Decode(&got)
+	if err != nil {
+		t.Fatalf("failed to decode response body: %v", err)
+	}
+
+	want := []api.ValidationErrorDetail{{Field: "admin", Rule: usecases.RuleUnknown, Message: "is not allowed"}}
+
+	if resp.StatusCode != http.StatusBadRequest || got.Code != api.ErrorResponseCodeValidation || got.Details == nil || !reflect.DeepEqual(*got.Details, want) {
+		t.Fatalf("status = %d, body = %+v; want 400 with code %d and details %+v", resp.StatusCode, got, api.ErrorResponseCodeValidation, want)
+	}
+}
+
+// checkResponse записывает ответ strict-обработчика операции operationID так же, как сгенерированная обертка,
+// и проверяет его по спецификации (validateResponse). nil - обработчик вернул ошибку, проверять нечего.
+func checkResponse(t *testing.T, operationID string, response any) {
+	t.Helper()
+
+	if response == nil {
+		return
+	}
+
+	visit := reflect.ValueOf(response).MethodByName("Visit" + operationID + "Response")
+	if !visit.IsValid() {
+		t.Fatalf("%T is not a response of %s", response, operationID)
+	}
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		err, _ := visit.Call([]reflect.Value{reflect.ValueOf(c)})[0].Interface().(error)
+
+		return err
+	})
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("Visit%sResponse() error = %v", operationID, err)
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	validateResponse(t, operationID, resp.StatusCode, resp.Header, body)
+}
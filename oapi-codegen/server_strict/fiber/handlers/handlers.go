@@ -2,24 +2,39 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
 
 	api "server/generated"
-	"server/usecases"
+	"shared/errcatalog"
+	"shared/etag"
+	"shared/incident"
+	"shared/usecases"
+	"shared/validation"
 )
 
 type Handlers struct {
-	useCases UseCases
+	useCases  UseCases
+	incidents *incident.Reporter
 }
 
 type UseCases interface {
 	GetUser(ctx context.Context, id int) (usecases.User, error)
 	CreateUsers(ctx context.Context, userRequests usecases.CreateUserRequestDTO) (int, error)
+	CreateUsersBatch(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error)
+	UpdateUser(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO) (usecases.User, error)
+	PatchUser(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO) (usecases.User, error)
+	DeleteUser(ctx context.Context, id int) error
+	RestoreUser(ctx context.Context, id int) (usecases.User, error)
+	UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error)
+	ListUsers(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO) (usecases.UsersPage, error)
 }
 
-func New(useCases UseCases) *Handlers {
+func New(useCases UseCases, incidents *incident.Reporter) *Handlers {
 	return &Handlers{
-		useCases: useCases,
+		useCases:  useCases,
+		incidents: incidents,
 	}
 }
 
@@ -28,44 +43,37 @@ func (h *Handlers) GetUserById(ctx context.Context, request api.GetUserByIdReque
 
 	user, err := h.useCases.GetUser(ctx, id)
 	if err != nil {
-		switch {
-		case errors.Is(err, usecases.ErrNotFound):
-			response := api.ErrorResponse{
-				Code:  404,
-				Error: "Not Found",
-			}
-
-			return api.GetUserById404JSONResponse(response), nil
-		case errors.Is(err, usecases.ErrNotPublic1):
-			response := api.ErrorResponse{
-				Code:  1,
-				Error: "Internal Server Error 1",
-			}
-
-			return api.GetUserById500JSONResponse(response), nil
-		case errors.Is(err, usecases.ErrNotPublic2):
-			response := api.ErrorResponse{
-				Code:  2,
-				Error: "Internal Server Error 2",
-			}
-
-			return api.GetUserById500JSONResponse(response), nil
-		default:
-			response := api.ErrorResponse{
-				Code:  -1,
-				Error: "Internal Server Error",
-			}
+		entry := errcatalog.Lookup(err, errcatalog.NotFound, errcatalog.Gone, errcatalog.NotPublic1, errcatalog.NotPublic2)
 
-			return api.GetUserById500JSONResponse(response), nil
+		switch entry.Status {
+		case http.StatusNotFound:
+			return api.GetUserById404JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusGone:
+			return api.GetUserById410JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.GetUserById500JSONResponse(h.errorResponse(ctx, err, entry)), nil
 		}
 	}
 
+	if request.Params.IfNoneMatch != nil && etag.NoneMatch(*request.Params.IfNoneMatch, user.Version) {
+		return api.GetUserById304Response{
+			Headers: api.GetUserById304ResponseHeaders{
+				ETag: etag.Format(user.Version),
+			},
+		}, nil
+	}
+
 	response := api.GetUserByIdResponse{
 		Id:   user.ID,
 		Name: user.Name,
 	}
 
-	return api.GetUserById200JSONResponse(response), nil
+	return api.GetUserById200JSONResponse{
+		Body: response,
+		Headers: api.GetUserById200ResponseHeaders{
+			ETag: etag.Format(user.Version),
+		},
+	}, nil
 
 }
 
@@ -76,21 +84,15 @@ func (h *Handlers) CreateUser(ctx context.Context, request api.CreateUserRequest
 
 	id, err := h.useCases.CreateUsers(ctx, createUserRequestDTO)
 	if err != nil {
-		switch {
-		case errors.Is(err, usecases.ErrValidation):
-			response := api.ErrorResponse{
-				Code:  3,
-				Error: err.Error(),
-			}
-
-			return api.CreateUser400JSONResponse(response), nil
-		default:
-			response := api.ErrorResponse{
-				Code:  -1,
-				Error: "Internal Server Error",
-			}
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.AlreadyExists)
 
-			return api.CreateUser500JSONResponse(response), nil
+		switch entry.Status {
+		case http.StatusBadRequest:
+			return api.CreateUser400JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusConflict:
+			return api.CreateUser409JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.CreateUser500JSONResponse(h.errorResponse(ctx, err, entry)), nil
 		}
 	}
 
@@ -100,3 +102,405 @@ func (h *Handlers) CreateUser(ctx context.Context, request api.CreateUserRequest
 
 	return api.CreateUser201JSONResponse(response), nil
 }
+
+func (h *Handlers) CreateUsersBatch(ctx context.Context, request api.CreateUsersBatchRequestObject) (api.CreateUsersBatchResponseObject, error) {
+	createUsersBatchRequestDTO := usecases.CreateUsersBatchRequestDTO{
+		Items: make([]usecases.CreateUserRequestDTO, 0, len(request.Body.Items)),
+	}
+
+	for _, item := range request.Body.Items {
+		createUsersBatchRequestDTO.Items = append(createUsersBatchRequestDTO.Items, usecases.CreateUserRequestDTO{
+			Name: item.Name,
+		})
+	}
+
+	if request.Body.AllOrNothing != nil {
+		createUsersBatchRequestDTO.AllOrNothing = *request.Body.AllOrNothing
+	}
+
+	batch, err := h.useCases.CreateUsersBatch(ctx, createUsersBatchRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			return api.CreateUsersBatch400JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusConflict:
+			return api.CreateUsersBatch409JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.CreateUsersBatch500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	response := api.CreateUsersBatchResponse{
+		Results: make([]api.CreateUsersBatchResult, 0, len(batch.Results)),
+	}
+
+	for _, result := range batch.Results {
+		if result.Err != nil {
+			response.Results = append(response.Results, api.CreateUsersBatchResult{
+				Error: h.batchItemError(ctx, result.Err),
+			})
+
+			continue
+		}
+
+		id := result.ID
+
+		response.Results = append(response.Results, api.CreateUsersBatchResult{
+			Id: &id,
+		})
+	}
+
+	if batch.RolledBack {
+		return api.CreateUsersBatch422JSONResponse(response), nil
+	}
+
+	return api.CreateUsersBatch200JSONResponse(response), nil
+}
+
+// batchItemError - ошибка отдельного элемента пакетного создания
+func (h *Handlers) batchItemError(ctx context.Context, err error) *api.ErrorResponse {
+	response := h.errorResponse(ctx, err, errcatalog.Lookup(err, errcatalog.Validation, errcatalog.RolledBack))
+
+	return &response
+}
+
+// ParameterError отвечает на ошибку разбора параметров запроса в сгенерированной обертке
+// (StdHTTPServerOptions.ErrorHandlerFunc).
+func (h *Handlers) ParameterError(w http.ResponseWriter, r *http.Request, err error) {
+	h.writeEntry(w, r, err, errcatalog.InvalidParameter)
+}
+
+// BodyError отвечает на тело запроса, которое strict-обертка не смогла разобрать
+// (StrictHTTPServerOptions.RequestErrorHandlerFunc).
+func (h *Handlers) BodyError(w http.ResponseWriter, r *http.Request, err error) {
+	h.writeEntry(w, r, err, errcatalog.MalformedBody)
+}
+
+// ResponseError отвечает на ошибку, которую strict-обертка получила от обработчика или при записи ответа
+// (StrictHTTPServerOptions.ResponseErrorHandlerFunc).
+func (h *Handlers) ResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	h.writeEntry(w, r, err, errcatalog.Internal)
+}
+
+// RequestError отвечает на запрос, не прошедший проверку по спецификации (validation.Validator.Middleware).
+func (h *Handlers) RequestError(w http.ResponseWriter, r *http.Request, err error) {
+	entry := errcatalog.Validation
+
+	var validationErr *validation.Error
+	if errors.As(err, &validationErr) {
+		entry = validationErr.Entry
+	}
+
+	h.writeEntry(w, r, err, entry)
+}
+
+// NotFound отвечает на запрос, которому не соответствует ни одна операция (custommethod.ServeMux.NotFound).
+func (h *Handlers) NotFound(w http.ResponseWriter, r *http.Request) {
+	h.writeEntry(w, r, nil, errcatalog.RouteNotFound)
+}
+
+// MethodNotAllowed отвечает на запрос к пути, у которого нет операции с таким методом
+// (custommethod.ServeMux.MethodNotAllowed).
+func (h *Handlers) MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	h.writeEntry(w, r, nil, errcatalog.MethodNotAllowed)
+}
+
+// writeEntry отвечает ошибкой из каталога в обход StrictServerInterface - для ошибок, которые возникают
+// до вызова обработчика или после него.
+func (h *Handlers) writeEntry(w http.ResponseWriter, r *http.Request, err error, entry errcatalog.Entry) {
+	responseBytes, marshalErr := json.Marshal(h.errorResponse(r.Context(), err, entry))
+	if marshalErr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(entry.Status)
+
+	_, _ = w.Write(responseBytes)
+}
+
+// errorResponse - ответ с ошибкой по записи каталога. Нарушения по полям добавляются только к ошибке валидации;
+// внутренняя ошибка (5xx) регистрируется как инцидент, и клиент получает его ID.
+func (h *Handlers) errorResponse(ctx context.Context, err error, entry errcatalog.Entry) api.ErrorResponse {
+	response := api.ErrorResponse{
+		Code:  api.ErrorResponseCode(entry.Code),
+		Error: entry.Text(err),
+	}
+
+	if entry == errcatalog.Validation {
+		response.Details = validationDetails(err)
+	}
+
+	if entry.Status >= http.StatusInternalServerError {
+		id, debugMessage := h.incidents.Report(ctx, entry.Code, err)
+
+		if id != "" {
+			response.IncidentId = &id
+		}
+
+		if debugMessage != "" {
+			response.DebugMessage = &debugMessage
+		}
+	}
+
+	return response
+}
+
+// validationDetails - нарушения по полям из ошибки валидации; nil, если ошибка их не содержит
+func validationDetails(err error) *[]api.ValidationErrorDetail {
+	var validationErr *usecases.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	details := make([]api.ValidationErrorDetail, 0, len(validationErr.Fields))
+
+	for _, f := range validationErr.Fields {
+		details = append(details, api.ValidationErrorDetail{
+			Field:   f.Field,
+			Rule:    f.Rule,
+			Message: f.Message,
+		})
+	}
+
+	return &details
+}
+
+func (h *Handlers) UpdateUser(ctx context.Context, request api.UpdateUserRequestObject) (api.UpdateUserResponseObject, error) {
+	updateUserRequestDTO := usecases.UpdateUserRequestDTO{
+		Name:    request.Body.Name,
+		IfMatch: etag.ParseIfMatch(request.Params.IfMatch),
+	}
+
+	user, err := h.useCases.UpdateUser(ctx, request.Id, updateUserRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			return api.UpdateUser400JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusNotFound:
+			return api.UpdateUser404JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusGone:
+			return api.UpdateUser410JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusConflict:
+			return api.UpdateUser409JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusPreconditionFailed:
+			return api.UpdateUser412JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.UpdateUser500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	response := api.GetUserByIdResponse{
+		Id:   user.ID,
+		Name: user.Name,
+	}
+
+	return api.UpdateUser200JSONResponse{
+		Body: response,
+		Headers: api.UpdateUser200ResponseHeaders{
+			ETag: etag.Format(user.Version),
+		},
+	}, nil
+}
+
+func (h *Handlers) PatchUser(ctx context.Context, request api.PatchUserRequestObject) (api.PatchUserResponseObject, error) {
+	patchUserRequestDTO := usecases.PatchUserRequestDTO{
+		Name:    request.Body.Name,
+		IfMatch: etag.ParseIfMatch(request.Params.IfMatch),
+	}
+
+	user, err := h.useCases.PatchUser(ctx, request.Id, patchUserRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			return api.PatchUser400JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusNotFound:
+			return api.PatchUser404JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusGone:
+			return api.PatchUser410JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusConflict:
+			return api.PatchUser409JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusPreconditionFailed:
+			return api.PatchUser412JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.PatchUser500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	response := api.GetUserByIdResponse{
+		Id:   user.ID,
+		Name: user.Name,
+	}
+
+	return api.PatchUser200JSONResponse{
+		Body: response,
+		Headers: api.PatchUser200ResponseHeaders{
+			ETag: etag.Format(user.Version),
+		},
+	}, nil
+}
+
+func (h *Handlers) DeleteUser(ctx context.Context, request api.DeleteUserRequestObject) (api.DeleteUserResponseObject, error) {
+	err := h.useCases.DeleteUser(ctx, request.Id)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound, errcatalog.Gone)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			return api.DeleteUser404JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusGone:
+			return api.DeleteUser410JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.DeleteUser500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	return api.DeleteUser204Response{}, nil
+}
+
+func (h *Handlers) RestoreUser(ctx context.Context, request api.RestoreUserRequestObject) (api.RestoreUserResponseObject, error) {
+	user, err := h.useCases.RestoreUser(ctx, request.Id)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			return api.RestoreUser404JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.RestoreUser500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	response := api.GetUserByIdResponse{
+		Id:   user.ID,
+		Name: user.Name,
+	}
+
+	return api.RestoreUser200JSONResponse{
+		Body: response,
+		Headers: api.RestoreUser200ResponseHeaders{
+			ETag: etag.Format(user.Version),
+		},
+	}, nil
+}
+
+func (h *Handlers) GetUserHistory(ctx context.Context, request api.GetUserHistoryRequestObject) (api.GetUserHistoryResponseObject, error) {
+	history, err := h.useCases.UserHistory(ctx, request.Id)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			return api.GetUserHistory404JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.GetUserHistory500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	response := api.UserHistoryResponse{
+		Items: make([]api.UserHistoryEntry, 0, len(history)),
+	}
+
+	for _, entry := range history {
+		item := api.UserHistoryEntry{
+			Seq:      entry.Seq,
+			Action:   api.UserHistoryEntryAction(entry.Action),
+			At:       entry.At,
+			Version:  entry.Version,
+			Changes:  make([]api.UserHistoryChange, 0, len(entry.Changes)),
+			PrevHash: entry.PrevHash,
+			Hash:     entry.Hash,
+		}
+
+		if entry.Actor != "" {
+			actor := entry.Actor
+			item.Actor = &actor
+		}
+
+		for _, change := range entry.Changes {
+			item.Changes = append(item.Changes, api.UserHistoryChange{
+				Field: change.Field,
+				From:  change.From,
+				To:    change.To,
+			})
+		}
+
+		response.Items = append(response.Items, item)
+	}
+
+	return api.GetUserHistory200JSONResponse(response), nil
+}
+
+func (h *Handlers) ListUsers(ctx context.Context, request api.ListUsersRequestObject) (api.ListUsersResponseObject, error) {
+	var listUsersRequestDTO usecases.ListUsersRequestDTO
+
+	if request.Params.Limit != nil {
+		listUsersRequestDTO.Limit = *request.Params.Limit
+	}
+
+	if request.Params.Cursor != nil {
+		listUsersRequestDTO.Cursor = *request.Params.Cursor
+	}
+
+	if request.Params.NamePrefix != nil {
+		listUsersRequestDTO.NamePrefix = *request.Params.NamePrefix
+	}
+
+	if request.Params.CreatedAfter != nil {
+		listUsersRequestDTO.CreatedAfter = *request.Params.CreatedAfter
+	}
+
+	if request.Params.Sort != nil {
+		listUsersRequestDTO.Sort = *request.Params.Sort
+	}
+
+	if request.Params.IncludeDeleted != nil {
+		listUsersRequestDTO.IncludeDeleted = *request.Params.IncludeDeleted
+	}
+
+	page, err := h.useCases.ListUsers(ctx, listUsersRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.InvalidSort, errcatalog.Validation, errcatalog.Forbidden)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			return api.ListUsers400JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		case http.StatusForbidden:
+			return api.ListUsers403JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		default:
+			return api.ListUsers500JSONResponse(h.errorResponse(ctx, err, entry)), nil
+		}
+	}
+
+	response := api.ListUsersResponse{
+		Items: make([]api.GetUserByIdResponse, 0, len(page.Users)),
+	}
+
+	for _, user := range page.Users {
+		item := api.GetUserByIdResponse{
+			Id:   user.ID,
+			Name: user.Name,
+		}
+
+		if user.Deleted() {
+			deletedAt := user.DeletedAt
+			item.DeletedAt = &deletedAt
+		}
+
+		response.Items = append(response.Items, item)
+	}
+
+	if page.NextCursor != "" {
+		response.NextCursor = &page.NextCursor
+	}
+
+	return api.ListUsers200JSONResponse(response), nil
+}
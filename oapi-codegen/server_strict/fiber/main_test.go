@@ -0,0 +1,64 @@
+package main
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"shared/audit"
+	"shared/conformance"
+	"shared/repository/memory"
+)
+
+func TestConformance(t *testing.T) {
+	t.Setenv("DEBUG_TOKEN", conformance.DebugToken)
+
+	app, err := newHandler(memory.New(), audit.New(), time.Hour)
+	if err != nil {
+		t.Fatalf("newHandler() error = %v", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+
+	go func() {
+		_ = app.Listener(listener)
+	}()
+	defer app.Shutdown()
+
+	conformance.Run(t, "http://"+listener.Addr().String())
+}
+
+// Журнал и пользователи должны храниться одинаково долго, иначе после перезапуска они расходятся
+func TestNewAuditLog(t *testing.T) {
+	auditFile := filepath.Join(t.TempDir(), "audit.log")
+
+	tests := []struct {
+		storage   string
+		auditFile string
+		wantErr   bool
+	}{
+		{storage: "", auditFile: ""},
+		{storage: "memory", auditFile: ""},
+		{storage: "memory", auditFile: auditFile, wantErr: true},
+		{storage: "sqlite", auditFile: "", wantErr: true},
+		{storage: "sqlite", auditFile: auditFile},
+		{storage: "file", auditFile: "", wantErr: true},
+		{storage: "file", auditFile: auditFile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.storage+" "+tt.auditFile, func(t *testing.T) {
+			t.Setenv("STORAGE", tt.storage)
+			t.Setenv("AUDIT_FILE", tt.auditFile)
+
+			_, err := newAuditLog()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newAuditLog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package adapter
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"shared/idempotency"
+)
+
+// Idempotency - idempotency.Middleware в виде middleware gin. Ответ остальных обработчиков цепочки проходит через
+// ResponseWriter middleware, поэтому записывается в store так же, как в net/http.
+func Idempotency(store *idempotency.Store, routes ...idempotency.Route) gin.HandlerFunc {
+	middleware := idempotency.Middleware(store, routes...)
+
+	return func(c *gin.Context) {
+		called := false
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+
+			original := c.Writer
+			// статус мог быть выставлен до цепочки: так gin отвечает на NoRoute и NoMethod
+			writer := &ginResponseWriter{ResponseWriter: original, w: w, status: original.Status()}
+
+			c.Writer = writer
+			c.Request = r
+
+			c.Next()
+
+			// как и gin после обработчиков: статус без тела тоже должен дойти до клиента
+			writer.WriteHeaderNow()
+
+			c.Writer = original
+		})
+
+		middleware(next).ServeHTTP(c.Writer, c.Request)
+
+		// ответ уже отдан самим middleware (повтор или ошибка) - остальные обработчики не нужны
+		if !called {
+			c.Abort()
+		}
+	}
+}
+
+// ginResponseWriter перенаправляет запись ответа gin в http.ResponseWriter middleware.
+type ginResponseWriter struct {
+	gin.ResponseWriter
+	w       http.ResponseWriter
+	status  int
+	size    int
+	written bool
+}
+
+func (g *ginResponseWriter) Header() http.Header {
+	return g.w.Header()
+}
+
+func (g *ginResponseWriter) WriteHeader(statusCode int) {
+	if g.written {
+		return
+	}
+
+	g.status = statusCode
+}
+
+func (g *ginResponseWriter) WriteHeaderNow() {
+	if g.written {
+		return
+	}
+
+	g.written = true
+
+	if g.status == 0 {
+		g.status = http.StatusOK
+	}
+
+	g.w.WriteHeader(g.status)
+}
+
+func (g *ginResponseWriter) Write(b []byte) (int, error) {
+	g.WriteHeaderNow()
+
+	n, err := g.w.Write(b)
+	g.size += n
+
+	return n, err
+}
+
+func (g *ginResponseWriter) WriteString(s string) (int, error) {
+	return g.Write([]byte(s))
+}
+
+func (g *ginResponseWriter) Status() int {
+	if g.status == 0 {
+		return http.StatusOK
+	}
+
+	return g.status
+}
+
+func (g *ginResponseWriter) Size() int {
+	if !g.written {
+		return -1
+	}
+
+	return g.size
+}
+
+func (g *ginResponseWriter) Written() bool {
+	return g.written
+}
+
+func (g *ginResponseWriter) Flush() {
+	g.WriteHeaderNow()
+
+	if flusher, ok := g.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (g *ginResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, http.ErrNotSupported
+}
@@ -0,0 +1,99 @@
+package adapter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"shared/idempotency"
+)
+
+// createUser - операция, которую middleware делает идемпотентной в тестах
+var createUser = idempotency.Route{Method: http.MethodPost, Path: "/users"}
+
+func TestIdempotency(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	calls := 0
+
+	r := gin.New()
+	r.Use(Idempotency(idempotency.NewStore(time.Hour, 100), createUser, idempotency.Route{Method: http.MethodPost, Path: "/empty"}))
+	r.POST("/users", func(c *gin.Context) {
+		calls++
+
+		c.JSON(http.StatusCreated, gin.H{"id": calls})
+	})
+	r.POST("/empty", func(c *gin.Context) {
+		c.Status(http.StatusAccepted)
+	})
+
+	send := func(path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set(idempotency.Header, "key-1")
+
+		rr := httptest.NewRecorder()
+		r.ServeHTTP(rr, req)
+
+		return rr
+	}
+
+	first := send("/users", `{"name":"Alice"}`)
+	second := send("/users", `{"name":"Alice"}`)
+
+	if first.Code != http.StatusCreated || second.Code != http.StatusCreated {
+		t.Fatalf("status = %d, %d, want %d", first.Code, second.Code, http.StatusCreated)
+	}
+
+	if second.Body.String() != first.Body.String() {
+		t.Fatalf("replay body = %q, want %q", second.Body.String(), first.Body.String())
+	}
+
+	if got := second.Header().Get("Content-Type"); got != first.Header().Get("Content-Type") {
+		t.Fatalf("replay Content-Type = %q, want %q", got, first.Header().Get("Content-Type"))
+	}
+
+	if got := second.Header().Get(idempotency.ReplayedHeader); got != "true" {
+		t.Fatalf("replay %s = %q, want %q", idempotency.ReplayedHeader, got, "true")
+	}
+
+	mismatch := send("/users", `{"name":"Bob"}`)
+	if mismatch.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("mismatch status = %d, want %d", mismatch.Code, http.StatusUnprocessableEntity)
+	}
+
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+
+	for range 2 {
+		rr := send("/empty", "")
+		if rr.Code != http.StatusAccepted {
+			t.Fatalf("status without body = %d, want %d", rr.Code, http.StatusAccepted)
+		}
+	}
+}
+
+func TestIdempotency_NoRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := gin.New()
+	// ответ NoRoute тоже проходит через middleware, если путь входит в его операции
+	r.Use(Idempotency(idempotency.NewStore(time.Hour, 100), idempotency.Route{Method: http.MethodPost, Path: "/accounts"}))
+	r.POST("/users", func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/accounts", nil)
+	req.Header.Set(idempotency.Header, "key-1")
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusNotFound)
+	}
+}
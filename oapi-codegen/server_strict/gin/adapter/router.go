@@ -0,0 +1,125 @@
+package adapter
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"shared/custommethod"
+)
+
+// Router - gin.IRouter, понимающий шаблоны "/users/:id:restore". Gin допускает только один параметр
+// в сегменте, поэтому шаблон регистрируется как "/users/:id", а обработчик выбирается по суффиксу значения.
+type Router struct {
+	gin.IRouter
+	routes map[string]*custommethod.Route[gin.HandlersChain]
+}
+
+func NewRouter(router gin.IRouter) *Router {
+	return &Router{
+		IRouter: router,
+		routes:  make(map[string]*custommethod.Route[gin.HandlersChain]),
+	}
+}
+
+func (r *Router) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodGet, path, handlers...)
+}
+
+func (r *Router) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPost, path, handlers...)
+}
+
+func (r *Router) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodDelete, path, handlers...)
+}
+
+func (r *Router) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPatch, path, handlers...)
+}
+
+func (r *Router) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPut, path, handlers...)
+}
+
+func (r *Router) OPTIONS(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodOptions, path, handlers...)
+}
+
+func (r *Router) HEAD(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodHead, path, handlers...)
+}
+
+// Handle регистрирует обработчики. Для шаблонов с параметром в последнем сегменте цепочка обработчиков
+// выполняется внутри общего обработчика шаблона, пока ее не прервут через Abort.
+func (r *Router) Handle(method string, path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	base, param, customMethod, ok := splitColonPattern(path)
+	if !ok {
+		r.IRouter.Handle(method, path, handlers...)
+
+		return r
+	}
+
+	key := method + " " + base
+
+	rt, exists := r.routes[key]
+	if !exists {
+		rt = custommethod.NewRoute[gin.HandlersChain](param)
+		r.routes[key] = rt
+
+		r.IRouter.Handle(method, base, func(c *gin.Context) {
+			chain, value, ok := rt.Match(c.Param(rt.Param))
+			if ok {
+				setGinParam(c, rt.Param, value)
+			}
+
+			if chain == nil {
+				// статус без тела, как у gin для NoRoute: заголовок отправит gin после цепочки,
+				// и middleware еще могут ответить своим телом
+				c.Status(http.StatusNotFound)
+				c.Abort()
+
+				return
+			}
+
+			for _, handler := range chain {
+				handler(c)
+
+				if c.IsAborted() {
+					return
+				}
+			}
+		})
+	}
+
+	rt.Add(customMethod, handlers)
+
+	return r
+}
+
+func setGinParam(c *gin.Context, name string, value string) {
+	for i := range c.Params {
+		if c.Params[i].Key == name {
+			c.Params[i].Value = value
+		}
+	}
+}
+
+// splitColonPattern разбирает шаблон, последний сегмент которого - параметр ":id" или ":id:method".
+func splitColonPattern(pattern string) (base string, param string, method string, ok bool) {
+	i := strings.LastIndexByte(pattern, '/')
+	segment := pattern[i+1:]
+
+	rest, ok := strings.CutPrefix(segment, ":")
+	if !ok {
+		return "", "", "", false
+	}
+
+	param, method, _ = strings.Cut(rest, ":")
+	if param == "" {
+		return "", "", "", false
+	}
+
+	return pattern[:i+1] + ":" + param, param, method, true
+}
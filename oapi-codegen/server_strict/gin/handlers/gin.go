@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"shared/errcatalog"
+)
+
+// GinParameterError - GinServerOptions.ErrorHandler: ошибка разбора параметров запроса в сгенерированной обертке.
+func (h *Handlers) GinParameterError(c *gin.Context, err error, statusCode int) {
+	h.writeGinEntry(c, err, errcatalog.InvalidParameter)
+}
+
+// Gin - middleware gin, отвечающий ErrorResponse, если цепочка завершилась статусом ошибки без тела.
+// Так strict-обертка сообщает об ошибках разбора тела (400) и обработчика (500), а gin - о ненайденном
+// маршруте (NoRoute, NoMethod при HandleMethodNotAllowed).
+func (h *Handlers) Gin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Writer.Written() {
+			return
+		}
+
+		var err error
+		if last := c.Errors.Last(); last != nil {
+			err = last.Err
+		}
+
+		switch status := c.Writer.Status(); {
+		case status == http.StatusBadRequest && err != nil:
+			h.writeGinEntry(c, fmt.Errorf("decode request body: %w", err), errcatalog.MalformedBody)
+		case status == http.StatusNotFound:
+			h.writeGinEntry(c, nil, errcatalog.RouteNotFound)
+		case status == http.StatusMethodNotAllowed:
+			h.writeGinEntry(c, nil, errcatalog.MethodNotAllowed)
+		case status >= http.StatusInternalServerError:
+			h.writeGinEntry(c, err, errcatalog.Internal)
+		}
+	}
+}
+
+func (h *Handlers) writeGinEntry(c *gin.Context, err error, entry errcatalog.Entry) {
+	c.JSON(entry.Status, h.errorResponse(c.Request.Context(), err, entry))
+}
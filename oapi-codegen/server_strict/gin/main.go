@@ -1,26 +1,201 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"server/adapter"
 	api "server/generated"
 	"server/handlers"
-	"server/usecases"
+	"shared/audit"
+	"shared/idempotency"
+	"shared/incident"
+	"shared/problem"
+	"shared/repository/file"
+	"shared/repository/memory"
+	"shared/repository/sqlite"
+	"shared/usecases"
+	"shared/validation"
 )
 
 func main() {
-	useCases := usecases.New()
-	handlers := handlers.New(useCases)
+	repository, err := newRepository(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	auditLog, err := newAuditLog()
+	if err != nil {
+		panic(err)
+	}
+
+	ttl, err := idempotencyTTL()
+	if err != nil {
+		panic(err)
+	}
+
+	mux, err := newHandler(repository, auditLog, ttl)
+	if err != nil {
+		panic(err)
+	}
+
+	err = http.ListenAndServe(listenAddr(), mux)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// newHandler собирает сервер целиком: обработчики, роутер и middleware. Тесты поднимают его же через httptest.
+func newHandler(repository usecases.Repository, auditLog usecases.AuditLog, ttl time.Duration) (http.Handler, error) {
+	incidents := incident.New(incident.NewLogSink(os.Stderr))
+	useCases := usecases.New(repository, auditLog, incidents)
+	handlers := handlers.New(useCases, incidents)
+	idempotencyStore := idempotency.NewStore(ttl, idempotencyMaxKeys)
+
+	validator, err := newValidator()
+	if err != nil {
+		return nil, err
+	}
 
 	strictMux := api.NewStrictHandler(handlers, nil)
 
 	mux := gin.New()
-	api.RegisterHandlers(mux, strictMux)
+	// без этого ctx обработчика (*gin.Context) не видит значения из контекста запроса, например отладочный режим
+	mux.ContextWithFallback = true
+	mux.HandleMethodNotAllowed = true
+	mux.Use(adapter.Idempotency(idempotencyStore, idempotentRoutes...))
+	mux.Use(handlers.Gin())
+	api.RegisterHandlersWithOptions(adapter.NewRouter(mux), strictMux, api.GinServerOptions{
+		ErrorHandler: handlers.GinParameterError,
+	})
 
-	err := http.ListenAndServe(":8080", mux)
+	responseLog := slog.New(slog.NewJSONHandler(os.Stderr, nil))
+
+	return problem.Middleware(incident.Middleware(debugToken())(validator.LogResponses(responseLog)(
+		validator.Middleware(handlers.RequestError)(mux),
+	))), nil
+}
+
+// newValidator - проверка запросов по спецификации, встроенной в сгенерированный код.
+func newValidator() (*validation.Validator, error) {
+	spec, err := api.GetSwagger()
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("load embedded spec: %w", err)
+	}
+
+	return validation.New(spec)
+}
+
+// debugToken - токен операторов из DEBUG_TOKEN. Запросы с ним в заголовке X-Debug-Token получают текст
+// внутренних ошибок, их изменения попадают в журнал от имени оператора, а ответы проверяются по спецификации
+// с записью нарушений в stderr; без DEBUG_TOKEN отладочный режим выключен.
+func debugToken() string {
+	return os.Getenv("DEBUG_TOKEN")
+}
+
+// defaultAddr - адрес сервера, если ADDR не задан
+const defaultAddr = ":8080"
+
+// listenAddr - адрес, на котором слушает сервер, из ADDR (например, "127.0.0.1:9000").
+func listenAddr() string {
+	addr := os.Getenv("ADDR")
+	if addr == "" {
+		return defaultAddr
+	}
+
+	return addr
+}
+
+// defaultIdempotencyTTL - сколько хранятся ответы по ключам идемпотентности, если IDEMPOTENCY_TTL не задан
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// idempotencyMaxKeys - сколько ключей идемпотентности хранится одновременно; новый ключ вытесняет самый старый
+const idempotencyMaxKeys = 100_000
+
+// idempotentRoutes - операции, для которых в спецификации описан заголовок Idempotency-Key
+var idempotentRoutes = []idempotency.Route{{Method: http.MethodPost, Path: "/users"}}
+
+// idempotencyTTL читает время жизни ключей идемпотентности из IDEMPOTENCY_TTL (например, "1h30m").
+func idempotencyTTL() (time.Duration, error) {
+	value := os.Getenv("IDEMPOTENCY_TTL")
+	if value == "" {
+		return defaultIdempotencyTTL, nil
+	}
+
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("parse IDEMPOTENCY_TTL: %w", err)
+	}
+
+	if ttl <= 0 {
+		return 0, fmt.Errorf("IDEMPOTENCY_TTL must be positive, got %s", ttl)
+	}
+
+	return ttl, nil
+}
+
+// fileCompactThreshold - размер журнала file-хранилища, после которого он сворачивается в снапшот
+const fileCompactThreshold = 1 << 20
+
+// newRepository выбирает хранилище по переменной окружения STORAGE: memory (по умолчанию), sqlite или file.
+// Для sqlite путь к базе берется из SQLITE_DSN, для file директория с данными - из FILE_DIR.
+func newRepository(ctx context.Context) (usecases.Repository, error) {
+	storage := os.Getenv("STORAGE")
+
+	switch storage {
+	case "", "memory":
+		return memory.New(), nil
+	case "sqlite":
+		dsn := os.Getenv("SQLITE_DSN")
+		if dsn == "" {
+			dsn = "users.db"
+		}
+
+		repository, err := sqlite.New(ctx, dsn)
+		if err != nil {
+			return nil, err
+		}
+
+		return repository, nil
+	case "file":
+		dir := os.Getenv("FILE_DIR")
+		if dir == "" {
+			dir = "data"
+		}
+
+		repository, err := file.New(dir, fileCompactThreshold, slog.New(slog.NewJSONHandler(os.Stderr, nil)))
+		if err != nil {
+			return nil, err
+		}
+
+		return repository, nil
+	default:
+		return nil, fmt.Errorf("unknown STORAGE %q", storage)
+	}
+}
+
+// newAuditLog открывает журнал изменений из файла AUDIT_FILE (контрольная точка - рядом, в AUDIT_FILE.head);
+// если он не задан, журнал хранится только в памяти.
+// Переписанный, удаленный или отрезанный с конца журнал не дает серверу стартовать. Журнал должен жить столько же,
+// сколько пользователи: AUDIT_FILE обязателен для STORAGE=sqlite и file и запрещен для хранилища в памяти.
+func newAuditLog() (usecases.AuditLog, error) {
+	storage := os.Getenv("STORAGE")
+	path := os.Getenv("AUDIT_FILE")
+
+	switch {
+	case (storage == "" || storage == "memory") && path != "":
+		return nil, fmt.Errorf("AUDIT_FILE is set, but users are stored in memory (STORAGE=%q): the audit log would outlive them", storage)
+	case (storage == "sqlite" || storage == "file") && path == "":
+		return nil, fmt.Errorf("STORAGE=%s requires AUDIT_FILE: the audit log in memory would be lost on restart", storage)
+	case path == "":
+		return audit.New(), nil
+	default:
+		return audit.Open(path)
 	}
 }
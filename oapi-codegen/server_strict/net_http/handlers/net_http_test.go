@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "server/generated"
+	"shared/custommethod"
+)
+
+func TestHandlers_NetHTTPErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		body           string
+		wantStatusCode int
+		wantCode       api.ErrorResponseCode
+	}{
+		{
+			name:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/users/abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=many",
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeInvalidParameter,
+		},
+		{
+			name:           "malformed body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantCode:       api.ErrorResponseCodeMalformedBody,
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodGet,
+			target:         "/accounts",
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       api.ErrorResponseCodeRouteNotFound,
+		},
+		{
+			name:           "unknown custom method",
+			method:         http.MethodPost,
+			target:         "/users/1:archive",
+			wantStatusCode: http.StatusNotFound,
+			wantCode:       api.ErrorResponseCodeRouteNotFound,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPut,
+			target:         "/users",
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantCode:       api.ErrorResponseCodeMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(NewMockUseCases(t), nil)
+
+			router := custommethod.NewServeMux()
+			router.NotFound = http.HandlerFunc(h.NotFound)
+			router.MethodNotAllowed = http.HandlerFunc(h.MethodNotAllowed)
+
+			strictHandler := api.NewStrictHandlerWithOptions(h, nil, api.StrictHTTPServerOptions{
+				RequestErrorHandlerFunc:  h.BodyError,
+				ResponseErrorHandlerFunc: h.ResponseError,
+			})
+
+			mux := api.HandlerWithOptions(strictHandler, api.StdHTTPServerOptions{
+				BaseRouter:       router,
+				ErrorHandlerFunc: h.ParameterError,
+			})
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rr := httptest.NewRecorder()
+
+			mux.ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			var got api.ErrorResponse
+
+			err := json.Unmarshal(rr.Body.Bytes(), &got)
+			if err != nil {
+				t.Fatalf("failed to unmarshal response body: %v; raw: %q", err, rr.Body.String())
+			}
+
+			if got.Code != tt.wantCode || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantCode)
+			}
+		})
+	}
+}
+
+// checkResponse записывает ответ strict-обработчика операции operationID так же, как сгенерированная обертка,
+// и проверяет его по спецификации (validateResponse). nil - обработчик вернул ошибку, проверять нечего.
+func checkResponse(t *testing.T, operationID string, response any) {
+	t.Helper()
+
+	if response == nil {
+		return
+	}
+
+	visit := reflect.ValueOf(response).MethodByName("Visit" + operationID + "Response")
+	if !visit.IsValid() {
+		t.Fatalf("%T is not a response of %s", response, operationID)
+	}
+
+	rr := httptest.NewRecorder()
+
+	err, _ := visit.Call([]reflect.Value{reflect.ValueOf(rr)})[0].Interface().(error)
+	if err != nil {
+		t.Fatalf("Visit%sResponse() error = %v", operationID, err)
+	}
+
+	validateResponse(t, operationID, rr.Code, rr.Header(), rr.Body.Bytes())
+}
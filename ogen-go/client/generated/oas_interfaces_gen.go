@@ -5,6 +5,34 @@ type CreateUserRes interface {
 	createUserRes()
 }
 
+type CreateUsersBatchRes interface {
+	createUsersBatchRes()
+}
+
+type DeleteUserRes interface {
+	deleteUserRes()
+}
+
 type GetUserByIdRes interface {
 	getUserByIdRes()
 }
+
+type GetUserHistoryRes interface {
+	getUserHistoryRes()
+}
+
+type ListUsersRes interface {
+	listUsersRes()
+}
+
+type PatchUserRes interface {
+	patchUserRes()
+}
+
+type RestoreUserRes interface {
+	restoreUserRes()
+}
+
+type UpdateUserRes interface {
+	updateUserRes()
+}
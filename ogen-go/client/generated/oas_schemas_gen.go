@@ -2,16 +2,63 @@
 
 package api
 
-type CreateUserBadRequest ErrorResponse
+import (
+	"time"
 
-func (*CreateUserBadRequest) createUserRes() {}
+	"github.com/go-faster/errors"
+)
 
-type CreateUserInternalServerError ErrorResponse
+type CreateUserApplicationJSONBadRequest ErrorResponse
 
-func (*CreateUserInternalServerError) createUserRes() {}
+func (*CreateUserApplicationJSONBadRequest) createUserRes() {}
+
+type CreateUserApplicationJSONConflict ErrorResponse
+
+func (*CreateUserApplicationJSONConflict) createUserRes() {}
+
+type CreateUserApplicationJSONInternalServerError ErrorResponse
+
+func (*CreateUserApplicationJSONInternalServerError) createUserRes() {}
+
+type CreateUserApplicationJSONNotAcceptable ErrorResponse
+
+func (*CreateUserApplicationJSONNotAcceptable) createUserRes() {}
+
+type CreateUserApplicationJSONUnprocessableEntity ErrorResponse
+
+func (*CreateUserApplicationJSONUnprocessableEntity) createUserRes() {}
+
+type CreateUserApplicationJSONUnsupportedMediaType ErrorResponse
+
+func (*CreateUserApplicationJSONUnsupportedMediaType) createUserRes() {}
+
+type CreateUserApplicationProblemJSONBadRequest ProblemDetails
+
+func (*CreateUserApplicationProblemJSONBadRequest) createUserRes() {}
+
+type CreateUserApplicationProblemJSONConflict ProblemDetails
+
+func (*CreateUserApplicationProblemJSONConflict) createUserRes() {}
+
+type CreateUserApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*CreateUserApplicationProblemJSONInternalServerError) createUserRes() {}
+
+type CreateUserApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*CreateUserApplicationProblemJSONNotAcceptable) createUserRes() {}
+
+type CreateUserApplicationProblemJSONUnprocessableEntity ProblemDetails
+
+func (*CreateUserApplicationProblemJSONUnprocessableEntity) createUserRes() {}
+
+type CreateUserApplicationProblemJSONUnsupportedMediaType ProblemDetails
+
+func (*CreateUserApplicationProblemJSONUnsupportedMediaType) createUserRes() {}
 
 // Ref: #/components/schemas/CreateUserRequest
 type CreateUserRequest struct {
+	// Unique among all users, including deleted ones.
 	Name string `json:"name"`
 }
 
@@ -42,10 +89,201 @@ func (s *CreateUserResponse) SetID(val int) {
 
 func (*CreateUserResponse) createUserRes() {}
 
+type CreateUsersBatchApplicationJSONBadRequest ErrorResponse
+
+func (*CreateUsersBatchApplicationJSONBadRequest) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationJSONConflict ErrorResponse
+
+func (*CreateUsersBatchApplicationJSONConflict) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationJSONInternalServerError ErrorResponse
+
+func (*CreateUsersBatchApplicationJSONInternalServerError) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationJSONNotAcceptable ErrorResponse
+
+func (*CreateUsersBatchApplicationJSONNotAcceptable) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationJSONUnsupportedMediaType ErrorResponse
+
+func (*CreateUsersBatchApplicationJSONUnsupportedMediaType) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationProblemJSONBadRequest ProblemDetails
+
+func (*CreateUsersBatchApplicationProblemJSONBadRequest) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationProblemJSONConflict ProblemDetails
+
+func (*CreateUsersBatchApplicationProblemJSONConflict) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*CreateUsersBatchApplicationProblemJSONInternalServerError) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*CreateUsersBatchApplicationProblemJSONNotAcceptable) createUsersBatchRes() {}
+
+type CreateUsersBatchApplicationProblemJSONUnsupportedMediaType ProblemDetails
+
+func (*CreateUsersBatchApplicationProblemJSONUnsupportedMediaType) createUsersBatchRes() {}
+
+type CreateUsersBatchOK CreateUsersBatchResponse
+
+func (*CreateUsersBatchOK) createUsersBatchRes() {}
+
+// Ref: #/components/schemas/CreateUsersBatchRequest
+type CreateUsersBatchRequest struct {
+	Items        []CreateUserRequest `json:"items"`
+	AllOrNothing OptBool             `json:"allOrNothing"`
+}
+
+// GetItems returns the value of Items.
+func (s *CreateUsersBatchRequest) GetItems() []CreateUserRequest {
+	return s.Items
+}
+
+// GetAllOrNothing returns the value of AllOrNothing.
+func (s *CreateUsersBatchRequest) GetAllOrNothing() OptBool {
+	return s.AllOrNothing
+}
+
+// SetItems sets the value of Items.
+func (s *CreateUsersBatchRequest) SetItems(val []CreateUserRequest) {
+	s.Items = val
+}
+
+// SetAllOrNothing sets the value of AllOrNothing.
+func (s *CreateUsersBatchRequest) SetAllOrNothing(val OptBool) {
+	s.AllOrNothing = val
+}
+
+// Ref: #/components/schemas/CreateUsersBatchResponse
+type CreateUsersBatchResponse struct {
+	// Results in the same order as request items.
+	Results []CreateUsersBatchResult `json:"results"`
+}
+
+// GetResults returns the value of Results.
+func (s *CreateUsersBatchResponse) GetResults() []CreateUsersBatchResult {
+	return s.Results
+}
+
+// SetResults sets the value of Results.
+func (s *CreateUsersBatchResponse) SetResults(val []CreateUsersBatchResult) {
+	s.Results = val
+}
+
+// Either id of the created user or error.
+// Ref: #/components/schemas/CreateUsersBatchResult
+type CreateUsersBatchResult struct {
+	ID    OptInt           `json:"id"`
+	Error OptErrorResponse `json:"error"`
+}
+
+// GetID returns the value of ID.
+func (s *CreateUsersBatchResult) GetID() OptInt {
+	return s.ID
+}
+
+// GetError returns the value of Error.
+func (s *CreateUsersBatchResult) GetError() OptErrorResponse {
+	return s.Error
+}
+
+// SetID sets the value of ID.
+func (s *CreateUsersBatchResult) SetID(val OptInt) {
+	s.ID = val
+}
+
+// SetError sets the value of Error.
+func (s *CreateUsersBatchResult) SetError(val OptErrorResponse) {
+	s.Error = val
+}
+
+type CreateUsersBatchUnprocessableEntity CreateUsersBatchResponse
+
+func (*CreateUsersBatchUnprocessableEntity) createUsersBatchRes() {}
+
+type DeleteUserApplicationJSONBadRequest ErrorResponse
+
+func (*DeleteUserApplicationJSONBadRequest) deleteUserRes() {}
+
+type DeleteUserApplicationJSONGone ErrorResponse
+
+func (*DeleteUserApplicationJSONGone) deleteUserRes() {}
+
+type DeleteUserApplicationJSONInternalServerError ErrorResponse
+
+func (*DeleteUserApplicationJSONInternalServerError) deleteUserRes() {}
+
+type DeleteUserApplicationJSONNotAcceptable ErrorResponse
+
+func (*DeleteUserApplicationJSONNotAcceptable) deleteUserRes() {}
+
+type DeleteUserApplicationJSONNotFound ErrorResponse
+
+func (*DeleteUserApplicationJSONNotFound) deleteUserRes() {}
+
+type DeleteUserApplicationProblemJSONBadRequest ProblemDetails
+
+func (*DeleteUserApplicationProblemJSONBadRequest) deleteUserRes() {}
+
+type DeleteUserApplicationProblemJSONGone ProblemDetails
+
+func (*DeleteUserApplicationProblemJSONGone) deleteUserRes() {}
+
+type DeleteUserApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*DeleteUserApplicationProblemJSONInternalServerError) deleteUserRes() {}
+
+type DeleteUserApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*DeleteUserApplicationProblemJSONNotAcceptable) deleteUserRes() {}
+
+type DeleteUserApplicationProblemJSONNotFound ProblemDetails
+
+func (*DeleteUserApplicationProblemJSONNotFound) deleteUserRes() {}
+
+// DeleteUserNoContent is response for DeleteUser operation.
+type DeleteUserNoContent struct{}
+
+func (*DeleteUserNoContent) deleteUserRes() {}
+
 // Ref: #/components/schemas/ErrorResponse
 type ErrorResponse struct {
 	Error string `json:"error"`
-	Code  int    `json:"code"`
+	// Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was
+	// rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being
+	// processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted
+	// one
+	// * `-1` Internal (HTTP 500) - unexpected error.
+	Code ErrorResponseCode `json:"code"`
+	// Field-level violations; set only for validation errors (code 3).
+	Details []ValidationErrorDetail `json:"details"`
+	// Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id.
+	IncidentID OptString `json:"incident_id"`
+	// Underlying error message of an internal error; set only in debug mode (X-Debug-Token header).
+	DebugMessage OptString `json:"debug_message"`
 }
 
 // GetError returns the value of Error.
@@ -54,32 +292,185 @@ func (s *ErrorResponse) GetError() string {
 }
 
 // GetCode returns the value of Code.
-func (s *ErrorResponse) GetCode() int {
+func (s *ErrorResponse) GetCode() ErrorResponseCode {
 	return s.Code
 }
 
+// GetDetails returns the value of Details.
+func (s *ErrorResponse) GetDetails() []ValidationErrorDetail {
+	return s.Details
+}
+
+// GetIncidentID returns the value of IncidentID.
+func (s *ErrorResponse) GetIncidentID() OptString {
+	return s.IncidentID
+}
+
+// GetDebugMessage returns the value of DebugMessage.
+func (s *ErrorResponse) GetDebugMessage() OptString {
+	return s.DebugMessage
+}
+
 // SetError sets the value of Error.
 func (s *ErrorResponse) SetError(val string) {
 	s.Error = val
 }
 
 // SetCode sets the value of Code.
-func (s *ErrorResponse) SetCode(val int) {
+func (s *ErrorResponse) SetCode(val ErrorResponseCode) {
 	s.Code = val
 }
 
-type GetUserByIdInternalServerError ErrorResponse
+// SetDetails sets the value of Details.
+func (s *ErrorResponse) SetDetails(val []ValidationErrorDetail) {
+	s.Details = val
+}
+
+// SetIncidentID sets the value of IncidentID.
+func (s *ErrorResponse) SetIncidentID(val OptString) {
+	s.IncidentID = val
+}
+
+// SetDebugMessage sets the value of DebugMessage.
+func (s *ErrorResponse) SetDebugMessage(val OptString) {
+	s.DebugMessage = val
+}
+
+// Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+// * `404` NotFound (HTTP 404) - user does not exist
+// * `410` Gone (HTTP 410) - user is deleted and can be restored
+// * `1` NotPublic1 (HTTP 500) - internal error 1
+// * `2` NotPublic2 (HTTP 500) - internal error 2
+// * `3` Validation (HTTP 400) - request validation failed, see details
+// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was
+// rolled back
+// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being
+// processed
+// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted
+// one
+// * `-1` Internal (HTTP 500) - unexpected error.
+type ErrorResponseCode int
+
+const (
+	ErrorResponseCode404    ErrorResponseCode = 404
+	ErrorResponseCode410    ErrorResponseCode = 410
+	ErrorResponseCode1      ErrorResponseCode = 1
+	ErrorResponseCode2      ErrorResponseCode = 2
+	ErrorResponseCode3      ErrorResponseCode = 3
+	ErrorResponseCode4      ErrorResponseCode = 4
+	ErrorResponseCode5      ErrorResponseCode = 5
+	ErrorResponseCode6      ErrorResponseCode = 6
+	ErrorResponseCode7      ErrorResponseCode = 7
+	ErrorResponseCode8      ErrorResponseCode = 8
+	ErrorResponseCode9      ErrorResponseCode = 9
+	ErrorResponseCode10     ErrorResponseCode = 10
+	ErrorResponseCode11     ErrorResponseCode = 11
+	ErrorResponseCode12     ErrorResponseCode = 12
+	ErrorResponseCode13     ErrorResponseCode = 13
+	ErrorResponseCode14     ErrorResponseCode = 14
+	ErrorResponseCode15     ErrorResponseCode = 15
+	ErrorResponseCode16     ErrorResponseCode = 16
+	ErrorResponseCodeMinus1 ErrorResponseCode = -1
+)
+
+// AllValues returns all ErrorResponseCode values.
+func (ErrorResponseCode) AllValues() []ErrorResponseCode {
+	return []ErrorResponseCode{
+		ErrorResponseCode404,
+		ErrorResponseCode410,
+		ErrorResponseCode1,
+		ErrorResponseCode2,
+		ErrorResponseCode3,
+		ErrorResponseCode4,
+		ErrorResponseCode5,
+		ErrorResponseCode6,
+		ErrorResponseCode7,
+		ErrorResponseCode8,
+		ErrorResponseCode9,
+		ErrorResponseCode10,
+		ErrorResponseCode11,
+		ErrorResponseCode12,
+		ErrorResponseCode13,
+		ErrorResponseCode14,
+		ErrorResponseCode15,
+		ErrorResponseCode16,
+		ErrorResponseCodeMinus1,
+	}
+}
+
+type GetUserByIdApplicationJSONBadRequest ErrorResponse
+
+func (*GetUserByIdApplicationJSONBadRequest) getUserByIdRes() {}
+
+type GetUserByIdApplicationJSONGone ErrorResponse
+
+func (*GetUserByIdApplicationJSONGone) getUserByIdRes() {}
+
+type GetUserByIdApplicationJSONInternalServerError ErrorResponse
 
-func (*GetUserByIdInternalServerError) getUserByIdRes() {}
+func (*GetUserByIdApplicationJSONInternalServerError) getUserByIdRes() {}
 
-type GetUserByIdNotFound ErrorResponse
+type GetUserByIdApplicationJSONNotAcceptable ErrorResponse
 
-func (*GetUserByIdNotFound) getUserByIdRes() {}
+func (*GetUserByIdApplicationJSONNotAcceptable) getUserByIdRes() {}
+
+type GetUserByIdApplicationJSONNotFound ErrorResponse
+
+func (*GetUserByIdApplicationJSONNotFound) getUserByIdRes() {}
+
+type GetUserByIdApplicationProblemJSONBadRequest ProblemDetails
+
+func (*GetUserByIdApplicationProblemJSONBadRequest) getUserByIdRes() {}
+
+type GetUserByIdApplicationProblemJSONGone ProblemDetails
+
+func (*GetUserByIdApplicationProblemJSONGone) getUserByIdRes() {}
+
+type GetUserByIdApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*GetUserByIdApplicationProblemJSONInternalServerError) getUserByIdRes() {}
+
+type GetUserByIdApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*GetUserByIdApplicationProblemJSONNotAcceptable) getUserByIdRes() {}
+
+type GetUserByIdApplicationProblemJSONNotFound ProblemDetails
+
+func (*GetUserByIdApplicationProblemJSONNotFound) getUserByIdRes() {}
+
+// GetUserByIdNotModified is response for GetUserById operation.
+type GetUserByIdNotModified struct {
+	ETag string
+}
+
+// GetETag returns the value of ETag.
+func (s *GetUserByIdNotModified) GetETag() string {
+	return s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *GetUserByIdNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+func (*GetUserByIdNotModified) getUserByIdRes() {}
 
 // Ref: #/components/schemas/GetUserByIdResponse
 type GetUserByIdResponse struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
+	// Set only for deleted users, which ListUsers returns with include_deleted.
+	DeletedAt OptDateTime `json:"deleted_at"`
 }
 
 // GetID returns the value of ID.
@@ -92,6 +483,11 @@ func (s *GetUserByIdResponse) GetName() string {
 	return s.Name
 }
 
+// GetDeletedAt returns the value of DeletedAt.
+func (s *GetUserByIdResponse) GetDeletedAt() OptDateTime {
+	return s.DeletedAt
+}
+
 // SetID sets the value of ID.
 func (s *GetUserByIdResponse) SetID(val int) {
 	s.ID = val
@@ -102,4 +498,1009 @@ func (s *GetUserByIdResponse) SetName(val string) {
 	s.Name = val
 }
 
-func (*GetUserByIdResponse) getUserByIdRes() {}
+// SetDeletedAt sets the value of DeletedAt.
+func (s *GetUserByIdResponse) SetDeletedAt(val OptDateTime) {
+	s.DeletedAt = val
+}
+
+// GetUserByIdResponseHeaders wraps GetUserByIdResponse with response headers.
+type GetUserByIdResponseHeaders struct {
+	ETag     string
+	Response GetUserByIdResponse
+}
+
+// GetETag returns the value of ETag.
+func (s *GetUserByIdResponseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *GetUserByIdResponseHeaders) GetResponse() GetUserByIdResponse {
+	return s.Response
+}
+
+// SetETag sets the value of ETag.
+func (s *GetUserByIdResponseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetUserByIdResponseHeaders) SetResponse(val GetUserByIdResponse) {
+	s.Response = val
+}
+
+func (*GetUserByIdResponseHeaders) getUserByIdRes() {}
+func (*GetUserByIdResponseHeaders) patchUserRes()   {}
+func (*GetUserByIdResponseHeaders) restoreUserRes() {}
+func (*GetUserByIdResponseHeaders) updateUserRes()  {}
+
+type GetUserHistoryApplicationJSONBadRequest ErrorResponse
+
+func (*GetUserHistoryApplicationJSONBadRequest) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationJSONInternalServerError ErrorResponse
+
+func (*GetUserHistoryApplicationJSONInternalServerError) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationJSONNotAcceptable ErrorResponse
+
+func (*GetUserHistoryApplicationJSONNotAcceptable) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationJSONNotFound ErrorResponse
+
+func (*GetUserHistoryApplicationJSONNotFound) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationProblemJSONBadRequest ProblemDetails
+
+func (*GetUserHistoryApplicationProblemJSONBadRequest) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*GetUserHistoryApplicationProblemJSONInternalServerError) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*GetUserHistoryApplicationProblemJSONNotAcceptable) getUserHistoryRes() {}
+
+type GetUserHistoryApplicationProblemJSONNotFound ProblemDetails
+
+func (*GetUserHistoryApplicationProblemJSONNotFound) getUserHistoryRes() {}
+
+type ListUsersApplicationJSONBadRequest ErrorResponse
+
+func (*ListUsersApplicationJSONBadRequest) listUsersRes() {}
+
+type ListUsersApplicationJSONForbidden ErrorResponse
+
+func (*ListUsersApplicationJSONForbidden) listUsersRes() {}
+
+type ListUsersApplicationJSONInternalServerError ErrorResponse
+
+func (*ListUsersApplicationJSONInternalServerError) listUsersRes() {}
+
+type ListUsersApplicationJSONNotAcceptable ErrorResponse
+
+func (*ListUsersApplicationJSONNotAcceptable) listUsersRes() {}
+
+type ListUsersApplicationProblemJSONBadRequest ProblemDetails
+
+func (*ListUsersApplicationProblemJSONBadRequest) listUsersRes() {}
+
+type ListUsersApplicationProblemJSONForbidden ProblemDetails
+
+func (*ListUsersApplicationProblemJSONForbidden) listUsersRes() {}
+
+type ListUsersApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*ListUsersApplicationProblemJSONInternalServerError) listUsersRes() {}
+
+type ListUsersApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*ListUsersApplicationProblemJSONNotAcceptable) listUsersRes() {}
+
+// Ref: #/components/schemas/ListUsersResponse
+type ListUsersResponse struct {
+	Items []GetUserByIdResponse `json:"items"`
+	// Cursor of the next page; absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *ListUsersResponse) GetItems() []GetUserByIdResponse {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListUsersResponse) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *ListUsersResponse) SetItems(val []GetUserByIdResponse) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListUsersResponse) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+func (*ListUsersResponse) listUsersRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptErrorResponse returns new OptErrorResponse with value set to v.
+func NewOptErrorResponse(v ErrorResponse) OptErrorResponse {
+	return OptErrorResponse{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptErrorResponse is optional ErrorResponse.
+type OptErrorResponse struct {
+	Value ErrorResponse
+	Set   bool
+}
+
+// IsSet returns true if OptErrorResponse was set.
+func (o OptErrorResponse) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptErrorResponse) Reset() {
+	var v ErrorResponse
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptErrorResponse) SetTo(v ErrorResponse) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptErrorResponse) Get() (v ErrorResponse, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptErrorResponse) Or(d ErrorResponse) ErrorResponse {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+type PatchUserApplicationJSONBadRequest ErrorResponse
+
+func (*PatchUserApplicationJSONBadRequest) patchUserRes() {}
+
+type PatchUserApplicationJSONConflict ErrorResponse
+
+func (*PatchUserApplicationJSONConflict) patchUserRes() {}
+
+type PatchUserApplicationJSONGone ErrorResponse
+
+func (*PatchUserApplicationJSONGone) patchUserRes() {}
+
+type PatchUserApplicationJSONInternalServerError ErrorResponse
+
+func (*PatchUserApplicationJSONInternalServerError) patchUserRes() {}
+
+type PatchUserApplicationJSONNotAcceptable ErrorResponse
+
+func (*PatchUserApplicationJSONNotAcceptable) patchUserRes() {}
+
+type PatchUserApplicationJSONNotFound ErrorResponse
+
+func (*PatchUserApplicationJSONNotFound) patchUserRes() {}
+
+type PatchUserApplicationJSONPreconditionFailed ErrorResponse
+
+func (*PatchUserApplicationJSONPreconditionFailed) patchUserRes() {}
+
+type PatchUserApplicationJSONUnsupportedMediaType ErrorResponse
+
+func (*PatchUserApplicationJSONUnsupportedMediaType) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONBadRequest ProblemDetails
+
+func (*PatchUserApplicationProblemJSONBadRequest) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONConflict ProblemDetails
+
+func (*PatchUserApplicationProblemJSONConflict) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONGone ProblemDetails
+
+func (*PatchUserApplicationProblemJSONGone) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*PatchUserApplicationProblemJSONInternalServerError) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*PatchUserApplicationProblemJSONNotAcceptable) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONNotFound ProblemDetails
+
+func (*PatchUserApplicationProblemJSONNotFound) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONPreconditionFailed ProblemDetails
+
+func (*PatchUserApplicationProblemJSONPreconditionFailed) patchUserRes() {}
+
+type PatchUserApplicationProblemJSONUnsupportedMediaType ProblemDetails
+
+func (*PatchUserApplicationProblemJSONUnsupportedMediaType) patchUserRes() {}
+
+// Ref: #/components/schemas/PatchUserRequest
+type PatchUserRequest struct {
+	// Unique among all users, including deleted ones.
+	Name OptString `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *PatchUserRequest) GetName() OptString {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *PatchUserRequest) SetName(val OptString) {
+	s.Name = val
+}
+
+// RFC 7807 problem details. Returned instead of ErrorResponse when the client prefers
+// application/problem+json in Accept.
+// Ref: #/components/schemas/ProblemDetails
+type ProblemDetails struct {
+	// Problem type URI; about:blank when the status code says it all.
+	Type string `json:"type"`
+	// Short summary of the problem type.
+	Title string `json:"title"`
+	// HTTP status code.
+	Status int `json:"status"`
+	// Explanation of this occurrence of the problem; error of ErrorResponse.
+	Detail OptString `json:"detail"`
+	// Path of the request that caused the problem.
+	Instance OptString `json:"instance"`
+	// Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+	// * `404` NotFound (HTTP 404) - user does not exist
+	// * `410` Gone (HTTP 410) - user is deleted and can be restored
+	// * `1` NotPublic1 (HTTP 500) - internal error 1
+	// * `2` NotPublic2 (HTTP 500) - internal error 2
+	// * `3` Validation (HTTP 400) - request validation failed, see details
+	// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+	// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was
+	// rolled back
+	// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+	// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being
+	// processed
+	// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+	// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+	// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+	// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+	// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+	// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+	// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+	// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+	// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted
+	// one
+	// * `-1` Internal (HTTP 500) - unexpected error.
+	Code ProblemDetailsCode `json:"code"`
+	// Field-level violations; set only for validation errors (code 3).
+	Details []ValidationErrorDetail `json:"details"`
+	// Set for internal errors (HTTP 5xx); the full error is recorded on the server under this id.
+	IncidentID OptString `json:"incident_id"`
+	// Underlying error message of an internal error; set only in debug mode (X-Debug-Token header).
+	DebugMessage OptString `json:"debug_message"`
+}
+
+// GetType returns the value of Type.
+func (s *ProblemDetails) GetType() string {
+	return s.Type
+}
+
+// GetTitle returns the value of Title.
+func (s *ProblemDetails) GetTitle() string {
+	return s.Title
+}
+
+// GetStatus returns the value of Status.
+func (s *ProblemDetails) GetStatus() int {
+	return s.Status
+}
+
+// GetDetail returns the value of Detail.
+func (s *ProblemDetails) GetDetail() OptString {
+	return s.Detail
+}
+
+// GetInstance returns the value of Instance.
+func (s *ProblemDetails) GetInstance() OptString {
+	return s.Instance
+}
+
+// GetCode returns the value of Code.
+func (s *ProblemDetails) GetCode() ProblemDetailsCode {
+	return s.Code
+}
+
+// GetDetails returns the value of Details.
+func (s *ProblemDetails) GetDetails() []ValidationErrorDetail {
+	return s.Details
+}
+
+// GetIncidentID returns the value of IncidentID.
+func (s *ProblemDetails) GetIncidentID() OptString {
+	return s.IncidentID
+}
+
+// GetDebugMessage returns the value of DebugMessage.
+func (s *ProblemDetails) GetDebugMessage() OptString {
+	return s.DebugMessage
+}
+
+// SetType sets the value of Type.
+func (s *ProblemDetails) SetType(val string) {
+	s.Type = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ProblemDetails) SetTitle(val string) {
+	s.Title = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ProblemDetails) SetStatus(val int) {
+	s.Status = val
+}
+
+// SetDetail sets the value of Detail.
+func (s *ProblemDetails) SetDetail(val OptString) {
+	s.Detail = val
+}
+
+// SetInstance sets the value of Instance.
+func (s *ProblemDetails) SetInstance(val OptString) {
+	s.Instance = val
+}
+
+// SetCode sets the value of Code.
+func (s *ProblemDetails) SetCode(val ProblemDetailsCode) {
+	s.Code = val
+}
+
+// SetDetails sets the value of Details.
+func (s *ProblemDetails) SetDetails(val []ValidationErrorDetail) {
+	s.Details = val
+}
+
+// SetIncidentID sets the value of IncidentID.
+func (s *ProblemDetails) SetIncidentID(val OptString) {
+	s.IncidentID = val
+}
+
+// SetDebugMessage sets the value of DebugMessage.
+func (s *ProblemDetails) SetDebugMessage(val OptString) {
+	s.DebugMessage = val
+}
+
+// Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+// * `404` NotFound (HTTP 404) - user does not exist
+// * `410` Gone (HTTP 410) - user is deleted and can be restored
+// * `1` NotPublic1 (HTTP 500) - internal error 1
+// * `2` NotPublic2 (HTTP 500) - internal error 2
+// * `3` Validation (HTTP 400) - request validation failed, see details
+// * `4` InvalidSort (HTTP 400) - unsupported sort field or direction
+// * `5` RolledBack (HTTP 422) - batch item was not created because the all-or-nothing batch was
+// rolled back
+// * `6` IdempotencyKeyMismatch (HTTP 422) - Idempotency-Key was already used with a different request
+// * `7` IdempotencyInProgress (HTTP 409) - request with the same Idempotency-Key is still being
+// processed
+// * `8` PreconditionFailed (HTTP 412) - If-Match does not match the current user version
+// * `9` InvalidParameter (HTTP 400) - path, query or header parameter is missing or malformed
+// * `10` MalformedBody (HTTP 400) - request body cannot be decoded into the operation's schema
+// * `11` RouteNotFound (HTTP 404) - no operation matches the request path
+// * `12` MethodNotAllowed (HTTP 405) - the request path does not support the request method
+// * `13` UnsupportedMediaType (HTTP 415) - request body content type is not supported
+// * `14` NotAcceptable (HTTP 406) - none of the media types in Accept can be produced
+// * `15` Forbidden (HTTP 403) - the request requires the operator token in X-Debug-Token
+// * `16` AlreadyExists (HTTP 409) - user name is already taken by another user, including a deleted
+// one
+// * `-1` Internal (HTTP 500) - unexpected error.
+type ProblemDetailsCode int
+
+const (
+	ProblemDetailsCode404    ProblemDetailsCode = 404
+	ProblemDetailsCode410    ProblemDetailsCode = 410
+	ProblemDetailsCode1      ProblemDetailsCode = 1
+	ProblemDetailsCode2      ProblemDetailsCode = 2
+	ProblemDetailsCode3      ProblemDetailsCode = 3
+	ProblemDetailsCode4      ProblemDetailsCode = 4
+	ProblemDetailsCode5      ProblemDetailsCode = 5
+	ProblemDetailsCode6      ProblemDetailsCode = 6
+	ProblemDetailsCode7      ProblemDetailsCode = 7
+	ProblemDetailsCode8      ProblemDetailsCode = 8
+	ProblemDetailsCode9      ProblemDetailsCode = 9
+	ProblemDetailsCode10     ProblemDetailsCode = 10
+	ProblemDetailsCode11     ProblemDetailsCode = 11
+	ProblemDetailsCode12     ProblemDetailsCode = 12
+	ProblemDetailsCode13     ProblemDetailsCode = 13
+	ProblemDetailsCode14     ProblemDetailsCode = 14
+	ProblemDetailsCode15     ProblemDetailsCode = 15
+	ProblemDetailsCode16     ProblemDetailsCode = 16
+	ProblemDetailsCodeMinus1 ProblemDetailsCode = -1
+)
+
+// AllValues returns all ProblemDetailsCode values.
+func (ProblemDetailsCode) AllValues() []ProblemDetailsCode {
+	return []ProblemDetailsCode{
+		ProblemDetailsCode404,
+		ProblemDetailsCode410,
+		ProblemDetailsCode1,
+		ProblemDetailsCode2,
+		ProblemDetailsCode3,
+		ProblemDetailsCode4,
+		ProblemDetailsCode5,
+		ProblemDetailsCode6,
+		ProblemDetailsCode7,
+		ProblemDetailsCode8,
+		ProblemDetailsCode9,
+		ProblemDetailsCode10,
+		ProblemDetailsCode11,
+		ProblemDetailsCode12,
+		ProblemDetailsCode13,
+		ProblemDetailsCode14,
+		ProblemDetailsCode15,
+		ProblemDetailsCode16,
+		ProblemDetailsCodeMinus1,
+	}
+}
+
+type RestoreUserApplicationJSONBadRequest ErrorResponse
+
+func (*RestoreUserApplicationJSONBadRequest) restoreUserRes() {}
+
+type RestoreUserApplicationJSONInternalServerError ErrorResponse
+
+func (*RestoreUserApplicationJSONInternalServerError) restoreUserRes() {}
+
+type RestoreUserApplicationJSONNotAcceptable ErrorResponse
+
+func (*RestoreUserApplicationJSONNotAcceptable) restoreUserRes() {}
+
+type RestoreUserApplicationJSONNotFound ErrorResponse
+
+func (*RestoreUserApplicationJSONNotFound) restoreUserRes() {}
+
+type RestoreUserApplicationProblemJSONBadRequest ProblemDetails
+
+func (*RestoreUserApplicationProblemJSONBadRequest) restoreUserRes() {}
+
+type RestoreUserApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*RestoreUserApplicationProblemJSONInternalServerError) restoreUserRes() {}
+
+type RestoreUserApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*RestoreUserApplicationProblemJSONNotAcceptable) restoreUserRes() {}
+
+type RestoreUserApplicationProblemJSONNotFound ProblemDetails
+
+func (*RestoreUserApplicationProblemJSONNotFound) restoreUserRes() {}
+
+type UpdateUserApplicationJSONBadRequest ErrorResponse
+
+func (*UpdateUserApplicationJSONBadRequest) updateUserRes() {}
+
+type UpdateUserApplicationJSONConflict ErrorResponse
+
+func (*UpdateUserApplicationJSONConflict) updateUserRes() {}
+
+type UpdateUserApplicationJSONGone ErrorResponse
+
+func (*UpdateUserApplicationJSONGone) updateUserRes() {}
+
+type UpdateUserApplicationJSONInternalServerError ErrorResponse
+
+func (*UpdateUserApplicationJSONInternalServerError) updateUserRes() {}
+
+type UpdateUserApplicationJSONNotAcceptable ErrorResponse
+
+func (*UpdateUserApplicationJSONNotAcceptable) updateUserRes() {}
+
+type UpdateUserApplicationJSONNotFound ErrorResponse
+
+func (*UpdateUserApplicationJSONNotFound) updateUserRes() {}
+
+type UpdateUserApplicationJSONPreconditionFailed ErrorResponse
+
+func (*UpdateUserApplicationJSONPreconditionFailed) updateUserRes() {}
+
+type UpdateUserApplicationJSONUnsupportedMediaType ErrorResponse
+
+func (*UpdateUserApplicationJSONUnsupportedMediaType) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONBadRequest ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONBadRequest) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONConflict ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONConflict) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONGone ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONGone) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONInternalServerError ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONInternalServerError) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONNotAcceptable ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONNotAcceptable) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONNotFound ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONNotFound) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONPreconditionFailed ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONPreconditionFailed) updateUserRes() {}
+
+type UpdateUserApplicationProblemJSONUnsupportedMediaType ProblemDetails
+
+func (*UpdateUserApplicationProblemJSONUnsupportedMediaType) updateUserRes() {}
+
+// Ref: #/components/schemas/UpdateUserRequest
+type UpdateUserRequest struct {
+	// Unique among all users, including deleted ones.
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *UpdateUserRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *UpdateUserRequest) SetName(val string) {
+	s.Name = val
+}
+
+// Ref: #/components/schemas/UserHistoryChange
+type UserHistoryChange struct {
+	Field string `json:"field"`
+	// Value before the change; empty if there was none.
+	From string `json:"from"`
+	// Value after the change; empty if there is none.
+	To string `json:"to"`
+}
+
+// GetField returns the value of Field.
+func (s *UserHistoryChange) GetField() string {
+	return s.Field
+}
+
+// GetFrom returns the value of From.
+func (s *UserHistoryChange) GetFrom() string {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *UserHistoryChange) GetTo() string {
+	return s.To
+}
+
+// SetField sets the value of Field.
+func (s *UserHistoryChange) SetField(val string) {
+	s.Field = val
+}
+
+// SetFrom sets the value of From.
+func (s *UserHistoryChange) SetFrom(val string) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *UserHistoryChange) SetTo(val string) {
+	s.To = val
+}
+
+// Ref: #/components/schemas/UserHistoryEntry
+type UserHistoryEntry struct {
+	// Position of the entry in the whole audit log, starting with 1.
+	Seq    int                    `json:"seq"`
+	Action UserHistoryEntryAction `json:"action"`
+	// "operator" for changes made with the operator token in X-Debug-Token; absent for other requests.
+	// The token is shared by all operators, so no individual principal is recorded.
+	Actor OptString `json:"actor"`
+	At    time.Time `json:"at"`
+	// Version of the user after the change.
+	Version int                 `json:"version"`
+	Changes []UserHistoryChange `json:"changes"`
+	// Hash of the previous entry of the audit log; empty for the first entry.
+	PrevHash string `json:"prev_hash"`
+	// SHA-256 of the entry including prev_hash, hex encoded.
+	Hash string `json:"hash"`
+}
+
+// GetSeq returns the value of Seq.
+func (s *UserHistoryEntry) GetSeq() int {
+	return s.Seq
+}
+
+// GetAction returns the value of Action.
+func (s *UserHistoryEntry) GetAction() UserHistoryEntryAction {
+	return s.Action
+}
+
+// GetActor returns the value of Actor.
+func (s *UserHistoryEntry) GetActor() OptString {
+	return s.Actor
+}
+
+// GetAt returns the value of At.
+func (s *UserHistoryEntry) GetAt() time.Time {
+	return s.At
+}
+
+// GetVersion returns the value of Version.
+func (s *UserHistoryEntry) GetVersion() int {
+	return s.Version
+}
+
+// GetChanges returns the value of Changes.
+func (s *UserHistoryEntry) GetChanges() []UserHistoryChange {
+	return s.Changes
+}
+
+// GetPrevHash returns the value of PrevHash.
+func (s *UserHistoryEntry) GetPrevHash() string {
+	return s.PrevHash
+}
+
+// GetHash returns the value of Hash.
+func (s *UserHistoryEntry) GetHash() string {
+	return s.Hash
+}
+
+// SetSeq sets the value of Seq.
+func (s *UserHistoryEntry) SetSeq(val int) {
+	s.Seq = val
+}
+
+// SetAction sets the value of Action.
+func (s *UserHistoryEntry) SetAction(val UserHistoryEntryAction) {
+	s.Action = val
+}
+
+// SetActor sets the value of Actor.
+func (s *UserHistoryEntry) SetActor(val OptString) {
+	s.Actor = val
+}
+
+// SetAt sets the value of At.
+func (s *UserHistoryEntry) SetAt(val time.Time) {
+	s.At = val
+}
+
+// SetVersion sets the value of Version.
+func (s *UserHistoryEntry) SetVersion(val int) {
+	s.Version = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *UserHistoryEntry) SetChanges(val []UserHistoryChange) {
+	s.Changes = val
+}
+
+// SetPrevHash sets the value of PrevHash.
+func (s *UserHistoryEntry) SetPrevHash(val string) {
+	s.PrevHash = val
+}
+
+// SetHash sets the value of Hash.
+func (s *UserHistoryEntry) SetHash(val string) {
+	s.Hash = val
+}
+
+type UserHistoryEntryAction string
+
+const (
+	UserHistoryEntryActionCreate  UserHistoryEntryAction = "create"
+	UserHistoryEntryActionUpdate  UserHistoryEntryAction = "update"
+	UserHistoryEntryActionDelete  UserHistoryEntryAction = "delete"
+	UserHistoryEntryActionRestore UserHistoryEntryAction = "restore"
+)
+
+// AllValues returns all UserHistoryEntryAction values.
+func (UserHistoryEntryAction) AllValues() []UserHistoryEntryAction {
+	return []UserHistoryEntryAction{
+		UserHistoryEntryActionCreate,
+		UserHistoryEntryActionUpdate,
+		UserHistoryEntryActionDelete,
+		UserHistoryEntryActionRestore,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UserHistoryEntryAction) MarshalText() ([]byte, error) {
+	switch s {
+	case UserHistoryEntryActionCreate:
+		return []byte(s), nil
+	case UserHistoryEntryActionUpdate:
+		return []byte(s), nil
+	case UserHistoryEntryActionDelete:
+		return []byte(s), nil
+	case UserHistoryEntryActionRestore:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UserHistoryEntryAction) UnmarshalText(data []byte) error {
+	switch UserHistoryEntryAction(data) {
+	case UserHistoryEntryActionCreate:
+		*s = UserHistoryEntryActionCreate
+		return nil
+	case UserHistoryEntryActionUpdate:
+		*s = UserHistoryEntryActionUpdate
+		return nil
+	case UserHistoryEntryActionDelete:
+		*s = UserHistoryEntryActionDelete
+		return nil
+	case UserHistoryEntryActionRestore:
+		*s = UserHistoryEntryActionRestore
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/UserHistoryResponse
+type UserHistoryResponse struct {
+	Items []UserHistoryEntry `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *UserHistoryResponse) GetItems() []UserHistoryEntry {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *UserHistoryResponse) SetItems(val []UserHistoryEntry) {
+	s.Items = val
+}
+
+func (*UserHistoryResponse) getUserHistoryRes() {}
+
+// Ref: #/components/schemas/ValidationErrorDetail
+type ValidationErrorDetail struct {
+	// Request field that failed validation, e.g. name or limit.
+	Field string `json:"field"`
+	// Violated rule - not_blank, length, charset, range, format, required, type or unknown.
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// GetField returns the value of Field.
+func (s *ValidationErrorDetail) GetField() string {
+	return s.Field
+}
+
+// GetRule returns the value of Rule.
+func (s *ValidationErrorDetail) GetRule() string {
+	return s.Rule
+}
+
+// GetMessage returns the value of Message.
+func (s *ValidationErrorDetail) GetMessage() string {
+	return s.Message
+}
+
+// SetField sets the value of Field.
+func (s *ValidationErrorDetail) SetField(val string) {
+	s.Field = val
+}
+
+// SetRule sets the value of Rule.
+func (s *ValidationErrorDetail) SetRule(val string) {
+	s.Rule = val
+}
+
+// SetMessage sets the value of Message.
+func (s *ValidationErrorDetail) SetMessage(val string) {
+	s.Message = val
+}
@@ -18,7 +18,28 @@ var _ Handler = UnimplementedHandler{}
 // Create user.
 //
 // POST /users
-func (UnimplementedHandler) CreateUser(ctx context.Context, req *CreateUserRequest) (r CreateUserRes, _ error) {
+func (UnimplementedHandler) CreateUser(ctx context.Context, req *CreateUserRequest, params CreateUserParams) (r CreateUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateUsersBatch implements CreateUsersBatch operation.
+//
+// Every item gets its own result: either the id of the created user or an error. Without
+// allOrNothing valid items are created even if others fail validation. With allOrNothing nothing is
+// created if any item fails, and the response is 422 with code 5 for the items that were valid.
+//
+// POST /users:batch
+func (UnimplementedHandler) CreateUsersBatch(ctx context.Context, req *CreateUsersBatchRequest) (r CreateUsersBatchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteUser implements DeleteUser operation.
+//
+// Marks the user as deleted. The user stays in storage as a tombstone: GetUserById answers 410,
+// ListUsers shows it only with include_deleted, and RestoreUser brings it back.
+//
+// DELETE /users/{id}
+func (UnimplementedHandler) DeleteUser(ctx context.Context, params DeleteUserParams) (r DeleteUserRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
@@ -30,3 +51,51 @@ func (UnimplementedHandler) CreateUser(ctx context.Context, req *CreateUserReque
 func (UnimplementedHandler) GetUserById(ctx context.Context, params GetUserByIdParams) (r GetUserByIdRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// GetUserHistory implements GetUserHistory operation.
+//
+// Entries of the audit log for the user, oldest first. Every entry is hash-chained to the previous
+// entry of the whole log, so history of deleted users is returned as well. A change is saved before
+// it is logged: if the log write fails, the request still succeeds, the failure is recorded as a
+// server-side incident and the change is missing from the history.
+//
+// GET /users/{id}/history
+func (UnimplementedHandler) GetUserHistory(ctx context.Context, params GetUserHistoryParams) (r GetUserHistoryRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListUsers implements ListUsers operation.
+//
+// List users.
+//
+// GET /users
+func (UnimplementedHandler) ListUsers(ctx context.Context, params ListUsersParams) (r ListUsersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PatchUser implements PatchUser operation.
+//
+// Partially update user.
+//
+// PATCH /users/{id}
+func (UnimplementedHandler) PatchUser(ctx context.Context, req *PatchUserRequest, params PatchUserParams) (r PatchUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreUser implements RestoreUser operation.
+//
+// Restoring a user that is not deleted is a no-op.
+//
+// POST /users/{id}:restore
+func (UnimplementedHandler) RestoreUser(ctx context.Context, params RestoreUserParams) (r RestoreUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateUser implements UpdateUser operation.
+//
+// Replace user.
+//
+// PUT /users/{id}
+func (UnimplementedHandler) UpdateUser(ctx context.Context, req *UpdateUserRequest, params UpdateUserParams) (r UpdateUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
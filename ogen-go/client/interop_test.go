@@ -0,0 +1,472 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	api "client/generated"
+	"client/testserver"
+)
+
+// interopStep - вызов клиента и тип ответа, в который он должен разобраться. Шаги выполняются по порядку
+// против одного сервера с пустым хранилищем.
+type interopStep struct {
+	name string
+	call func(ctx context.Context, c *api.Client) (any, error)
+	want any
+	// wantCode - ErrorResponse.code ответа с ошибкой; 0 - не проверяется
+	wantCode int
+}
+
+var interopSteps = []interopStep{
+	{
+		name: "create user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUser(ctx, &api.CreateUserRequest{Name: "Alice"}, api.CreateUserParams{})
+		},
+		want: &api.CreateUserResponse{},
+	},
+	{
+		name: "create user with blank name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUser(ctx, &api.CreateUserRequest{Name: " "}, api.CreateUserParams{})
+		},
+		want:     &api.CreateUserApplicationJSONBadRequest{},
+		wantCode: 3,
+	},
+	{
+		name: "create user with taken name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUser(ctx, &api.CreateUserRequest{Name: "Alice"}, api.CreateUserParams{})
+		},
+		want:     &api.CreateUserApplicationJSONConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "create user with idempotency key",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUser(ctx, &api.CreateUserRequest{Name: "Bob"}, api.CreateUserParams{IdempotencyKey: api.NewOptString("interop")})
+		},
+		want: &api.CreateUserResponse{},
+	},
+	{
+		name: "reuse idempotency key with another body",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUser(ctx, &api.CreateUserRequest{Name: "Carol"}, api.CreateUserParams{IdempotencyKey: api.NewOptString("interop")})
+		},
+		want:     &api.CreateUserApplicationJSONUnprocessableEntity{},
+		wantCode: 6,
+	},
+	{
+		name: "create batch",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUsersBatch(ctx, &api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{{Name: "Carol"}, {Name: " "}}})
+		},
+		want: &api.CreateUsersBatchOK{},
+	},
+	{
+		name: "create batch all or nothing",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUsersBatch(ctx, &api.CreateUsersBatchRequest{
+				Items:        []api.CreateUserRequest{{Name: "Dave"}, {Name: " "}},
+				AllOrNothing: api.NewOptBool(true),
+			})
+		},
+		want: &api.CreateUsersBatchUnprocessableEntity{},
+	},
+	{
+		name: "create batch with taken name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.CreateUsersBatch(ctx, &api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{{Name: "Eve"}, {Name: "Alice"}}})
+		},
+		want:     &api.CreateUsersBatchApplicationJSONConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "get user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserById(ctx, api.GetUserByIdParams{ID: 1})
+		},
+		want: &api.GetUserByIdResponseHeaders{},
+	},
+	{
+		name: "get unchanged user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserById(ctx, api.GetUserByIdParams{ID: 1, IfNoneMatch: api.NewOptString(`"1"`)})
+		},
+		want: &api.GetUserByIdNotModified{},
+	},
+	{
+		name: "get missing user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserById(ctx, api.GetUserByIdParams{ID: 99})
+		},
+		want:     &api.GetUserByIdApplicationJSONNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "get missing user as problem details",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserById(withAccept(ctx, "application/problem+json"), api.GetUserByIdParams{ID: 99})
+		},
+		want:     &api.GetUserByIdApplicationProblemJSONNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "replace user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: "Alicia"}, api.UpdateUserParams{ID: 1, IfMatch: `"1"`})
+		},
+		want: &api.GetUserByIdResponseHeaders{},
+	},
+	{
+		name: "replace user with stale ETag",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: "Alice"}, api.UpdateUserParams{ID: 1, IfMatch: `"1"`})
+		},
+		want:     &api.UpdateUserApplicationJSONPreconditionFailed{},
+		wantCode: 8,
+	},
+	{
+		name: "replace user with blank name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: " "}, api.UpdateUserParams{ID: 1, IfMatch: "*"})
+		},
+		want:     &api.UpdateUserApplicationJSONBadRequest{},
+		wantCode: 3,
+	},
+	{
+		name: "replace user with taken name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: "Bob"}, api.UpdateUserParams{ID: 1, IfMatch: "*"})
+		},
+		want:     &api.UpdateUserApplicationJSONConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "replace missing user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: "Alice"}, api.UpdateUserParams{ID: 99, IfMatch: "*"})
+		},
+		want:     &api.UpdateUserApplicationJSONNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "patch user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString("Bobby")}, api.PatchUserParams{ID: 2, IfMatch: "*"})
+		},
+		want: &api.GetUserByIdResponseHeaders{},
+	},
+	{
+		name: "patch user with stale ETag",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString("Bob")}, api.PatchUserParams{ID: 2, IfMatch: `"1"`})
+		},
+		want:     &api.PatchUserApplicationJSONPreconditionFailed{},
+		wantCode: 8,
+	},
+	{
+		name: "patch user with blank name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString(" ")}, api.PatchUserParams{ID: 2, IfMatch: "*"})
+		},
+		want:     &api.PatchUserApplicationJSONBadRequest{},
+		wantCode: 3,
+	},
+	{
+		name: "patch user to taken name",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString("Alicia")}, api.PatchUserParams{ID: 2, IfMatch: "*"})
+		},
+		want:     &api.PatchUserApplicationJSONConflict{},
+		wantCode: 16,
+	},
+	{
+		name: "patch missing user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString("Bob")}, api.PatchUserParams{ID: 99, IfMatch: "*"})
+		},
+		want:     &api.PatchUserApplicationJSONNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "list users",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.ListUsers(ctx, api.ListUsersParams{Limit: api.NewOptInt(2), Sort: []string{"-name"}})
+		},
+		want: &api.ListUsersResponse{},
+	},
+	{
+		name: "list users with unknown sort field",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.ListUsers(ctx, api.ListUsersParams{Sort: []string{"email"}})
+		},
+		want:     &api.ListUsersApplicationJSONBadRequest{},
+		wantCode: 4,
+	},
+	{
+		name: "delete user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.DeleteUser(ctx, api.DeleteUserParams{ID: 2})
+		},
+		want: &api.DeleteUserNoContent{},
+	},
+	{
+		name: "get deleted user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserById(ctx, api.GetUserByIdParams{ID: 2})
+		},
+		want:     &api.GetUserByIdApplicationJSONGone{},
+		wantCode: 410,
+	},
+	{
+		name: "replace deleted user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: "Bob"}, api.UpdateUserParams{ID: 2, IfMatch: "*"})
+		},
+		want:     &api.UpdateUserApplicationJSONGone{},
+		wantCode: 410,
+	},
+	{
+		name: "patch deleted user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString("Bob")}, api.PatchUserParams{ID: 2, IfMatch: "*"})
+		},
+		want:     &api.PatchUserApplicationJSONGone{},
+		wantCode: 410,
+	},
+	{
+		name: "delete deleted user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.DeleteUser(ctx, api.DeleteUserParams{ID: 2})
+		},
+		want:     &api.DeleteUserApplicationJSONGone{},
+		wantCode: 410,
+	},
+	{
+		name: "delete missing user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.DeleteUser(ctx, api.DeleteUserParams{ID: 99})
+		},
+		want:     &api.DeleteUserApplicationJSONNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "restore user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.RestoreUser(ctx, api.RestoreUserParams{ID: 2})
+		},
+		want: &api.GetUserByIdResponseHeaders{},
+	},
+	{
+		name: "restore missing user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.RestoreUser(ctx, api.RestoreUserParams{ID: 99})
+		},
+		want:     &api.RestoreUserApplicationJSONNotFound{},
+		wantCode: 404,
+	},
+	{
+		name: "get user history",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserHistory(ctx, api.GetUserHistoryParams{ID: 2})
+		},
+		want: &api.UserHistoryResponse{},
+	},
+	{
+		name: "get history of missing user",
+		call: func(ctx context.Context, c *api.Client) (any, error) {
+			return c.GetUserHistory(ctx, api.GetUserHistoryParams{ID: 99})
+		},
+		want:     &api.GetUserHistoryApplicationJSONNotFound{},
+		wantCode: 404,
+	},
+}
+
+// Клиент ogen против каждого сервера репозитория
+func TestInterop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and starts every server")
+	}
+
+	for _, dir := range testserver.Servers {
+		t.Run(dir, func(t *testing.T) {
+			t.Parallel()
+
+			baseURL := testserver.Start(t, filepath.Join("..", "..", dir))
+
+			client, err := api.NewClient(baseURL, api.WithClient(&http.Client{Transport: acceptTransport{}}))
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			for _, step := range interopSteps {
+				ok := t.Run(step.name, func(t *testing.T) {
+					got, err := step.call(context.Background(), client)
+					checkResponse(t, got, err, step.want, step.wantCode)
+				})
+				if !ok {
+					t.Fatalf("step %q failed, the rest of the scenario is skipped", step.name)
+				}
+			}
+		})
+	}
+}
+
+// documentedStatus - ответ с ошибкой из спецификации. Серверы отдают его только при сбое (500), гонке (409 с кодом 7)
+// или запросе, который типизированный клиент не отправит (406, 415, неверный параметр), поэтому он
+// воспроизводится заглушкой с телом ErrorResponse.
+type documentedStatus struct {
+	operation string
+	status    int
+	code      int
+	call      func(ctx context.Context, c *api.Client) (any, error)
+	want      any
+}
+
+func getUser(ctx context.Context, c *api.Client) (any, error) {
+	return c.GetUserById(ctx, api.GetUserByIdParams{ID: 1})
+}
+
+func updateUser(ctx context.Context, c *api.Client) (any, error) {
+	return c.UpdateUser(ctx, &api.UpdateUserRequest{Name: "Alice"}, api.UpdateUserParams{ID: 1, IfMatch: "*"})
+}
+
+func patchUser(ctx context.Context, c *api.Client) (any, error) {
+	return c.PatchUser(ctx, &api.PatchUserRequest{Name: api.NewOptString("Alice")}, api.PatchUserParams{ID: 1, IfMatch: "*"})
+}
+
+func deleteUser(ctx context.Context, c *api.Client) (any, error) {
+	return c.DeleteUser(ctx, api.DeleteUserParams{ID: 1})
+}
+
+func restoreUser(ctx context.Context, c *api.Client) (any, error) {
+	return c.RestoreUser(ctx, api.RestoreUserParams{ID: 1})
+}
+
+func getUserHistory(ctx context.Context, c *api.Client) (any, error) {
+	return c.GetUserHistory(ctx, api.GetUserHistoryParams{ID: 1})
+}
+
+func listUsers(ctx context.Context, c *api.Client) (any, error) {
+	return c.ListUsers(ctx, api.ListUsersParams{})
+}
+
+func createUser(ctx context.Context, c *api.Client) (any, error) {
+	return c.CreateUser(ctx, &api.CreateUserRequest{Name: "Alice"}, api.CreateUserParams{})
+}
+
+func createUsersBatch(ctx context.Context, c *api.Client) (any, error) {
+	return c.CreateUsersBatch(ctx, &api.CreateUsersBatchRequest{Items: []api.CreateUserRequest{{Name: "Alice"}}})
+}
+
+var documentedStatuses = []documentedStatus{
+	{operation: "GetUserById", status: 400, code: 9, call: getUser, want: &api.GetUserByIdApplicationJSONBadRequest{}},
+	{operation: "GetUserById", status: 406, code: 14, call: getUser, want: &api.GetUserByIdApplicationJSONNotAcceptable{}},
+	{operation: "GetUserById", status: 500, code: -1, call: getUser, want: &api.GetUserByIdApplicationJSONInternalServerError{}},
+	{operation: "UpdateUser", status: 406, code: 14, call: updateUser, want: &api.UpdateUserApplicationJSONNotAcceptable{}},
+	{operation: "UpdateUser", status: 415, code: 13, call: updateUser, want: &api.UpdateUserApplicationJSONUnsupportedMediaType{}},
+	{operation: "UpdateUser", status: 500, code: -1, call: updateUser, want: &api.UpdateUserApplicationJSONInternalServerError{}},
+	{operation: "PatchUser", status: 406, code: 14, call: patchUser, want: &api.PatchUserApplicationJSONNotAcceptable{}},
+	{operation: "PatchUser", status: 415, code: 13, call: patchUser, want: &api.PatchUserApplicationJSONUnsupportedMediaType{}},
+	{operation: "PatchUser", status: 500, code: -1, call: patchUser, want: &api.PatchUserApplicationJSONInternalServerError{}},
+	{operation: "DeleteUser", status: 400, code: 9, call: deleteUser, want: &api.DeleteUserApplicationJSONBadRequest{}},
+	{operation: "DeleteUser", status: 406, code: 14, call: deleteUser, want: &api.DeleteUserApplicationJSONNotAcceptable{}},
+	{operation: "DeleteUser", status: 500, code: -1, call: deleteUser, want: &api.DeleteUserApplicationJSONInternalServerError{}},
+	{operation: "RestoreUser", status: 400, code: 9, call: restoreUser, want: &api.RestoreUserApplicationJSONBadRequest{}},
+	{operation: "RestoreUser", status: 406, code: 14, call: restoreUser, want: &api.RestoreUserApplicationJSONNotAcceptable{}},
+	{operation: "RestoreUser", status: 500, code: -1, call: restoreUser, want: &api.RestoreUserApplicationJSONInternalServerError{}},
+	{operation: "GetUserHistory", status: 400, code: 9, call: getUserHistory, want: &api.GetUserHistoryApplicationJSONBadRequest{}},
+	{operation: "GetUserHistory", status: 406, code: 14, call: getUserHistory, want: &api.GetUserHistoryApplicationJSONNotAcceptable{}},
+	{operation: "GetUserHistory", status: 500, code: -1, call: getUserHistory, want: &api.GetUserHistoryApplicationJSONInternalServerError{}},
+	{operation: "ListUsers", status: 403, code: 15, call: listUsers, want: &api.ListUsersApplicationJSONForbidden{}},
+	{operation: "ListUsers", status: 406, code: 14, call: listUsers, want: &api.ListUsersApplicationJSONNotAcceptable{}},
+	{operation: "ListUsers", status: 500, code: -1, call: listUsers, want: &api.ListUsersApplicationJSONInternalServerError{}},
+	{operation: "CreateUser", status: 406, code: 14, call: createUser, want: &api.CreateUserApplicationJSONNotAcceptable{}},
+	{operation: "CreateUser", status: 409, code: 7, call: createUser, want: &api.CreateUserApplicationJSONConflict{}},
+	{operation: "CreateUser", status: 415, code: 13, call: createUser, want: &api.CreateUserApplicationJSONUnsupportedMediaType{}},
+	{operation: "CreateUser", status: 500, code: -1, call: createUser, want: &api.CreateUserApplicationJSONInternalServerError{}},
+	{operation: "CreateUsersBatch", status: 400, code: 3, call: createUsersBatch, want: &api.CreateUsersBatchApplicationJSONBadRequest{}},
+	{operation: "CreateUsersBatch", status: 406, code: 14, call: createUsersBatch, want: &api.CreateUsersBatchApplicationJSONNotAcceptable{}},
+	{operation: "CreateUsersBatch", status: 415, code: 13, call: createUsersBatch, want: &api.CreateUsersBatchApplicationJSONUnsupportedMediaType{}},
+	{operation: "CreateUsersBatch", status: 500, code: -1, call: createUsersBatch, want: &api.CreateUsersBatchApplicationJSONInternalServerError{}},
+}
+
+func TestDocumentedStatuses(t *testing.T) {
+	for _, tt := range documentedStatuses {
+		t.Run(fmt.Sprintf("%s %d", tt.operation, tt.status), func(t *testing.T) {
+			stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+
+				_, _ = fmt.Fprintf(w, `{"code":%d,"error":%q}`, tt.code, http.StatusText(tt.status))
+			}))
+			defer stub.Close()
+
+			client, err := api.NewClient(stub.URL)
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			got, err := tt.call(context.Background(), client)
+			checkResponse(t, got, err, tt.want, tt.code)
+		})
+	}
+}
+
+// checkResponse проверяет, что ответ разобран без ошибки в тип want, а у ответа с ошибкой - код wantCode.
+func checkResponse(t *testing.T, got any, err error, want any, wantCode int) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("error = %v, want %T", err, want)
+	}
+
+	if reflect.TypeOf(got) != reflect.TypeOf(want) {
+		t.Fatalf("response = %T %+v, want %T", got, got, want)
+	}
+
+	if wantCode == 0 {
+		return
+	}
+
+	body, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var errorResponse struct {
+		Code int `json:"code"`
+	}
+
+	err = json.Unmarshal(body, &errorResponse)
+	if err != nil || errorResponse.Code != wantCode {
+		t.Fatalf("response = %s, want code %d", body, wantCode)
+	}
+}
+
+// acceptKey - ключ контекста с заголовком Accept запроса: у клиента ogen нет опций отдельного запроса
+type acceptKey struct{}
+
+func withAccept(ctx context.Context, mediaType string) context.Context {
+	return context.WithValue(ctx, acceptKey{}, mediaType)
+}
+
+// acceptTransport задает запросу заголовок Accept из контекста (см. withAccept)
+type acceptTransport struct{}
+
+func (acceptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if mediaType, ok := req.Context().Value(acceptKey{}).(string); ok {
+		req = req.Clone(req.Context())
+		req.Header.Set("Accept", mediaType)
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
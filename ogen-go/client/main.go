@@ -22,21 +22,35 @@ func main() {
 	}
 
 	switch p := response.(type) {
-	case *api.GetUserByIdResponse:
+	case *api.GetUserByIdResponseHeaders:
+		rr, err := json.MarshalIndent(p.Response, " ", " ")
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(p.ETag, string(rr))
+	case *api.GetUserByIdApplicationJSONNotFound:
+		rr, err := json.MarshalIndent(p, " ", " ")
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(string(rr))
+	case *api.GetUserByIdApplicationJSONInternalServerError:
 		rr, err := json.MarshalIndent(p, " ", " ")
 		if err != nil {
 			panic(err)
 		}
 
 		fmt.Println(string(rr))
-	case *api.GetUserByIdNotFound:
+	case *api.GetUserByIdApplicationProblemJSONNotFound:
 		rr, err := json.MarshalIndent(p, " ", " ")
 		if err != nil {
 			panic(err)
 		}
 
 		fmt.Println(string(rr))
-	case *api.GetUserByIdInternalServerError:
+	case *api.GetUserByIdApplicationProblemJSONInternalServerError:
 		rr, err := json.MarshalIndent(p, " ", " ")
 		if err != nil {
 			panic(err)
@@ -0,0 +1,28 @@
+package testserver
+
+import (
+	"os"
+	"testing"
+)
+
+// Матрица клиентов и серверов одна только пока копии пакета совпадают
+func TestCopiesUpToDate(t *testing.T) {
+	want, err := os.ReadFile("testserver.go")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	for _, path := range []string{
+		"../../../oapi-codegen/client/testserver/testserver.go",
+		"../../../go-swagger/client/testserver/testserver.go",
+	} {
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+
+		if string(got) != string(want) {
+			t.Errorf("%s differs from ogen-go/client/testserver/testserver.go, copy it over", path)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package contenttype
+
+import (
+	"mime"
+	"net/http"
+)
+
+// Middleware убирает параметр charset из Content-Type ответов application/json и application/problem+json.
+// ogen помечает ими каждый JSON-ответ, хотя у этих типов нет параметра charset (RFC 8259), а клиент
+// oapi-codegen сравнивает Content-Type целиком и не разбирает тело такого ответа.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&responseWriter{ResponseWriter: w}, r)
+	})
+}
+
+type responseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+
+		mediaType, params, err := mime.ParseMediaType(w.Header().Get("Content-Type"))
+		if err == nil && (mediaType == "application/json" || mediaType == "application/problem+json") {
+			delete(params, "charset")
+
+			w.Header().Set("Content-Type", mime.FormatMediaType(mediaType, params))
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap нужен http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
@@ -0,0 +1,50 @@
+package contenttype
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		writeHeader bool
+		want        string
+	}{
+		{name: "json with charset", contentType: "application/json; charset=utf-8", writeHeader: true, want: "application/json"},
+		{name: "problem details with charset", contentType: "application/problem+json; charset=utf-8", writeHeader: true, want: "application/problem+json"},
+		{name: "implicit status", contentType: "application/json; charset=utf-8", want: "application/json"},
+		{name: "json without charset", contentType: "application/json", writeHeader: true, want: "application/json"},
+		{name: "other media type", contentType: "text/plain; charset=utf-8", writeHeader: true, want: "text/plain; charset=utf-8"},
+		{name: "no content type", writeHeader: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+
+				if tt.writeHeader {
+					w.WriteHeader(http.StatusBadRequest)
+				}
+
+				_, _ = w.Write([]byte(`{}`))
+			}))
+
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+
+			if got := rr.Header().Get("Content-Type"); got != tt.want {
+				t.Errorf("Content-Type = %q, want %q", got, tt.want)
+			}
+
+			if rr.Body.String() != `{}` {
+				t.Errorf("body = %q, want {}", rr.Body.String())
+			}
+		})
+	}
+}
@@ -6,6 +6,13 @@ package api
 type OperationName = string
 
 const (
-	CreateUserOperation  OperationName = "CreateUser"
-	GetUserByIdOperation OperationName = "GetUserById"
+	CreateUserOperation       OperationName = "CreateUser"
+	CreateUsersBatchOperation OperationName = "CreateUsersBatch"
+	DeleteUserOperation       OperationName = "DeleteUser"
+	GetUserByIdOperation      OperationName = "GetUserById"
+	GetUserHistoryOperation   OperationName = "GetUserHistory"
+	ListUsersOperation        OperationName = "ListUsers"
+	PatchUserOperation        OperationName = "PatchUser"
+	RestoreUserOperation      OperationName = "RestoreUser"
+	UpdateUserOperation       OperationName = "UpdateUser"
 )
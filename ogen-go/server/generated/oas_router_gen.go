@@ -59,10 +59,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 			if len(elem) == 0 {
 				switch r.Method {
+				case "GET":
+					s.handleListUsersRequest([0]string{}, elemIsEscaped, w, r)
 				case "POST":
 					s.handleCreateUserRequest([0]string{}, elemIsEscaped, w, r)
 				default:
-					s.notAllowed(w, r, "POST")
+					s.notAllowed(w, r, "GET,POST")
 				}
 
 				return
@@ -77,23 +79,100 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				// Param: "id"
-				// Leaf parameter, slashes are prohibited
-				idx := strings.IndexByte(elem, '/')
-				if idx >= 0 {
-					break
+				// Match until one of "/:"
+				idx := strings.IndexAny(elem, "/:")
+				if idx < 0 {
+					idx = len(elem)
 				}
-				args[0] = elem
-				elem = ""
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
+					case "DELETE":
+						s.handleDeleteUserRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
 					case "GET":
 						s.handleGetUserByIdRequest([1]string{
 							args[0],
 						}, elemIsEscaped, w, r)
+					case "PATCH":
+						s.handlePatchUserRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
+					case "PUT":
+						s.handleUpdateUserRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "DELETE,GET,PATCH,PUT")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/history"
+
+					if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetUserHistoryRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case ':': // Prefix: ":restore"
+
+					if l := len(":restore"); len(elem) >= l && elem[0:l] == ":restore" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleRestoreUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				}
+
+			case ':': // Prefix: ":batch"
+
+				if l := len(":batch"); len(elem) >= l && elem[0:l] == ":batch" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleCreateUsersBatchRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, "POST")
 					}
 
 					return
@@ -191,6 +270,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 			if len(elem) == 0 {
 				switch method {
+				case "GET":
+					r.name = ListUsersOperation
+					r.summary = "List users"
+					r.operationID = "ListUsers"
+					r.pathPattern = "/users"
+					r.args = args
+					r.count = 0
+					return r, true
 				case "POST":
 					r.name = CreateUserOperation
 					r.summary = "Create user"
@@ -213,17 +300,24 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				// Param: "id"
-				// Leaf parameter, slashes are prohibited
-				idx := strings.IndexByte(elem, '/')
-				if idx >= 0 {
-					break
+				// Match until one of "/:"
+				idx := strings.IndexAny(elem, "/:")
+				if idx < 0 {
+					idx = len(elem)
 				}
-				args[0] = elem
-				elem = ""
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
+					case "DELETE":
+						r.name = DeleteUserOperation
+						r.summary = "Delete user"
+						r.operationID = "DeleteUser"
+						r.pathPattern = "/users/{id}"
+						r.args = args
+						r.count = 1
+						return r, true
 					case "GET":
 						r.name = GetUserByIdOperation
 						r.summary = "Get user by ID"
@@ -232,6 +326,96 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						r.args = args
 						r.count = 1
 						return r, true
+					case "PATCH":
+						r.name = PatchUserOperation
+						r.summary = "Partially update user"
+						r.operationID = "PatchUser"
+						r.pathPattern = "/users/{id}"
+						r.args = args
+						r.count = 1
+						return r, true
+					case "PUT":
+						r.name = UpdateUserOperation
+						r.summary = "Replace user"
+						r.operationID = "UpdateUser"
+						r.pathPattern = "/users/{id}"
+						r.args = args
+						r.count = 1
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/history"
+
+					if l := len("/history"); len(elem) >= l && elem[0:l] == "/history" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetUserHistoryOperation
+							r.summary = "Get user change history"
+							r.operationID = "GetUserHistory"
+							r.pathPattern = "/users/{id}/history"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case ':': // Prefix: ":restore"
+
+					if l := len(":restore"); len(elem) >= l && elem[0:l] == ":restore" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = RestoreUserOperation
+							r.summary = "Restore deleted user"
+							r.operationID = "RestoreUser"
+							r.pathPattern = "/users/{id}:restore"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case ':': // Prefix: ":batch"
+
+				if l := len(":batch"); len(elem) >= l && elem[0:l] == ":batch" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = CreateUsersBatchOperation
+						r.summary = "Create several users at once"
+						r.operationID = "CreateUsersBatch"
+						r.pathPattern = "/users:batch"
+						r.args = args
+						r.count = 0
+						return r, true
 					default:
 						return
 					}
@@ -13,13 +13,61 @@ type Handler interface {
 	// Create user.
 	//
 	// POST /users
-	CreateUser(ctx context.Context, req *CreateUserRequest) (CreateUserRes, error)
+	CreateUser(ctx context.Context, req *CreateUserRequest, params CreateUserParams) (CreateUserRes, error)
+	// CreateUsersBatch implements CreateUsersBatch operation.
+	//
+	// Every item gets its own result: either the id of the created user or an error. Without
+	// allOrNothing valid items are created even if others fail validation. With allOrNothing nothing is
+	// created if any item fails, and the response is 422 with code 5 for the items that were valid.
+	//
+	// POST /users:batch
+	CreateUsersBatch(ctx context.Context, req *CreateUsersBatchRequest) (CreateUsersBatchRes, error)
+	// DeleteUser implements DeleteUser operation.
+	//
+	// Marks the user as deleted. The user stays in storage as a tombstone: GetUserById answers 410,
+	// ListUsers shows it only with include_deleted, and RestoreUser brings it back.
+	//
+	// DELETE /users/{id}
+	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
 	// GetUserById implements GetUserById operation.
 	//
 	// Get user by ID.
 	//
 	// GET /users/{id}
 	GetUserById(ctx context.Context, params GetUserByIdParams) (GetUserByIdRes, error)
+	// GetUserHistory implements GetUserHistory operation.
+	//
+	// Entries of the audit log for the user, oldest first. Every entry is hash-chained to the previous
+	// entry of the whole log, so history of deleted users is returned as well. A change is saved before
+	// it is logged: if the log write fails, the request still succeeds, the failure is recorded as a
+	// server-side incident and the change is missing from the history.
+	//
+	// GET /users/{id}/history
+	GetUserHistory(ctx context.Context, params GetUserHistoryParams) (GetUserHistoryRes, error)
+	// ListUsers implements ListUsers operation.
+	//
+	// List users.
+	//
+	// GET /users
+	ListUsers(ctx context.Context, params ListUsersParams) (ListUsersRes, error)
+	// PatchUser implements PatchUser operation.
+	//
+	// Partially update user.
+	//
+	// PATCH /users/{id}
+	PatchUser(ctx context.Context, req *PatchUserRequest, params PatchUserParams) (PatchUserRes, error)
+	// RestoreUser implements RestoreUser operation.
+	//
+	// Restoring a user that is not deleted is a no-op.
+	//
+	// POST /users/{id}:restore
+	RestoreUser(ctx context.Context, params RestoreUserParams) (RestoreUserRes, error)
+	// UpdateUser implements UpdateUser operation.
+	//
+	// Replace user.
+	//
+	// PUT /users/{id}
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, params UpdateUserParams) (UpdateUserRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -2,24 +2,45 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/validate"
 
 	api "server/generated"
-	"server/usecases"
+	"shared/errcatalog"
+	"shared/etag"
+	"shared/incident"
+	"shared/usecases"
 )
 
 type Handlers struct {
-	useCases UseCases
+	useCases  UseCases
+	incidents *incident.Reporter
 }
 
 type UseCases interface {
 	GetUser(ctx context.Context, id int) (usecases.User, error)
 	CreateUsers(ctx context.Context, userRequests usecases.CreateUserRequestDTO) (int, error)
+	CreateUsersBatch(ctx context.Context, createUsersBatchRequestDTO usecases.CreateUsersBatchRequestDTO) (usecases.CreateUsersBatchResult, error)
+	UpdateUser(ctx context.Context, id int, updateUserRequestDTO usecases.UpdateUserRequestDTO) (usecases.User, error)
+	PatchUser(ctx context.Context, id int, patchUserRequestDTO usecases.PatchUserRequestDTO) (usecases.User, error)
+	DeleteUser(ctx context.Context, id int) error
+	RestoreUser(ctx context.Context, id int) (usecases.User, error)
+	UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error)
+	ListUsers(ctx context.Context, listUsersRequestDTO usecases.ListUsersRequestDTO) (usecases.UsersPage, error)
 }
 
-func New(useCases UseCases) *Handlers {
+func New(useCases UseCases, incidents *incident.Reporter) *Handlers {
 	return &Handlers{
-		useCases: useCases,
+		useCases:  useCases,
+		incidents: incidents,
 	}
 }
 
@@ -28,67 +49,65 @@ func (h *Handlers) GetUserById(ctx context.Context, params api.GetUserByIdParams
 
 	user, err := h.useCases.GetUser(ctx, id)
 	if err != nil {
-		switch {
-		case errors.Is(err, usecases.ErrNotFound):
-			response := api.GetUserByIdNotFound{
-				Code:  404,
-				Error: "Not Found",
-			}
+		entry := errcatalog.Lookup(err, errcatalog.NotFound, errcatalog.Gone, errcatalog.NotPublic1, errcatalog.NotPublic2)
 
-			return &response, nil
-		case errors.Is(err, usecases.ErrNotPublic1):
-			response := api.GetUserByIdInternalServerError{
-				Code:  1,
-				Error: "Internal Server Error 1",
-			}
+		switch entry.Status {
+		case http.StatusNotFound:
+			response := api.GetUserByIdApplicationJSONNotFound(h.errorResponse(ctx, err, entry))
 
 			return &response, nil
-		case errors.Is(err, usecases.ErrNotPublic2):
-			response := api.GetUserByIdInternalServerError{
-				Code:  2,
-				Error: "Internal Server Error 2",
-			}
+		case http.StatusGone:
+			response := api.GetUserByIdApplicationJSONGone(h.errorResponse(ctx, err, entry))
 
 			return &response, nil
 		default:
-			response := api.GetUserByIdInternalServerError{
-				Code:  -1,
-				Error: "Internal Server Error",
-			}
+			response := api.GetUserByIdApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
 
 			return &response, nil
 		}
 	}
 
-	response := api.GetUserByIdResponse{
-		ID:   user.ID,
-		Name: user.Name,
+	if etag.NoneMatch(params.IfNoneMatch.Or(""), user.Version) {
+		response := api.GetUserByIdNotModified{
+			ETag: etag.Format(user.Version),
+		}
+
+		return &response, nil
+	}
+
+	response := api.GetUserByIdResponseHeaders{
+		ETag: etag.Format(user.Version),
+		Response: api.GetUserByIdResponse{
+			ID:   user.ID,
+			Name: user.Name,
+		},
 	}
 
 	return &response, nil
 
 }
 
-func (h *Handlers) CreateUser(ctx context.Context, req *api.CreateUserRequest) (api.CreateUserRes, error) {
+// CreateUser - повторы с Idempotency-Key отрабатывает middleware idempotency, до обработчика они не доходят.
+func (h *Handlers) CreateUser(ctx context.Context, req *api.CreateUserRequest, params api.CreateUserParams) (api.CreateUserRes, error) {
 	createUserRequestDTO := usecases.CreateUserRequestDTO{
 		Name: req.Name,
 	}
 
 	id, err := h.useCases.CreateUsers(ctx, createUserRequestDTO)
 	if err != nil {
-		switch {
-		case errors.Is(err, usecases.ErrValidation):
-			response := api.CreateUserBadRequest{
-				Code:  3,
-				Error: err.Error(),
-			}
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			response := api.CreateUserApplicationJSONBadRequest(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusConflict:
+			response := api.CreateUserApplicationJSONConflict(h.errorResponse(ctx, err, entry))
 
 			return &response, nil
 		default:
-			response := api.CreateUserInternalServerError{
-				Code:  -1,
-				Error: "Internal Server Error",
-			}
+			response := api.CreateUserApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
 
 			return &response, nil
 		}
@@ -100,3 +119,595 @@ func (h *Handlers) CreateUser(ctx context.Context, req *api.CreateUserRequest) (
 
 	return &response, nil
 }
+
+func (h *Handlers) CreateUsersBatch(ctx context.Context, req *api.CreateUsersBatchRequest) (api.CreateUsersBatchRes, error) {
+	createUsersBatchRequestDTO := usecases.CreateUsersBatchRequestDTO{
+		Items:        make([]usecases.CreateUserRequestDTO, 0, len(req.Items)),
+		AllOrNothing: req.AllOrNothing.Or(false),
+	}
+
+	for _, item := range req.Items {
+		createUsersBatchRequestDTO.Items = append(createUsersBatchRequestDTO.Items, usecases.CreateUserRequestDTO{
+			Name: item.Name,
+		})
+	}
+
+	batch, err := h.useCases.CreateUsersBatch(ctx, createUsersBatchRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			response := api.CreateUsersBatchApplicationJSONBadRequest(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusConflict:
+			response := api.CreateUsersBatchApplicationJSONConflict(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.CreateUsersBatchApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	results := make([]api.CreateUsersBatchResult, 0, len(batch.Results))
+
+	for _, result := range batch.Results {
+		if result.Err != nil {
+			results = append(results, api.CreateUsersBatchResult{
+				Error: api.NewOptErrorResponse(h.batchItemError(ctx, result.Err)),
+			})
+
+			continue
+		}
+
+		results = append(results, api.CreateUsersBatchResult{
+			ID: api.NewOptInt(result.ID),
+		})
+	}
+
+	if batch.RolledBack {
+		response := api.CreateUsersBatchUnprocessableEntity{
+			Results: results,
+		}
+
+		return &response, nil
+	}
+
+	response := api.CreateUsersBatchOK{
+		Results: results,
+	}
+
+	return &response, nil
+}
+
+// batchItemError - ошибка отдельного элемента пакетного создания
+func (h *Handlers) batchItemError(ctx context.Context, err error) api.ErrorResponse {
+	return h.errorResponse(ctx, err, errcatalog.Lookup(err, errcatalog.Validation, errcatalog.RolledBack))
+}
+
+// ErrorHandler - обработчик ошибок ogen (api.WithErrorHandler): ошибки разбора параметров и тела, а также ошибки,
+// которые вернул обработчик, отдаются в виде ErrorResponse.
+func (h *Handlers) ErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	entry, err := ogenError(err)
+
+	h.writeEntry(ctx, w, err, entry)
+}
+
+// NotFound отвечает на запрос, которому не соответствует ни одна операция (api.WithNotFound).
+func (h *Handlers) NotFound(w http.ResponseWriter, r *http.Request) {
+	h.writeEntry(r.Context(), w, nil, errcatalog.RouteNotFound)
+}
+
+// MethodNotAllowed отвечает на запрос к пути, у которого нет операции с таким методом (api.WithMethodNotAllowed).
+// Preflight-запрос OPTIONS, как и у ogen по умолчанию, получает 204 со списком методов.
+func (h *Handlers) MethodNotAllowed(w http.ResponseWriter, r *http.Request, allowed string) {
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", allowed)
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	}
+
+	w.Header().Set("Allow", allowed)
+	h.writeEntry(r.Context(), w, nil, errcatalog.MethodNotAllowed)
+}
+
+func (h *Handlers) writeEntry(ctx context.Context, w http.ResponseWriter, err error, entry errcatalog.Entry) {
+	response := h.errorResponse(ctx, err, entry)
+
+	responseBytes, marshalErr := response.MarshalJSON()
+	if marshalErr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(entry.Status)
+
+	_, _ = w.Write(responseBytes)
+}
+
+// ogenError выбирает запись каталога для ошибки, с которой ogen вызывает ErrorHandler, и ошибку, текст которой
+// получит клиент. Нарушения схемы в параметре или теле, как и в shared/validation, становятся ошибкой валидации
+// с нарушениями по полям.
+func ogenError(err error) (errcatalog.Entry, error) {
+	fields, ok := ogenFieldErrors(err)
+	if !ok {
+		return ogenErrorEntry(err), err
+	}
+
+	// порядок полей как в shared/validation, чтобы ответы серверов совпадали
+	slices.SortStableFunc(fields, func(a, b usecases.FieldError) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return errcatalog.Validation, &usecases.ValidationError{Fields: fields}
+}
+
+// ogenFieldErrors - нарушения по полям, если ошибка ogen сводится к ним. Поле параметра называется его именем,
+// поле тела - путем в JSON через точку.
+func ogenFieldErrors(err error) ([]usecases.FieldError, bool) {
+	var (
+		paramErr    *ogenerrors.DecodeParamError
+		bodyErr     *ogenerrors.DecodeBodyError
+		requestErr  *ogenerrors.DecodeRequestError
+		validateErr *validate.Error
+	)
+
+	switch {
+	case errors.As(err, &paramErr):
+		// нет обязательного параметра или он не разобран - это не нарушение схемы значения
+		rule, message, ok := describeOgen(paramErr.Err)
+		if !ok {
+			return nil, false
+		}
+
+		return []usecases.FieldError{{Field: paramErr.Name, Rule: rule, Message: message}}, true
+	case errors.As(err, &bodyErr):
+		return decodeFieldErrors(bodyErr)
+	case errors.As(err, &requestErr) && errors.As(requestErr.Err, &validateErr):
+		// тело разобрано, но не прошло проверку ограничений схемы (Validate)
+		return validateFieldErrors(validateErr, nil), true
+	default:
+		return nil, false
+	}
+}
+
+// decodeFieldErrors - нарушения по полям из ошибки разбора JSON-тела: нет обязательного поля, неизвестное поле
+// или значение не того типа. Путь к полю собирается из оберток "decode field"; индекс элемента массива ogen
+// в ошибке не сообщает, поэтому поле внутри элемента называется без него (items.name).
+func decodeFieldErrors(bodyErr *ogenerrors.DecodeBodyError) ([]usecases.FieldError, bool) {
+	if !json.Valid(bodyErr.Body) {
+		return nil, false
+	}
+
+	var path []string
+
+	leaf := bodyErr.Err
+	for next := errors.Unwrap(leaf); next != nil; leaf, next = next, errors.Unwrap(next) {
+		var name string
+
+		_, scanErr := fmt.Sscanf(strings.TrimSuffix(leaf.Error(), ": "+next.Error()), "decode field %q", &name)
+		if scanErr == nil {
+			path = append(path, name)
+		}
+	}
+
+	var validateErr *validate.Error
+	if errors.As(leaf, &validateErr) {
+		return validateFieldErrors(validateErr, path), true
+	}
+
+	var property string
+
+	_, scanErr := fmt.Sscanf(leaf.Error(), "unexpected field %q", &property)
+	if scanErr == nil {
+		field := strings.Join(append(path, property), ".")
+
+		return []usecases.FieldError{{Field: field, Rule: usecases.RuleUnknown, Message: "is not allowed"}}, true
+	}
+
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	// JSON корректен, значит, значение поля не того типа; какой тип ожидался, ogen в ошибке не сообщает
+	return []usecases.FieldError{{Field: strings.Join(path, "."), Rule: usecases.RuleType, Message: "has the wrong type"}}, true
+}
+
+// validateFieldErrors раскладывает ошибку validate.Error на нарушения по полям; элементы массива ogen
+// называет "[i]", в пути они становятся индексом.
+func validateFieldErrors(validateErr *validate.Error, path []string) []usecases.FieldError {
+	var fields []usecases.FieldError
+
+	for _, f := range validateErr.Fields {
+		fieldPath := append(slices.Clone(path), strings.Trim(f.Name, "[]"))
+
+		var nested *validate.Error
+		if errors.As(f.Error, &nested) {
+			fields = append(fields, validateFieldErrors(nested, fieldPath)...)
+
+			continue
+		}
+
+		rule, message, _ := describeOgen(f.Error)
+
+		fields = append(fields, usecases.FieldError{Field: strings.Join(fieldPath, "."), Rule: rule, Message: message})
+	}
+
+	return fields
+}
+
+// describeOgen переводит ошибку проверки ogen в правило usecases и сообщение в том же стиле, что и у
+// shared/validation. ok = false, если ошибка не нарушение ограничения схемы: тогда правило format и текст ogen.
+func describeOgen(err error) (rule string, message string, ok bool) {
+	var (
+		minLengthErr *validate.MinLengthError
+		maxLengthErr *validate.MaxLengthError
+	)
+
+	// ogen оборачивает ошибку длины в "array" или "string", а ошибку диапазона числа пишет только текстом
+	isArray := strings.HasPrefix(err.Error(), "array: ")
+
+	root := err
+	for errors.Unwrap(root) != nil {
+		root = errors.Unwrap(root)
+	}
+
+	var value, limit int64
+
+	switch {
+	case errors.Is(err, validate.ErrFieldRequired):
+		return usecases.RuleRequired, "is required", true
+	case errors.As(err, &minLengthErr) && isArray:
+		return usecases.RuleLength, fmt.Sprintf("must contain at least %d items", minLengthErr.MinLength), true
+	case errors.As(err, &maxLengthErr) && isArray:
+		return usecases.RuleLength, fmt.Sprintf("must contain at most %d items", maxLengthErr.MaxLength), true
+	case minLengthErr != nil:
+		return usecases.RuleLength, fmt.Sprintf("must be at least %d characters long", minLengthErr.MinLength), true
+	case maxLengthErr != nil:
+		return usecases.RuleLength, fmt.Sprintf("must be at most %d characters long", maxLengthErr.MaxLength), true
+	case scanned(root.Error(), "value %d less than %d", &value, &limit):
+		return usecases.RuleRange, fmt.Sprintf("must be at least %d", limit), true
+	case scanned(root.Error(), "value %d greater than %d", &value, &limit):
+		return usecases.RuleRange, fmt.Sprintf("must be at most %d", limit), true
+	default:
+		return usecases.RuleFormat, err.Error(), false
+	}
+}
+
+// scanned - разобран ли текст по формату целиком
+func scanned(text string, format string, args ...any) bool {
+	n, err := fmt.Sscanf(text, format, args...)
+
+	return err == nil && n == len(args)
+}
+
+// ogenErrorEntry выбирает запись каталога для ошибки ogen, которая не сводится к нарушениям по полям.
+func ogenErrorEntry(err error) errcatalog.Entry {
+	var (
+		contentTypeErr *validate.InvalidContentTypeError
+		paramsErr      *ogenerrors.DecodeParamsError
+		requestErr     *ogenerrors.DecodeRequestError
+	)
+
+	switch {
+	case errors.As(err, &contentTypeErr):
+		return errcatalog.UnsupportedMediaType
+	case errors.As(err, &paramsErr):
+		return errcatalog.InvalidParameter
+	case errors.As(err, &requestErr):
+		return errcatalog.MalformedBody
+	default:
+		return errcatalog.Internal
+	}
+}
+
+// errorResponse - ответ с ошибкой по записи каталога. Нарушения по полям добавляются только к ошибке валидации;
+// внутренняя ошибка (5xx) регистрируется как инцидент, и клиент получает его ID.
+func (h *Handlers) errorResponse(ctx context.Context, err error, entry errcatalog.Entry) api.ErrorResponse {
+	response := api.ErrorResponse{
+		Code:  api.ErrorResponseCode(entry.Code),
+		Error: entry.Text(err),
+	}
+
+	if entry == errcatalog.Validation {
+		response.Details = validationDetails(err)
+	}
+
+	if entry.Status >= http.StatusInternalServerError {
+		id, debugMessage := h.incidents.Report(ctx, entry.Code, err)
+
+		if id != "" {
+			response.IncidentID = api.NewOptString(id)
+		}
+
+		if debugMessage != "" {
+			response.DebugMessage = api.NewOptString(debugMessage)
+		}
+	}
+
+	return response
+}
+
+// validationDetails - нарушения по полям из ошибки валидации; nil, если ошибка их не содержит
+func validationDetails(err error) []api.ValidationErrorDetail {
+	var validationErr *usecases.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+
+	details := make([]api.ValidationErrorDetail, 0, len(validationErr.Fields))
+
+	for _, f := range validationErr.Fields {
+		details = append(details, api.ValidationErrorDetail{
+			Field:   f.Field,
+			Rule:    f.Rule,
+			Message: f.Message,
+		})
+	}
+
+	return details
+}
+
+func (h *Handlers) UpdateUser(ctx context.Context, req *api.UpdateUserRequest, params api.UpdateUserParams) (api.UpdateUserRes, error) {
+	updateUserRequestDTO := usecases.UpdateUserRequestDTO{
+		Name:    req.Name,
+		IfMatch: etag.ParseIfMatch(params.IfMatch),
+	}
+
+	user, err := h.useCases.UpdateUser(ctx, params.ID, updateUserRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			response := api.UpdateUserApplicationJSONBadRequest(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusNotFound:
+			response := api.UpdateUserApplicationJSONNotFound(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusGone:
+			response := api.UpdateUserApplicationJSONGone(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusConflict:
+			response := api.UpdateUserApplicationJSONConflict(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusPreconditionFailed:
+			response := api.UpdateUserApplicationJSONPreconditionFailed(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.UpdateUserApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	response := api.GetUserByIdResponseHeaders{
+		ETag: etag.Format(user.Version),
+		Response: api.GetUserByIdResponse{
+			ID:   user.ID,
+			Name: user.Name,
+		},
+	}
+
+	return &response, nil
+}
+
+func (h *Handlers) PatchUser(ctx context.Context, req *api.PatchUserRequest, params api.PatchUserParams) (api.PatchUserRes, error) {
+	patchUserRequestDTO := usecases.PatchUserRequestDTO{
+		IfMatch: etag.ParseIfMatch(params.IfMatch),
+	}
+
+	if name, ok := req.Name.Get(); ok {
+		patchUserRequestDTO.Name = &name
+	}
+
+	user, err := h.useCases.PatchUser(ctx, params.ID, patchUserRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.Validation, errcatalog.NotFound, errcatalog.Gone, errcatalog.PreconditionFailed, errcatalog.AlreadyExists)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			response := api.PatchUserApplicationJSONBadRequest(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusNotFound:
+			response := api.PatchUserApplicationJSONNotFound(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusGone:
+			response := api.PatchUserApplicationJSONGone(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusConflict:
+			response := api.PatchUserApplicationJSONConflict(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusPreconditionFailed:
+			response := api.PatchUserApplicationJSONPreconditionFailed(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.PatchUserApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	response := api.GetUserByIdResponseHeaders{
+		ETag: etag.Format(user.Version),
+		Response: api.GetUserByIdResponse{
+			ID:   user.ID,
+			Name: user.Name,
+		},
+	}
+
+	return &response, nil
+}
+
+func (h *Handlers) DeleteUser(ctx context.Context, params api.DeleteUserParams) (api.DeleteUserRes, error) {
+	err := h.useCases.DeleteUser(ctx, params.ID)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound, errcatalog.Gone)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			response := api.DeleteUserApplicationJSONNotFound(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusGone:
+			response := api.DeleteUserApplicationJSONGone(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.DeleteUserApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	return &api.DeleteUserNoContent{}, nil
+}
+
+func (h *Handlers) RestoreUser(ctx context.Context, params api.RestoreUserParams) (api.RestoreUserRes, error) {
+	user, err := h.useCases.RestoreUser(ctx, params.ID)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			response := api.RestoreUserApplicationJSONNotFound(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.RestoreUserApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	response := api.GetUserByIdResponseHeaders{
+		ETag: etag.Format(user.Version),
+		Response: api.GetUserByIdResponse{
+			ID:   user.ID,
+			Name: user.Name,
+		},
+	}
+
+	return &response, nil
+}
+
+func (h *Handlers) GetUserHistory(ctx context.Context, params api.GetUserHistoryParams) (api.GetUserHistoryRes, error) {
+	history, err := h.useCases.UserHistory(ctx, params.ID)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.NotFound)
+
+		switch entry.Status {
+		case http.StatusNotFound:
+			response := api.GetUserHistoryApplicationJSONNotFound(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.GetUserHistoryApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	response := api.UserHistoryResponse{
+		Items: make([]api.UserHistoryEntry, 0, len(history)),
+	}
+
+	for _, entry := range history {
+		item := api.UserHistoryEntry{
+			Seq:      entry.Seq,
+			Action:   api.UserHistoryEntryAction(entry.Action),
+			At:       entry.At,
+			Version:  entry.Version,
+			Changes:  make([]api.UserHistoryChange, 0, len(entry.Changes)),
+			PrevHash: entry.PrevHash,
+			Hash:     entry.Hash,
+		}
+
+		if entry.Actor != "" {
+			item.Actor = api.NewOptString(entry.Actor)
+		}
+
+		for _, change := range entry.Changes {
+			item.Changes = append(item.Changes, api.UserHistoryChange{
+				Field: change.Field,
+				From:  change.From,
+				To:    change.To,
+			})
+		}
+
+		response.Items = append(response.Items, item)
+	}
+
+	return &response, nil
+}
+
+func (h *Handlers) ListUsers(ctx context.Context, params api.ListUsersParams) (api.ListUsersRes, error) {
+	listUsersRequestDTO := usecases.ListUsersRequestDTO{
+		Limit:          params.Limit.Or(0),
+		Cursor:         params.Cursor.Or(""),
+		NamePrefix:     params.NamePrefix.Or(""),
+		CreatedAfter:   params.CreatedAfter.Or(time.Time{}),
+		Sort:           params.Sort,
+		IncludeDeleted: params.IncludeDeleted.Or(false),
+	}
+
+	page, err := h.useCases.ListUsers(ctx, listUsersRequestDTO)
+	if err != nil {
+		entry := errcatalog.Lookup(err, errcatalog.InvalidSort, errcatalog.Validation, errcatalog.Forbidden)
+
+		switch entry.Status {
+		case http.StatusBadRequest:
+			response := api.ListUsersApplicationJSONBadRequest(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		case http.StatusForbidden:
+			response := api.ListUsersApplicationJSONForbidden(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		default:
+			response := api.ListUsersApplicationJSONInternalServerError(h.errorResponse(ctx, err, entry))
+
+			return &response, nil
+		}
+	}
+
+	response := api.ListUsersResponse{
+		Items: make([]api.GetUserByIdResponse, 0, len(page.Users)),
+	}
+
+	for _, user := range page.Users {
+		item := api.GetUserByIdResponse{
+			ID:   user.ID,
+			Name: user.Name,
+		}
+
+		if user.Deleted() {
+			item.DeletedAt = api.NewOptDateTime(user.DeletedAt)
+		}
+
+		response.Items = append(response.Items, item)
+	}
+
+	if page.NextCursor != "" {
+		response.NextCursor = api.NewOptString(page.NextCursor)
+	}
+
+	return &response, nil
+}
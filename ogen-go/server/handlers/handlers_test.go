@@ -2,15 +2,137 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/stretchr/testify/mock"
 
 	api "server/generated"
-	"server/usecases"
+	"shared/errcatalog"
+	"shared/incident"
+	"shared/usecases"
+	"shared/validation"
 )
 
+// specValidator - проверка по спецификации, общая для тестов
+var specValidator = sync.OnceValues(func() (*validation.Validator, error) {
+	spec, err := openapi3.NewLoader().LoadFromFile("../../openapi.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	return validation.New(spec)
+})
+
+// checkResponses - проверка ответов, прошедших через net/http-обработчик: операция определяется по запросу.
+func checkResponses(t *testing.T, next http.Handler) http.Handler {
+	t.Helper()
+
+	validator, err := specValidator()
+	if err != nil {
+		t.Fatalf("validation.New() error = %v", err)
+	}
+
+	return validator.ResponseMiddleware(func(r *http.Request, err error) {
+		t.Errorf("%s %s: %v", r.Method, r.URL, err)
+	})(next)
+}
+
+// operationRequests - корректный запрос к каждой операции: ogen вызывает обработчик только для разобранного запроса.
+var operationRequests = map[string]struct {
+	method string
+	target string
+	body   string
+}{
+	"GetUserById":      {method: http.MethodGet, target: "/users/1"},
+	"UpdateUser":       {method: http.MethodPut, target: "/users/1", body: `{"name":"Alice"}`},
+	"PatchUser":        {method: http.MethodPatch, target: "/users/1", body: `{"name":"Alice"}`},
+	"DeleteUser":       {method: http.MethodDelete, target: "/users/1"},
+	"RestoreUser":      {method: http.MethodPost, target: "/users/1:restore"},
+	"GetUserHistory":   {method: http.MethodGet, target: "/users/1/history"},
+	"ListUsers":        {method: http.MethodGet, target: "/users"},
+	"CreateUser":       {method: http.MethodPost, target: "/users", body: `{"name":"Alice"}`},
+	"CreateUsersBatch": {method: http.MethodPost, target: "/users:batch", body: `{"items":[{"name":"Alice"}]}`},
+}
+
+// checkResponse проваливает тест, если ответ обработчика на операцию operationID не соответствует спецификации.
+// Ответ кодируется сгенерированным сервером так же, как для клиента.
+func checkResponse(t *testing.T, operationID string, response any) {
+	t.Helper()
+
+	if response == nil {
+		return
+	}
+
+	request, ok := operationRequests[operationID]
+	if !ok {
+		t.Fatalf("no request for operation %s", operationID)
+	}
+
+	server, err := api.NewServer(responseHandler{response: response})
+	if err != nil {
+		t.Fatalf("NewServer() error = %v", err)
+	}
+
+	req := httptest.NewRequest(request.method, request.target, strings.NewReader(request.body))
+	req.Header.Set("If-Match", "*")
+
+	if request.body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	checkResponses(t, server).ServeHTTP(httptest.NewRecorder(), req)
+}
+
+// responseHandler отвечает на любую операцию заданным ответом.
+type responseHandler struct {
+	api.UnimplementedHandler
+	response any
+}
+
+func (h responseHandler) GetUserById(context.Context, api.GetUserByIdParams) (api.GetUserByIdRes, error) {
+	return h.response.(api.GetUserByIdRes), nil
+}
+
+func (h responseHandler) UpdateUser(context.Context, *api.UpdateUserRequest, api.UpdateUserParams) (api.UpdateUserRes, error) {
+	return h.response.(api.UpdateUserRes), nil
+}
+
+func (h responseHandler) PatchUser(context.Context, *api.PatchUserRequest, api.PatchUserParams) (api.PatchUserRes, error) {
+	return h.response.(api.PatchUserRes), nil
+}
+
+func (h responseHandler) DeleteUser(context.Context, api.DeleteUserParams) (api.DeleteUserRes, error) {
+	return h.response.(api.DeleteUserRes), nil
+}
+
+func (h responseHandler) RestoreUser(context.Context, api.RestoreUserParams) (api.RestoreUserRes, error) {
+	return h.response.(api.RestoreUserRes), nil
+}
+
+func (h responseHandler) GetUserHistory(context.Context, api.GetUserHistoryParams) (api.GetUserHistoryRes, error) {
+	return h.response.(api.GetUserHistoryRes), nil
+}
+
+func (h responseHandler) ListUsers(context.Context, api.ListUsersParams) (api.ListUsersRes, error) {
+	return h.response.(api.ListUsersRes), nil
+}
+
+func (h responseHandler) CreateUser(context.Context, *api.CreateUserRequest, api.CreateUserParams) (api.CreateUserRes, error) {
+	return h.response.(api.CreateUserRes), nil
+}
+
+func (h responseHandler) CreateUsersBatch(context.Context, *api.CreateUsersBatchRequest) (api.CreateUsersBatchRes, error) {
+	return h.response.(api.CreateUsersBatchRes), nil
+}
+
 func TestHandlers_GetUserById(t *testing.T) {
 	type fields struct {
 		setup func(t *testing.T) UseCases
@@ -19,7 +141,7 @@ func TestHandlers_GetUserById(t *testing.T) {
 		ctx     context.Context
 		request api.GetUserByIdParams
 	}
-	
+
 	tests := []struct {
 		name    string
 		fields  fields
@@ -37,8 +159,9 @@ func TestHandlers_GetUserById(t *testing.T) {
 						GetUser(mock.Anything, 1).
 						Return(
 							usecases.User{
-								ID:   1,
-								Name: "Alice",
+								ID:      1,
+								Name:    "Alice",
+								Version: 3,
 							},
 							nil,
 						).
@@ -51,9 +174,42 @@ func TestHandlers_GetUserById(t *testing.T) {
 				ctx:     context.Background(),
 				request: api.GetUserByIdParams{ID: 1},
 			},
-			want: &api.GetUserByIdResponse{
-				ID:   1,
-				Name: "Alice",
+			want: &api.GetUserByIdResponseHeaders{
+				ETag: `"3"`,
+				Response: api.GetUserByIdResponse{
+					ID:   1,
+					Name: "Alice",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not modified",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 1).
+						Return(
+							usecases.User{
+								ID:      1,
+								Name:    "Alice",
+								Version: 3,
+							},
+							nil,
+						).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: api.GetUserByIdParams{ID: 1, IfNoneMatch: api.NewOptString(`"3"`)},
+			},
+			want: &api.GetUserByIdNotModified{
+				ETag: `"3"`,
 			},
 			wantErr: false,
 		},
@@ -78,12 +234,36 @@ func TestHandlers_GetUserById(t *testing.T) {
 				ctx:     context.Background(),
 				request: api.GetUserByIdParams{ID: 2},
 			},
-			want: &api.GetUserByIdNotFound{
+			want: &api.GetUserByIdApplicationJSONNotFound{
 				Code:  404,
 				Error: "Not Found",
 			},
 			wantErr: false,
 		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						GetUser(mock.Anything, 6).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: api.GetUserByIdParams{ID: 6},
+			},
+			want: &api.GetUserByIdApplicationJSONGone{
+				Code:  410,
+				Error: "Gone",
+			},
+			wantErr: false,
+		},
 		{
 			name: "internal error 1",
 			fields: fields{
@@ -104,7 +284,7 @@ func TestHandlers_GetUserById(t *testing.T) {
 				ctx:     context.Background(),
 				request: api.GetUserByIdParams{ID: 3},
 			},
-			want: &api.GetUserByIdInternalServerError{
+			want: &api.GetUserByIdApplicationJSONInternalServerError{
 				Code:  1,
 				Error: "Internal Server Error 1",
 			},
@@ -131,7 +311,7 @@ func TestHandlers_GetUserById(t *testing.T) {
 				ctx:     context.Background(),
 				request: api.GetUserByIdParams{ID: 4},
 			},
-			want: &api.GetUserByIdInternalServerError{
+			want: &api.GetUserByIdApplicationJSONInternalServerError{
 				Code:  2,
 				Error: "Internal Server Error 2",
 			},
@@ -158,7 +338,7 @@ func TestHandlers_GetUserById(t *testing.T) {
 				ctx:     context.Background(),
 				request: api.GetUserByIdParams{ID: 5},
 			},
-			want: &api.GetUserByIdInternalServerError{
+			want: &api.GetUserByIdApplicationJSONInternalServerError{
 				Code:  -1,
 				Error: "Internal Server Error",
 			},
@@ -181,6 +361,8 @@ func TestHandlers_GetUserById(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("GetUserById() got = %v, want %v", got, tt.want)
 			}
+
+			checkResponse(t, "GetUserById", got)
 		})
 	}
 }
@@ -247,12 +429,46 @@ func TestHandlers_CreateUser(t *testing.T) {
 				ctx:     context.Background(),
 				request: &api.CreateUserRequest{Name: ""},
 			},
-			want: &api.CreateUserBadRequest{
+			want: &api.CreateUserApplicationJSONBadRequest{
 				Code:  3,
 				Error: usecases.ErrValidation.Error(),
 			},
 			wantErr: false,
 		},
+		{
+			name: "validation error with details",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsers(
+							mock.Anything,
+							usecases.CreateUserRequestDTO{Name: "<script>"},
+						).
+						Return(0, &usecases.ValidationError{
+							Fields: []usecases.FieldError{
+								{Field: "name", Rule: usecases.RuleCharset, Message: "may contain only letters, digits, spaces and - ' . _"},
+							},
+						}).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.CreateUserRequest{Name: "<script>"},
+			},
+			want: &api.CreateUserApplicationJSONBadRequest{
+				Code:  3,
+				Error: "validation error: name may contain only letters, digits, spaces and - ' . _",
+				Details: []api.ValidationErrorDetail{
+					{Field: "name", Rule: "charset", Message: "may contain only letters, digits, spaces and - ' . _"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "internal server error",
 			fields: fields{
@@ -274,7 +490,7 @@ func TestHandlers_CreateUser(t *testing.T) {
 				ctx:     context.Background(),
 				request: &api.CreateUserRequest{Name: "Bob"},
 			},
-			want: &api.CreateUserInternalServerError{
+			want: &api.CreateUserApplicationJSONInternalServerError{
 				Code:  -1,
 				Error: "Internal Server Error",
 			},
@@ -288,7 +504,7 @@ func TestHandlers_CreateUser(t *testing.T) {
 				useCases: tt.fields.setup(t),
 			}
 
-			got, err := h.CreateUser(tt.args.ctx, tt.args.request)
+			got, err := h.CreateUser(tt.args.ctx, tt.args.request, api.CreateUserParams{})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CreateUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -297,6 +513,1637 @@ func TestHandlers_CreateUser(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("CreateUser() got = %v, want %v", got, tt.want)
 			}
+
+			checkResponse(t, "CreateUser", got)
+		})
+	}
+}
+
+func TestHandlers_CreateUsersBatch(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request *api.CreateUsersBatchRequest
+	}
+
+	items := []api.CreateUserRequest{{Name: "Alice"}, {Name: ""}}
+	itemsDTO := []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: ""}}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.CreateUsersBatchRes
+		wantErr bool
+	}{
+		{
+			name: "partial success",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{
+							Results: []usecases.CreateUserResult{{ID: 10}, {Err: usecases.ErrValidation}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.CreateUsersBatchRequest{Items: items},
+			},
+			want: &api.CreateUsersBatchOK{
+				Results: []api.CreateUsersBatchResult{
+					{ID: api.NewOptInt(10)},
+					{Error: api.NewOptErrorResponse(api.ErrorResponse{Code: 3, Error: usecases.ErrValidation.Error()})},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "all or nothing rolled back",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO, AllOrNothing: true}).
+						Return(usecases.CreateUsersBatchResult{
+							Results:    []usecases.CreateUserResult{{Err: usecases.ErrRolledBack}, {Err: usecases.ErrValidation}},
+							RolledBack: true,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.CreateUsersBatchRequest{Items: items, AllOrNothing: api.NewOptBool(true)},
+			},
+			want: &api.CreateUsersBatchUnprocessableEntity{
+				Results: []api.CreateUsersBatchResult{
+					{Error: api.NewOptErrorResponse(api.ErrorResponse{Code: 5, Error: usecases.ErrRolledBack.Error()})},
+					{Error: api.NewOptErrorResponse(api.ErrorResponse{Code: 3, Error: usecases.ErrValidation.Error()})},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "too large batch",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.CreateUsersBatchRequest{Items: items},
+			},
+			want: &api.CreateUsersBatchApplicationJSONBadRequest{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						CreateUsersBatch(mock.Anything, usecases.CreateUsersBatchRequestDTO{Items: itemsDTO}).
+						Return(usecases.CreateUsersBatchResult{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.CreateUsersBatchRequest{Items: items},
+			},
+			want: &api.CreateUsersBatchApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.CreateUsersBatch(tt.args.ctx, tt.args.request)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateUsersBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreateUsersBatch() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "CreateUsersBatch", got)
+		})
+	}
+}
+
+func TestHandlers_UpdateUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request *api.UpdateUserRequest
+		params  api.UpdateUserParams
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.UpdateUserRes
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.UpdateUserRequest{Name: "Bob"},
+				params:  api.UpdateUserParams{ID: 1, IfMatch: `"1"`},
+			},
+			want: &api.GetUserByIdResponseHeaders{
+				ETag: `"2"`,
+				Response: api.GetUserByIdResponse{
+					ID:   1,
+					Name: "Bob",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "validation error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: ""},
+						).
+						Return(usecases.User{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.UpdateUserRequest{Name: ""},
+				params:  api.UpdateUserParams{ID: 1},
+			},
+			want: &api.UpdateUserApplicationJSONBadRequest{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							2,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.UpdateUserRequest{Name: "Bob"},
+				params:  api.UpdateUserParams{ID: 2},
+			},
+			want: &api.UpdateUserApplicationJSONNotFound{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "gone",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							4,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.UpdateUserRequest{Name: "Bob"},
+				params:  api.UpdateUserParams{ID: 4},
+			},
+			want: &api.UpdateUserApplicationJSONGone{
+				Code:  410,
+				Error: "Gone",
+			},
+			wantErr: false,
+		},
+		{
+			name: "precondition failed",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							1,
+							usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.UpdateUserRequest{Name: "Bob"},
+				params:  api.UpdateUserParams{ID: 1, IfMatch: `"1"`},
+			},
+			want: &api.UpdateUserApplicationJSONPreconditionFailed{
+				Code:  8,
+				Error: "Precondition Failed",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UpdateUser(
+							mock.Anything,
+							3,
+							usecases.UpdateUserRequestDTO{Name: "Bob"},
+						).
+						Return(usecases.User{}, usecases.ErrNotPublic1).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.UpdateUserRequest{Name: "Bob"},
+				params:  api.UpdateUserParams{ID: 3},
+			},
+			want: &api.UpdateUserApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.UpdateUser(tt.args.ctx, tt.args.request, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "UpdateUser", got)
+		})
+	}
+}
+
+func TestHandlers_PatchUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx     context.Context
+		request *api.PatchUserRequest
+		params  api.PatchUserParams
+	}
+
+	name := "Bob"
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.PatchUserRes
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{ID: 1, Name: "Bob", Version: 2}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.PatchUserRequest{Name: api.NewOptString("Bob")},
+				params:  api.PatchUserParams{ID: 1, IfMatch: `"1"`},
+			},
+			want: &api.GetUserByIdResponseHeaders{
+				ETag: `"2"`,
+				Response: api.GetUserByIdResponse{
+					ID:   1,
+					Name: "Bob",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty patch",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{IfMatch: usecases.VersionMatch{Any: true}},
+						).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 4}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.PatchUserRequest{},
+				params:  api.PatchUserParams{ID: 1, IfMatch: "*"},
+			},
+			want: &api.GetUserByIdResponseHeaders{
+				ETag: `"4"`,
+				Response: api.GetUserByIdResponse{
+					ID:   1,
+					Name: "Alice",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							2,
+							usecases.PatchUserRequestDTO{Name: &name},
+						).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.PatchUserRequest{Name: api.NewOptString("Bob")},
+				params:  api.PatchUserParams{ID: 2},
+			},
+			want: &api.PatchUserApplicationJSONNotFound{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "precondition failed",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							1,
+							usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{1}}},
+						).
+						Return(usecases.User{}, usecases.ErrPreconditionFailed).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.PatchUserRequest{Name: api.NewOptString("Bob")},
+				params:  api.PatchUserParams{ID: 1, IfMatch: `"1"`},
+			},
+			want: &api.PatchUserApplicationJSONPreconditionFailed{
+				Code:  8,
+				Error: "Precondition Failed",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						PatchUser(
+							mock.Anything,
+							3,
+							usecases.PatchUserRequestDTO{Name: &name},
+						).
+						Return(usecases.User{}, usecases.ErrNotPublic2).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:     context.Background(),
+				request: &api.PatchUserRequest{Name: api.NewOptString("Bob")},
+				params:  api.PatchUserParams{ID: 3},
+			},
+			want: &api.PatchUserApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.PatchUser(tt.args.ctx, tt.args.request, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PatchUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PatchUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "PatchUser", got)
+		})
+	}
+}
+
+func TestHandlers_DeleteUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx    context.Context
+		params api.DeleteUserParams
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.DeleteUserRes
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 1).
+						Return(nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.DeleteUserParams{ID: 1},
+			},
+			want:    &api.DeleteUserNoContent{},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 2).
+						Return(usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.DeleteUserParams{ID: 2},
+			},
+			want: &api.DeleteUserApplicationJSONNotFound{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "already deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 4).
+						Return(usecases.ErrGone).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.DeleteUserParams{ID: 4},
+			},
+			want: &api.DeleteUserApplicationJSONGone{
+				Code:  410,
+				Error: "Gone",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						DeleteUser(mock.Anything, 3).
+						Return(usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.DeleteUserParams{ID: 3},
+			},
+			want: &api.DeleteUserApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.DeleteUser(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DeleteUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "DeleteUser", got)
+		})
+	}
+}
+
+func TestHandlers_RestoreUser(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx    context.Context
+		params api.RestoreUserParams
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.RestoreUserRes
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 1).
+						Return(usecases.User{ID: 1, Name: "Alice", Version: 3}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.RestoreUserParams{ID: 1},
+			},
+			want: &api.GetUserByIdResponseHeaders{
+				ETag: `"3"`,
+				Response: api.GetUserByIdResponse{
+					ID:   1,
+					Name: "Alice",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 2).
+						Return(usecases.User{}, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.RestoreUserParams{ID: 2},
+			},
+			want: &api.RestoreUserApplicationJSONNotFound{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						RestoreUser(mock.Anything, 3).
+						Return(usecases.User{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.RestoreUserParams{ID: 3},
+			},
+			want: &api.RestoreUserApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.RestoreUser(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RestoreUser() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestoreUser() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "RestoreUser", got)
+		})
+	}
+}
+
+// Путь с пользовательским методом ":restore" должен доходить до RestoreUser с числовым id.
+func TestHandlers_RestoreUser_Routing(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		RestoreUser(mock.Anything, 7).
+		Return(usecases.User{ID: 7, Name: "Alice", Version: 2}, nil).
+		Once()
+
+	server, err := api.NewServer(New(m, nil))
+	if err != nil {
+		t.Fatalf("NewServer() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/users/7:restore", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, server).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+func TestHandlers_GetUserHistory(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx    context.Context
+		params api.GetUserHistoryParams
+	}
+
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	actor := "admin"
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.GetUserHistoryRes
+		wantErr bool
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 1).
+						Return([]usecases.AuditEntry{
+							{
+								Seq:     1,
+								UserID:  1,
+								Action:  usecases.AuditActionCreate,
+								Actor:   actor,
+								At:      at,
+								Version: 1,
+								Changes: []usecases.AuditChange{{Field: "name", To: "Alice"}},
+								Hash:    "h1",
+							},
+							{
+								Seq:      3,
+								UserID:   1,
+								Action:   usecases.AuditActionDelete,
+								At:       at,
+								Version:  2,
+								Changes:  []usecases.AuditChange{},
+								PrevHash: "h2",
+								Hash:     "h3",
+							},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.GetUserHistoryParams{ID: 1},
+			},
+			want: &api.UserHistoryResponse{
+				Items: []api.UserHistoryEntry{
+					{
+						Seq:     1,
+						Action:  api.UserHistoryEntryActionCreate,
+						Actor:   api.NewOptString(actor),
+						At:      at,
+						Version: 1,
+						Changes: []api.UserHistoryChange{{Field: "name", To: "Alice"}},
+						Hash:    "h1",
+					},
+					{
+						Seq:      3,
+						Action:   api.UserHistoryEntryActionDelete,
+						At:       at,
+						Version:  2,
+						Changes:  []api.UserHistoryChange{},
+						PrevHash: "h2",
+						Hash:     "h3",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "not found",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 2).
+						Return(nil, usecases.ErrNotFound).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.GetUserHistoryParams{ID: 2},
+			},
+			want: &api.GetUserHistoryApplicationJSONNotFound{
+				Code:  404,
+				Error: "Not Found",
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						UserHistory(mock.Anything, 3).
+						Return(nil, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.GetUserHistoryParams{ID: 3},
+			},
+			want: &api.GetUserHistoryApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.GetUserHistory(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetUserHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetUserHistory() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "GetUserHistory", got)
+		})
+	}
+}
+
+func TestHandlers_ListUsers(t *testing.T) {
+	type fields struct {
+		setup func(t *testing.T) UseCases
+	}
+	type args struct {
+		ctx    context.Context
+		params api.ListUsersParams
+	}
+
+	cursor := "eyJhZnRlcl9pZCI6Mn0"
+	nextCursor := "eyJhZnRlcl9pZCI6NH0"
+	createdAfter := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    api.ListUsersRes
+		wantErr bool
+	}{
+		{
+			name: "first page",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.ListUsersParams{Limit: api.NewOptInt(20)},
+			},
+			want: &api.ListUsersResponse{
+				Items: []api.GetUserByIdResponse{{ID: 1, Name: "Alice"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "page with cursor",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 2, Cursor: cursor}).
+						Return(usecases.UsersPage{
+							Users:      []usecases.User{{ID: 3, Name: "Carol"}, {ID: 4, Name: "Dave"}},
+							NextCursor: nextCursor,
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: api.ListUsersParams{
+					Limit:  api.NewOptInt(2),
+					Cursor: api.NewOptString(cursor),
+				},
+			},
+			want: &api.ListUsersResponse{
+				Items:      []api.GetUserByIdResponse{{ID: 3, Name: "Carol"}, {ID: 4, Name: "Dave"}},
+				NextCursor: api.NewOptString(nextCursor),
+			},
+			wantErr: false,
+		},
+		{
+			name: "filters and sort",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+							Limit:        20,
+							NamePrefix:   "Al",
+							CreatedAfter: createdAfter,
+							Sort:         []string{"name", "-id"},
+						}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: api.ListUsersParams{
+					Limit:        api.NewOptInt(20),
+					NamePrefix:   api.NewOptString("Al"),
+					CreatedAfter: api.NewOptDateTime(createdAfter),
+					Sort:         []string{"name", "-id"},
+				},
+			},
+			want: &api.ListUsersResponse{
+				Items: []api.GetUserByIdResponse{{ID: 1, Name: "Alice"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "include deleted",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, IncludeDeleted: true}).
+						Return(usecases.UsersPage{
+							Users: []usecases.User{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob", DeletedAt: deletedAt}},
+						}, nil).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: api.ListUsersParams{
+					Limit:          api.NewOptInt(20),
+					IncludeDeleted: api.NewOptBool(true),
+				},
+			},
+			want: &api.ListUsersResponse{
+				Items: []api.GetUserByIdResponse{
+					{ID: 1, Name: "Alice"},
+					{ID: 2, Name: "Bob", DeletedAt: api.NewOptDateTime(deletedAt)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid sort",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, Sort: []string{"email"}}).
+						Return(usecases.UsersPage{}, usecases.ErrInvalidSort).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: api.ListUsersParams{
+					Limit: api.NewOptInt(20),
+					Sort:  []string{"email"},
+				},
+			},
+			want: &api.ListUsersApplicationJSONBadRequest{
+				Code:  4,
+				Error: usecases.ErrInvalidSort.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "include deleted without operator token",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, IncludeDeleted: true}).
+						Return(usecases.UsersPage{}, usecases.ErrForbidden).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: api.ListUsersParams{
+					Limit:          api.NewOptInt(20),
+					IncludeDeleted: api.NewOptBool(true),
+				},
+			},
+			want: &api.ListUsersApplicationJSONForbidden{
+				Code:  15,
+				Error: "Forbidden",
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid cursor",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20, Cursor: cursor}).
+						Return(usecases.UsersPage{}, usecases.ErrValidation).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				params: api.ListUsersParams{
+					Limit:  api.NewOptInt(20),
+					Cursor: api.NewOptString(cursor),
+				},
+			},
+			want: &api.ListUsersApplicationJSONBadRequest{
+				Code:  3,
+				Error: usecases.ErrValidation.Error(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "internal server error",
+			fields: fields{
+				setup: func(t *testing.T) UseCases {
+					m := NewMockUseCases(t)
+
+					m.EXPECT().
+						ListUsers(mock.Anything, usecases.ListUsersRequestDTO{Limit: 20}).
+						Return(usecases.UsersPage{}, usecases.ErrUnknown).
+						Once()
+
+					return m
+				},
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: api.ListUsersParams{Limit: api.NewOptInt(20)},
+			},
+			want: &api.ListUsersApplicationJSONInternalServerError{
+				Code:  -1,
+				Error: "Internal Server Error",
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handlers{
+				useCases: tt.fields.setup(t),
+			}
+
+			got, err := h.ListUsers(tt.args.ctx, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListUsers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListUsers() got = %v, want %v", got, tt.want)
+			}
+
+			checkResponse(t, "ListUsers", got)
+		})
+	}
+}
+
+// Параметры фильтрации и сортировки должны разбираться сгенерированным биндингом запроса.
+func TestHandlers_ListUsers_QueryBinding(t *testing.T) {
+	m := NewMockUseCases(t)
+
+	m.EXPECT().
+		ListUsers(mock.Anything, usecases.ListUsersRequestDTO{
+			Limit:          5,
+			NamePrefix:     "Al",
+			CreatedAfter:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Sort:           []string{"name", "-id"},
+			IncludeDeleted: true,
+		}).
+		Return(usecases.UsersPage{}, nil).
+		Once()
+
+	server, err := api.NewServer(New(m, nil))
+	if err != nil {
+		t.Fatalf("NewServer() error = %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/users?limit=5&name_prefix=Al&created_after=2025-01-01T00:00:00Z&sort=name,-id&include_deleted=true", nil)
+	rr := httptest.NewRecorder()
+
+	checkResponses(t, server).ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d; body: %s", rr.Code, http.StatusOK, rr.Body.String())
+	}
+}
+
+func TestHandlers_Incident(t *testing.T) {
+	notPublic := fmt.Errorf("load user: %w", usecases.ErrNotPublic1)
+
+	tests := []struct {
+		name             string
+		err              error
+		debug            bool
+		wantIncident     bool
+		wantDebugMessage string
+	}{
+		{
+			name:         "internal error is recorded",
+			err:          notPublic,
+			wantIncident: true,
+		},
+		{
+			name:             "debug mode returns the underlying message",
+			err:              notPublic,
+			debug:            true,
+			wantIncident:     true,
+			wantDebugMessage: "load user: we can't expose this text 1",
+		},
+		{
+			name:  "client error is not an incident",
+			err:   usecases.ErrNotFound,
+			debug: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMockUseCases(t)
+
+			m.EXPECT().
+				GetUser(mock.Anything, 1).
+				Return(usecases.User{}, tt.err).
+				Once()
+
+			sink := incident.NewMemory()
+			h := New(m, incident.New(sink))
+
+			ctx := context.Background()
+			if tt.debug {
+				ctx = incident.WithDebug(ctx)
+			}
+
+			response, err := h.GetUserById(ctx, api.GetUserByIdParams{ID: 1})
+			if err != nil {
+				t.Fatalf("GetUserById() error = %v", err)
+			}
+
+			checkResponse(t, "GetUserById", response)
+
+			var got api.ErrorResponse
+
+			switch r := response.(type) {
+			case *api.GetUserByIdApplicationJSONInternalServerError:
+				got = api.ErrorResponse(*r)
+			case *api.GetUserByIdApplicationJSONNotFound:
+				got = api.ErrorResponse(*r)
+			default:
+				t.Fatalf("GetUserById() = %T, want error response", response)
+			}
+
+			if got.DebugMessage.Or("") != tt.wantDebugMessage {
+				t.Fatalf("debug_message = %q, want %q", got.DebugMessage.Or(""), tt.wantDebugMessage)
+			}
+
+			id, ok := got.IncidentID.Get()
+			if ok != tt.wantIncident {
+				t.Fatalf("incident_id = %q, present = %v, want present = %v", id, ok, tt.wantIncident)
+			}
+
+			if !tt.wantIncident {
+				return
+			}
+
+			recorded, err := sink.Get(id)
+			if err != nil {
+				t.Fatalf("sink.Get() error = %v", err)
+			}
+
+			wantChain := []string{tt.err.Error(), usecases.ErrNotPublic1.Error()}
+			if recorded.Code != 1 || !reflect.DeepEqual(recorded.Chain, wantChain) {
+				t.Fatalf("incident = %+v, want code 1 and chain %q", recorded, wantChain)
+			}
+		})
+	}
+}
+
+func TestHandlers_FrameworkErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		body           string
+		wantStatusCode int
+		wantEntry      errcatalog.Entry
+		wantAllow      string
+	}{
+		{
+			name:           "invalid path parameter",
+			method:         http.MethodGet,
+			target:         "/users/abc",
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.InvalidParameter,
+		},
+		{
+			name:           "invalid query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=many",
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.InvalidParameter,
+		},
+		{
+			name:           "malformed body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.MalformedBody,
+		},
+		{
+			name:           "unsupported body content type",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "text/plain",
+			body:           "Alice",
+			wantStatusCode: http.StatusUnsupportedMediaType,
+			wantEntry:      errcatalog.UnsupportedMediaType,
+		},
+		{
+			name:           "unknown path",
+			method:         http.MethodGet,
+			target:         "/accounts",
+			wantStatusCode: http.StatusNotFound,
+			wantEntry:      errcatalog.RouteNotFound,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPut,
+			target:         "/users",
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantEntry:      errcatalog.MethodNotAllowed,
+			wantAllow:      "GET,POST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New(NewMockUseCases(t), nil)
+
+			server, err := api.NewServer(
+				h,
+				api.WithErrorHandler(h.ErrorHandler),
+				api.WithNotFound(h.NotFound),
+				api.WithMethodNotAllowed(h.MethodNotAllowed),
+			)
+			if err != nil {
+				t.Fatalf("NewServer() error = %v", err)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, server).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			var got api.ErrorResponse
+
+			err = got.UnmarshalJSON(rr.Body.Bytes())
+			if err != nil {
+				t.Fatalf("failed to unmarshal response body: %v; raw: %q", err, rr.Body.String())
+			}
+
+			if got.Code != api.ErrorResponseCode(tt.wantEntry.Code) || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantEntry.Code)
+			}
+
+			if allow := rr.Header().Get("Allow"); allow != tt.wantAllow {
+				t.Fatalf("Allow = %q, want %q", allow, tt.wantAllow)
+			}
+		})
+	}
+}
+
+func TestHandlers_RequestValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		contentType    string
+		idempotencyKey string
+		body           string
+		wantStatusCode int
+		wantEntry      errcatalog.Entry
+		wantDetails    []api.ValidationErrorDetail
+	}{
+		{
+			name:           "missing required field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "name", Rule: usecases.RuleRequired, Message: "is required"}},
+		},
+		{
+			name:           "unknown field",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":"Alice","admin":true}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "admin", Rule: usecases.RuleUnknown, Message: "is not allowed"}},
+		},
+		{
+			name:           "field of the wrong type",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":5}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "name", Rule: usecases.RuleType, Message: "has the wrong type"}},
+		},
+		{
+			name:           "empty batch",
+			method:         http.MethodPost,
+			target:         "/users:batch",
+			contentType:    "application/json",
+			body:           `{"items":[]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "items", Rule: usecases.RuleLength, Message: "must contain at least 1 items"}},
+		},
+		{
+			name:           "batch item without a required field",
+			method:         http.MethodPost,
+			target:         "/users:batch",
+			contentType:    "application/json",
+			body:           `{"items":[{"name":"Alice"},{}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "items.name", Rule: usecases.RuleRequired, Message: "is required"}},
+		},
+		{
+			name:           "too long header parameter",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			idempotencyKey: strings.Repeat("k", 256),
+			body:           `{"name":"Alice"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "Idempotency-Key", Rule: usecases.RuleLength, Message: "must be at most 255 characters long"}},
+		},
+		{
+			name:           "missing body",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.MalformedBody,
+		},
+		{
+			name:           "body that is not JSON",
+			method:         http.MethodPost,
+			target:         "/users",
+			contentType:    "application/json",
+			body:           `{"name":}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.MalformedBody,
+		},
+		{
+			name:           "missing required header parameter",
+			method:         http.MethodPut,
+			target:         "/users/1",
+			contentType:    "application/json",
+			body:           `{"name":"Alice"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.InvalidParameter,
+		},
+		{
+			name:           "out of range query parameter",
+			method:         http.MethodGet,
+			target:         "/users?limit=500",
+			wantStatusCode: http.StatusBadRequest,
+			wantEntry:      errcatalog.Validation,
+			wantDetails:    []api.ValidationErrorDetail{{Field: "limit", Rule: usecases.RuleRange, Message: "must be at most 100"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// usecases не должны вызываться: запрос отклоняется до обработчика
+			h := New(NewMockUseCases(t), nil)
+
+			server, err := api.NewServer(h, api.WithErrorHandler(h.ErrorHandler))
+			if err != nil {
+				t.Fatalf("NewServer() error = %v", err)
+			}
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			if tt.idempotencyKey != "" {
+				req.Header.Set("Idempotency-Key", tt.idempotencyKey)
+			}
+
+			rr := httptest.NewRecorder()
+
+			checkResponses(t, server).ServeHTTP(rr, req)
+
+			if rr.Code != tt.wantStatusCode {
+				t.Fatalf("status code = %d, want %d; body: %s", rr.Code, tt.wantStatusCode, rr.Body.String())
+			}
+
+			var got api.ErrorResponse
+
+			err = got.UnmarshalJSON(rr.Body.Bytes())
+			if err != nil {
+				t.Fatalf("failed to unmarshal response body: %v; raw: %q", err, rr.Body.String())
+			}
+
+			if got.Code != api.ErrorResponseCode(tt.wantEntry.Code) || got.Error == "" {
+				t.Fatalf("body = %+v, want code %d with a message", got, tt.wantEntry.Code)
+			}
+
+			if !reflect.DeepEqual(got.Details, tt.wantDetails) {
+				t.Fatalf("details = %+v, want %+v", got.Details, tt.wantDetails)
+			}
 		})
 	}
 }
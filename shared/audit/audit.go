@@ -0,0 +1,496 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"shared/usecases"
+)
+
+// ErrTampered - запись журнала переписана, удалена или вставлена.
+var ErrTampered = errors.New("audit log tampered")
+
+// Log - журнал изменений в виде хэш-цепочки: каждая запись содержит хэш предыдущей.
+// Записи хранятся в памяти; журнал, открытый через Open, дополнительно дописывается в файл (JSON lines),
+// а номер и хэш его последней записи - в контрольную точку рядом с ним (см. checkpoint).
+type Log struct {
+	mu      sync.RWMutex
+	entries []usecases.AuditEntry
+	// byUser - индексы записей в entries по ID пользователя
+	byUser map[int][]int
+	path   string
+	file   logFile
+	size   int64
+}
+
+// checkpoint - последняя запись журнала, сохраненная в отдельном файле (путь журнала + ".head"). Цепочка сама
+// не выявляет записи, отрезанные с конца файла, а контрольная точка - выявляет: журнал не может кончаться
+// раньше нее. Длиннее он быть может - после сбоя между записью в журнал и обновлением контрольной точки.
+type checkpoint struct {
+	Seq  int    `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// logFile - открытый файл журнала; тесты подменяют его файлом, который возвращает ошибки
+type logFile interface {
+	io.ReadWriter
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+type record struct {
+	Seq    int    `json:"seq"`
+	UserID int    `json:"user_id"`
+	Action string `json:"action"`
+	Actor  string `json:"actor,omitempty"`
+	// At - в RFC 3339 с наносекундами в UTC, чтобы хэш не зависел от представления времени
+	At       string   `json:"at"`
+	Version  int      `json:"version"`
+	Changes  []change `json:"changes"`
+	PrevHash string   `json:"prev_hash"`
+	Hash     string   `json:"hash,omitempty"`
+}
+
+type change struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// New создает пустой журнал в памяти.
+func New() *Log {
+	return &Log{
+		byUser: make(map[int][]int),
+	}
+}
+
+// Open загружает журнал из файла path (создавая его при отсутствии) и проверяет цепочку и контрольную точку.
+// Оборванная последняя запись (сбой посреди записи) отрезается; переписанная, удаленная или отрезанная
+// с конца запись - ошибка.
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+
+	l := New()
+	l.path = path
+	l.file = file
+
+	err = l.open()
+	if err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *Log) open() error {
+	torn, err := l.load()
+	if err != nil {
+		return err
+	}
+
+	if torn {
+		err = l.file.Truncate(l.size)
+		if err != nil {
+			return fmt.Errorf("truncate audit log: %w", err)
+		}
+	}
+
+	err = Verify(l.entries)
+	if err != nil {
+		return err
+	}
+
+	head, err := readCheckpoint(checkpointPath(l.path))
+	if errors.Is(err, os.ErrNotExist) && len(l.entries) == 0 {
+		// новый журнал
+		return l.writeCheckpoint()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = verifyCheckpoint(l.entries, head)
+	if err != nil {
+		return err
+	}
+
+	if head.Seq < len(l.entries) {
+		// сбой между записью в журнал и обновлением контрольной точки
+		return l.writeCheckpoint()
+	}
+
+	return nil
+}
+
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	return l.file.Close()
+}
+
+func (l *Log) Append(ctx context.Context, entry usecases.AuditEntry) (usecases.AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.Seq = len(l.entries) + 1
+	entry.PrevHash = ""
+	entry.Changes = slices.Clone(entry.Changes)
+
+	if len(l.entries) > 0 {
+		entry.PrevHash = l.entries[len(l.entries)-1].Hash
+	}
+
+	entry.Hash = Hash(entry)
+
+	if l.file != nil {
+		err := l.write(entry)
+		if err != nil {
+			return usecases.AuditEntry{}, err
+		}
+	}
+
+	l.add(entry)
+
+	if l.file != nil {
+		// запись уже в журнале, и Open ее примет: журнал может быть длиннее контрольной точки
+		err := l.writeCheckpoint()
+		if err != nil {
+			return cloneEntry(entry), err
+		}
+	}
+
+	return cloneEntry(entry), nil
+}
+
+func (l *Log) UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	history := make([]usecases.AuditEntry, 0, len(l.byUser[id]))
+
+	for _, i := range l.byUser[id] {
+		history = append(history, cloneEntry(l.entries[i]))
+	}
+
+	return history, nil
+}
+
+// Verify проверяет цепочку всего журнала. У журнала из файла заново читается и файл: в нем должны
+// остаться все записи, а последняя - совпасть с контрольной точкой.
+func (l *Log) Verify() error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	err := Verify(l.entries)
+	if err != nil || l.path == "" {
+		return err
+	}
+
+	file, err := os.Open(l.path)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	defer file.Close()
+
+	entries, _, _, err := readEntries(file)
+	if err != nil {
+		return err
+	}
+
+	err = Verify(entries)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) != len(l.entries) {
+		return fmt.Errorf("%w: file has %d entries, log has %d", ErrTampered, len(entries), len(l.entries))
+	}
+
+	head, err := readCheckpoint(checkpointPath(l.path))
+	if err != nil {
+		return err
+	}
+
+	return verifyCheckpoint(entries, head)
+}
+
+// Verify проверяет, что entries - непрерывная цепочка с начала журнала: номера идут подряд с 1,
+// PrevHash каждой записи равен хэшу предыдущей, а Hash совпадает с пересчитанным.
+// Удаление записей с конца цепочка сама по себе не выявляет - для этого журнал из файла сверяется
+// с контрольной точкой (см. Open).
+func Verify(entries []usecases.AuditEntry) error {
+	prevHash := ""
+
+	for i, entry := range entries {
+		if entry.Seq != i+1 {
+			return fmt.Errorf("%w: entry %d has seq %d", ErrTampered, i+1, entry.Seq)
+		}
+
+		if entry.PrevHash != prevHash {
+			return fmt.Errorf("%w: entry %d does not follow entry %d", ErrTampered, entry.Seq, entry.Seq-1)
+		}
+
+		if Hash(entry) != entry.Hash {
+			return fmt.Errorf("%w: entry %d was rewritten", ErrTampered, entry.Seq)
+		}
+
+		prevHash = entry.Hash
+	}
+
+	return nil
+}
+
+// Hash считает SHA-256 записи (без поля Hash) в каноническом JSON-представлении.
+func Hash(entry usecases.AuditEntry) string {
+	rec := toRecord(entry)
+	rec.Hash = ""
+
+	// структура из строк и чисел всегда сериализуется
+	data, _ := json.Marshal(rec)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (l *Log) add(entry usecases.AuditEntry) {
+	l.entries = append(l.entries, entry)
+	l.byUser[entry.UserID] = append(l.byUser[entry.UserID], len(l.entries)-1)
+}
+
+// write дописывает запись в файл и дожидается fsync.
+func (l *Log) write(entry usecases.AuditEntry) error {
+	line, err := json.Marshal(toRecord(entry))
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %w", err)
+	}
+
+	line = append(line, '\n')
+
+	n, err := l.file.Write(line)
+	if err != nil {
+		// не даем недописанной записи остаться в файле
+		truncateErr := l.file.Truncate(l.size)
+
+		return errors.Join(fmt.Errorf("write audit log: %w", err), truncateErr)
+	}
+
+	err = l.file.Sync()
+	if err != nil {
+		// запись не попадет в память, и следующая получит тот же Seq: оставшись в файле, она сломала бы
+		// цепочку при следующем Open
+		truncateErr := l.file.Truncate(l.size)
+
+		return errors.Join(fmt.Errorf("sync audit log: %w", err), truncateErr)
+	}
+
+	l.size += int64(n)
+
+	return nil
+}
+
+// load читает записи из файла. torn - последняя запись оборвана и не входит в l.size.
+func (l *Log) load() (torn bool, err error) {
+	entries, size, torn, err := readEntries(l.file)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		l.add(entry)
+	}
+
+	l.size = size
+
+	return torn, nil
+}
+
+// readEntries читает записи журнала из r. size - длина целых записей; torn - после них есть оборванная запись.
+func readEntries(r io.Reader) (entries []usecases.AuditEntry, size int64, torn bool, err error) {
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// последняя запись без перевода строки - запись была прервана
+			return entries, size, len(line) > 0, nil
+		}
+
+		if err != nil {
+			return nil, 0, false, fmt.Errorf("read audit log: %w", err)
+		}
+
+		var rec record
+
+		err = json.Unmarshal(bytes.TrimSpace(line), &rec)
+		if err != nil {
+			return nil, 0, false, fmt.Errorf("%w: decode entry after seq %d: %w", ErrTampered, len(entries), err)
+		}
+
+		entry, err := fromRecord(rec)
+		if err != nil {
+			return nil, 0, false, fmt.Errorf("%w: entry %d: %w", ErrTampered, rec.Seq, err)
+		}
+
+		entries = append(entries, entry)
+		size += int64(len(line))
+	}
+}
+
+func checkpointPath(path string) string {
+	return path + ".head"
+}
+
+func readCheckpoint(path string) (checkpoint, error) {
+	var head checkpoint
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return head, fmt.Errorf("%w: checkpoint %s is missing: %w", ErrTampered, path, err)
+	}
+
+	if err != nil {
+		return head, fmt.Errorf("read audit checkpoint: %w", err)
+	}
+
+	err = json.Unmarshal(data, &head)
+	if err != nil {
+		return head, fmt.Errorf("%w: decode checkpoint: %w", ErrTampered, err)
+	}
+
+	return head, nil
+}
+
+// verifyCheckpoint проверяет, что журнал entries не короче контрольной точки head и проходит через нее.
+func verifyCheckpoint(entries []usecases.AuditEntry, head checkpoint) error {
+	if len(entries) < head.Seq {
+		return fmt.Errorf("%w: log ends at entry %d, checkpoint is at entry %d", ErrTampered, len(entries), head.Seq)
+	}
+
+	if head.Seq > 0 && entries[head.Seq-1].Hash != head.Hash {
+		return fmt.Errorf("%w: entry %d does not match checkpoint", ErrTampered, head.Seq)
+	}
+
+	return nil
+}
+
+// writeCheckpoint атомарно заменяет контрольную точку последней записью журнала.
+func (l *Log) writeCheckpoint() error {
+	var head checkpoint
+
+	if len(l.entries) > 0 {
+		last := l.entries[len(l.entries)-1]
+		head = checkpoint{Seq: last.Seq, Hash: last.Hash}
+	}
+
+	// структура из строки и числа всегда сериализуется
+	data, _ := json.Marshal(head)
+
+	path := checkpointPath(l.path)
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("create temp audit checkpoint: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("write audit checkpoint: %w", err)
+	}
+
+	err = tmp.Sync()
+	if err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("sync audit checkpoint: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("close audit checkpoint: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("rename audit checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func toRecord(entry usecases.AuditEntry) record {
+	rec := record{
+		Seq:      entry.Seq,
+		UserID:   entry.UserID,
+		Action:   string(entry.Action),
+		Actor:    entry.Actor,
+		At:       entry.At.UTC().Format(time.RFC3339Nano),
+		Version:  entry.Version,
+		Changes:  make([]change, 0, len(entry.Changes)),
+		PrevHash: entry.PrevHash,
+		Hash:     entry.Hash,
+	}
+
+	for _, c := range entry.Changes {
+		rec.Changes = append(rec.Changes, change(c))
+	}
+
+	return rec
+}
+
+func fromRecord(rec record) (usecases.AuditEntry, error) {
+	at, err := time.Parse(time.RFC3339Nano, rec.At)
+	if err != nil {
+		return usecases.AuditEntry{}, fmt.Errorf("parse at: %w", err)
+	}
+
+	entry := usecases.AuditEntry{
+		Seq:      rec.Seq,
+		UserID:   rec.UserID,
+		Action:   usecases.AuditAction(rec.Action),
+		Actor:    rec.Actor,
+		At:       at,
+		Version:  rec.Version,
+		Changes:  make([]usecases.AuditChange, 0, len(rec.Changes)),
+		PrevHash: rec.PrevHash,
+		Hash:     rec.Hash,
+	}
+
+	for _, c := range rec.Changes {
+		entry.Changes = append(entry.Changes, usecases.AuditChange(c))
+	}
+
+	return entry, nil
+}
+
+func cloneEntry(entry usecases.AuditEntry) usecases.AuditEntry {
+	entry.Changes = slices.Clone(entry.Changes)
+
+	return entry
+}
@@ -0,0 +1,466 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"shared/usecases"
+)
+
+func appendEntries(t *testing.T, l *Log, userIDs ...int) []usecases.AuditEntry {
+	t.Helper()
+
+	entries := make([]usecases.AuditEntry, 0, len(userIDs))
+
+	for i, id := range userIDs {
+		entry, err := l.Append(context.Background(), usecases.AuditEntry{
+			UserID:  id,
+			Action:  usecases.AuditActionUpdate,
+			At:      time.Date(2025, 1, 1, 0, 0, i, 0, time.UTC),
+			Version: i + 1,
+			Changes: []usecases.AuditChange{{Field: "name", From: "old", To: "new"}},
+		})
+		if err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestLog_AppendChainsEntries(t *testing.T) {
+	l := New()
+
+	entries := appendEntries(t, l, 1, 2, 1)
+
+	for i, entry := range entries {
+		if entry.Seq != i+1 {
+			t.Fatalf("entry %d: Seq = %d, want %d", i, entry.Seq, i+1)
+		}
+
+		if i > 0 && entry.PrevHash != entries[i-1].Hash {
+			t.Fatalf("entry %d: PrevHash = %q, want %q", i, entry.PrevHash, entries[i-1].Hash)
+		}
+	}
+
+	history, err := l.UserHistory(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("UserHistory() error = %v", err)
+	}
+
+	want := []usecases.AuditEntry{entries[0], entries[2]}
+	if !reflect.DeepEqual(history, want) {
+		t.Fatalf("UserHistory() = %+v, want %+v", history, want)
+	}
+
+	err = l.Verify()
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(entries []usecases.AuditEntry) []usecases.AuditEntry
+		want   error
+	}{
+		{
+			name:   "intact",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry { return entries },
+		},
+		{
+			name: "rewritten change",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry {
+				entries[1].Changes = []usecases.AuditChange{{Field: "name", From: "old", To: "forged"}}
+
+				return entries
+			},
+			want: ErrTampered,
+		},
+		{
+			name: "rewritten actor",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry {
+				entries[0].Actor = "someone else"
+
+				return entries
+			},
+			want: ErrTampered,
+		},
+		{
+			name: "rewritten entry with recomputed hash",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry {
+				entries[1].Version = 100
+				entries[1].Hash = Hash(entries[1])
+
+				return entries
+			},
+			want: ErrTampered,
+		},
+		{
+			name: "removed entry",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry {
+				return append(entries[:1], entries[2:]...)
+			},
+			want: ErrTampered,
+		},
+		{
+			name: "removed and renumbered entry",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry {
+				entries = append(entries[:1], entries[2:]...)
+				entries[1].Seq = 2
+
+				return entries
+			},
+			want: ErrTampered,
+		},
+		{
+			name: "swapped entries",
+			tamper: func(entries []usecases.AuditEntry) []usecases.AuditEntry {
+				entries[1], entries[2] = entries[2], entries[1]
+
+				return entries
+			},
+			want: ErrTampered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New()
+			entries := tt.tamper(appendEntries(t, l, 1, 2, 1))
+
+			err := Verify(entries)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpen_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	entries := appendEntries(t, l, 1, 2)
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	l, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	t.Cleanup(func() { l.Close() })
+
+	next := appendEntries(t, l, 1)
+	if next[0].Seq != 3 || next[0].PrevHash != entries[1].Hash {
+		t.Fatalf("Append() after reopen = %+v, want seq 3 chained to %q", next[0], entries[1].Hash)
+	}
+
+	history, err := l.UserHistory(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("UserHistory() error = %v", err)
+	}
+
+	want := []usecases.AuditEntry{entries[0], next[0]}
+	if !reflect.DeepEqual(history, want) {
+		t.Fatalf("UserHistory() = %+v, want %+v", history, want)
+	}
+}
+
+func TestOpen_DetectsTamperedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	appendEntries(t, l, 1, 2)
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	err = os.WriteFile(path, []byte(strings.Replace(string(data), `"to":"new"`, `"to":"forged"`, 1)), 0o644)
+	if err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	_, err = Open(path)
+	if !errors.Is(err, ErrTampered) {
+		t.Fatalf("Open() error = %v, want %v", err, ErrTampered)
+	}
+}
+
+func TestOpen_TruncatesTornEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	entries := appendEntries(t, l, 1)
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatalf("OpenFile() error = %v", err)
+	}
+
+	_, err = file.WriteString(`{"seq":2,"user_id":1`)
+	if err != nil {
+		t.Fatalf("WriteString() error = %v", err)
+	}
+
+	file.Close()
+
+	l, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	t.Cleanup(func() { l.Close() })
+
+	next := appendEntries(t, l, 1)
+	if next[0].Seq != 2 || next[0].PrevHash != entries[0].Hash {
+		t.Fatalf("Append() after torn entry = %+v, want seq 2 chained to %q", next[0], entries[0].Hash)
+	}
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	l, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() after torn entry error = %v", err)
+	}
+
+	l.Close()
+}
+
+// failingSync - файл журнала, fsync которого завершается ошибкой
+type failingSync struct {
+	*os.File
+}
+
+var errSync = errors.New("sync failed")
+
+func (f failingSync) Sync() error {
+	return errSync
+}
+
+func TestLog_SyncErrorDropsEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	appendEntries(t, l, 1)
+
+	file := l.file.(*os.File)
+	l.file = failingSync{File: file}
+
+	_, err = l.Append(context.Background(), usecases.AuditEntry{UserID: 2, Action: usecases.AuditActionCreate, Version: 1})
+	if !errors.Is(err, errSync) {
+		t.Fatalf("Append() error = %v, want %v", err, errSync)
+	}
+
+	// после сбоя журнал пишется дальше, и следующая запись получает номер несохраненной
+	l.file = file
+
+	next := appendEntries(t, l, 3)
+	if next[0].Seq != 2 {
+		t.Fatalf("Append() after sync error seq = %d, want 2", next[0].Seq)
+	}
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	l, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	t.Cleanup(func() { l.Close() })
+
+	history, err := l.UserHistory(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("UserHistory() error = %v", err)
+	}
+
+	if len(history) != 0 {
+		t.Fatalf("UserHistory() = %+v, want no entries of the failed append", history)
+	}
+}
+
+// cutLastEntry отрезает последнюю запись файла журнала целиком, как сделал бы злоумышленник
+func cutLastEntry(t *testing.T, path string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+
+	// после последнего перевода строки SplitAfter дает пустую строку
+	err = os.WriteFile(path, []byte(strings.Join(lines[:len(lines)-2], "")), 0o644)
+	if err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+func TestOpen_DetectsTruncatedLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	appendEntries(t, l, 1, 2, 3)
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	cutLastEntry(t, path)
+
+	_, err = Open(path)
+	if !errors.Is(err, ErrTampered) {
+		t.Fatalf("Open() error = %v, want %v", err, ErrTampered)
+	}
+}
+
+func TestOpen_DetectsMissingCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	appendEntries(t, l, 1)
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	err = os.Remove(checkpointPath(path))
+	if err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+
+	_, err = Open(path)
+	if !errors.Is(err, ErrTampered) {
+		t.Fatalf("Open() error = %v, want %v", err, ErrTampered)
+	}
+}
+
+func TestOpen_CatchesUpCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	appendEntries(t, l, 1)
+
+	stale, err := os.ReadFile(checkpointPath(path))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	appendEntries(t, l, 2)
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// сбой между записью в журнал и обновлением контрольной точки
+	err = os.WriteFile(checkpointPath(path), stale, 0o644)
+	if err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	l, err = Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	err = l.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Open подтянул контрольную точку, и теперь отрезанная вторая запись заметна
+	cutLastEntry(t, path)
+
+	_, err = Open(path)
+	if !errors.Is(err, ErrTampered) {
+		t.Fatalf("Open() error = %v, want %v", err, ErrTampered)
+	}
+}
+
+func TestLog_VerifyDetectsTruncatedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+
+	t.Cleanup(func() { l.Close() })
+
+	appendEntries(t, l, 1, 2)
+
+	err = l.Verify()
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	cutLastEntry(t, path)
+
+	err = l.Verify()
+	if !errors.Is(err, ErrTampered) {
+		t.Fatalf("Verify() error = %v, want %v", err, ErrTampered)
+	}
+}
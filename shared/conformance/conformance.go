@@ -0,0 +1,176 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Step - запрос к серверу и ожидаемый ответ. Шаги выполняются по порядку против одного сервера с пустым
+// хранилищем, поэтому следующие шаги рассчитывают на пользователей, созданных предыдущими.
+type Step struct {
+	Name   string
+	Method string
+	Path   string
+	Header map[string]string
+	// Body - тело запроса; Content-Type по умолчанию application/json
+	Body string
+
+	Status int
+	// ContentType - тип тела ответа; пустой для ответов без тела, по умолчанию application/json
+	ContentType string
+	// Want - JSON, который должен содержаться в теле ответа: объекты сравниваются по указанным полям,
+	// массивы - поэлементно и по длине
+	Want string
+	// WantHeader - заголовки ответа, которые должны совпасть полностью
+	WantHeader map[string]string
+}
+
+// DebugToken - токен операторов, с которым тест запускает сервер (DEBUG_TOKEN): шаги от имени оператора
+// передают его в X-Debug-Token.
+const DebugToken = "conformance"
+
+// Run выполняет Steps против сервера на baseURL и проваливает тест на каждом расхождении. Ожидания
+// одни для всех реализаций: сервер, который ведет себя иначе остальных, не проходит свой тест.
+func Run(t *testing.T, baseURL string) {
+	t.Helper()
+
+	client := &http.Client{
+		// 304 и ответы с Location проверяются как есть
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	for i, step := range Steps {
+		name := fmt.Sprintf("%02d %s", i+1, step.Name)
+
+		ok := t.Run(name, func(t *testing.T) {
+			run(t, client, baseURL, step)
+		})
+		if !ok {
+			// следующие шаги зависят от состояния после этого
+			t.Fatalf("step %q failed, the rest of the scenario is skipped", name)
+		}
+	}
+}
+
+func run(t *testing.T, client *http.Client, baseURL string, step Step) {
+	var body io.Reader
+	if step.Body != "" {
+		body = strings.NewReader(step.Body)
+	}
+
+	req, err := http.NewRequest(step.Method, baseURL+step.Path, body)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+
+	if step.Body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	for name, value := range step.Header {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", step.Method, step.Path, err)
+	}
+	defer resp.Body.Close()
+
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+
+	if resp.StatusCode != step.Status {
+		t.Fatalf("%s %s: status code = %d, want %d; body: %s", step.Method, step.Path, resp.StatusCode, step.Status, got)
+	}
+
+	for name, want := range step.WantHeader {
+		if value := resp.Header.Get(name); value != want {
+			t.Errorf("%s = %q, want %q", name, value, want)
+		}
+	}
+
+	if step.Status == http.StatusNoContent || step.Status == http.StatusNotModified {
+		if len(bytes.TrimSpace(got)) != 0 {
+			t.Errorf("body = %q, want none", got)
+		}
+
+		return
+	}
+
+	wantType := step.ContentType
+	if wantType == "" {
+		wantType = "application/json"
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != wantType {
+		t.Errorf("Content-Type = %q, want %s", resp.Header.Get("Content-Type"), wantType)
+	}
+
+	if step.Want == "" {
+		return
+	}
+
+	var gotJSON, wantJSON any
+
+	err = json.Unmarshal(got, &gotJSON)
+	if err != nil {
+		t.Fatalf("body is not JSON: %v; body: %s", err, got)
+	}
+
+	err = json.Unmarshal([]byte(step.Want), &wantJSON)
+	if err != nil {
+		t.Fatalf("Want is not JSON: %v", err)
+	}
+
+	if !contains(gotJSON, wantJSON) {
+		t.Errorf("body = %s, want it to contain %s", got, step.Want)
+	}
+}
+
+// contains сообщает, содержит ли got значение want: у объектов сравниваются только поля want.
+func contains(got any, want any) bool {
+	switch want := want.(type) {
+	case map[string]any:
+		gotObject, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, value := range want {
+			gotValue, ok := gotObject[key]
+			if !ok || !contains(gotValue, value) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		gotArray, ok := got.([]any)
+		if !ok || len(gotArray) != len(want) {
+			return false
+		}
+
+		for i := range want {
+			if !contains(gotArray[i], want[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
@@ -0,0 +1,38 @@
+package conformance
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestContains(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+		ok   bool
+	}{
+		{name: "equal objects", got: `{"id":1}`, want: `{"id":1}`, ok: true},
+		{name: "extra fields are ignored", got: `{"id":1,"name":"Alice"}`, want: `{"id":1}`, ok: true},
+		{name: "missing field", got: `{"name":"Alice"}`, want: `{"id":1}`},
+		{name: "different value", got: `{"id":2}`, want: `{"id":1}`},
+		{name: "nested objects", got: `{"error":{"code":3,"error":"x"}}`, want: `{"error":{"code":3}}`, ok: true},
+		{name: "arrays by element", got: `[{"id":1,"x":1},{"id":2}]`, want: `[{"id":1},{"id":2}]`, ok: true},
+		{name: "array of other length", got: `[{"id":1},{"id":2}]`, want: `[{"id":1}]`},
+		{name: "array order matters", got: `[{"id":2},{"id":1}]`, want: `[{"id":1},{"id":2}]`},
+		{name: "object instead of array", got: `{"id":1}`, want: `[]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got, want any
+
+			_ = json.Unmarshal([]byte(tt.got), &got)
+			_ = json.Unmarshal([]byte(tt.want), &want)
+
+			if contains(got, want) != tt.ok {
+				t.Fatalf("contains(%s, %s) = %v, want %v", tt.got, tt.want, !tt.ok, tt.ok)
+			}
+		})
+	}
+}
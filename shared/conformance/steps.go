@@ -0,0 +1,392 @@
+package conformance
+
+import "net/http"
+
+// Steps - сценарий, который проходит каждый сервер: успешные вызовы всех операций, каждый код ошибки,
+// который можно получить без сбоя хранилища, неверные параметры и тела, неизвестные маршруты.
+var Steps = []Step{
+	// создание
+	{
+		Name:   "create user",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{"name":"Alice"}`,
+		Status: http.StatusCreated,
+		Want:   `{"id":1}`,
+	},
+	{
+		Name:   "create another user",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{"name":"Bob"}`,
+		Status: http.StatusCreated,
+		Want:   `{"id":2}`,
+	},
+	{
+		Name:   "create user with blank name",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{"name":"  "}`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":3,"details":[{"field":"name","rule":"not_blank"}]}`,
+	},
+	{
+		Name:   "create user without name",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{}`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":3,"details":[{"field":"name","rule":"required"}]}`,
+	},
+	{
+		Name:   "create user with name of wrong type",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{"name":5}`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":3,"details":[{"field":"name","rule":"type"}]}`,
+	},
+	{
+		Name:   "create user with malformed body",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{"name":`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":10}`,
+	},
+	{
+		Name:   "create user with unsupported content type",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Header: map[string]string{"Content-Type": "text/plain"},
+		Body:   `Carol`,
+		Status: http.StatusUnsupportedMediaType,
+		Want:   `{"code":13}`,
+	},
+	{
+		Name:   "create user with taken name",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Body:   `{"name":"Bob"}`,
+		Status: http.StatusConflict,
+		Want:   `{"code":16}`,
+	},
+
+	// идемпотентность
+	{
+		Name:   "create user with idempotency key",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Header: map[string]string{"Idempotency-Key": "conformance-1"},
+		Body:   `{"name":"Carol"}`,
+		Status: http.StatusCreated,
+		Want:   `{"id":3}`,
+	},
+	{
+		Name:   "repeat request with idempotency key",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Header: map[string]string{"Idempotency-Key": "conformance-1"},
+		Body:   `{"name":"Carol"}`,
+		Status: http.StatusCreated,
+		Want:   `{"id":3}`,
+	},
+	{
+		Name:   "reuse idempotency key with another body",
+		Method: http.MethodPost,
+		Path:   "/users",
+		Header: map[string]string{"Idempotency-Key": "conformance-1"},
+		Body:   `{"name":"Dave"}`,
+		Status: http.StatusUnprocessableEntity,
+		Want:   `{"code":6}`,
+	},
+
+	// пакетное создание
+	{
+		Name:   "create batch",
+		Method: http.MethodPost,
+		Path:   "/users:batch",
+		Body:   `{"items":[{"name":"Dave"},{"name":" "}]}`,
+		Status: http.StatusOK,
+		Want:   `{"results":[{"id":4},{"error":{"code":3}}]}`,
+	},
+	{
+		Name:   "create batch all or nothing",
+		Method: http.MethodPost,
+		Path:   "/users:batch",
+		Body:   `{"items":[{"name":"Eve"},{"name":" "}],"allOrNothing":true}`,
+		Status: http.StatusUnprocessableEntity,
+		Want:   `{"results":[{"error":{"code":5}},{"error":{"code":3}}]}`,
+	},
+	{
+		Name:   "create batch with taken name",
+		Method: http.MethodPost,
+		Path:   "/users:batch",
+		Body:   `{"items":[{"name":"Eve"},{"name":"Alice"}]}`,
+		Status: http.StatusConflict,
+		Want:   `{"code":16}`,
+	},
+	{
+		Name:   "create empty batch",
+		Method: http.MethodPost,
+		Path:   "/users:batch",
+		Body:   `{"items":[]}`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":3,"details":[{"field":"items","rule":"length"}]}`,
+	},
+
+	// чтение
+	{
+		Name:       "get user",
+		Method:     http.MethodGet,
+		Path:       "/users/1",
+		Status:     http.StatusOK,
+		Want:       `{"id":1,"name":"Alice"}`,
+		WantHeader: map[string]string{"ETag": `"1"`},
+	},
+	{
+		Name:       "get unchanged user",
+		Method:     http.MethodGet,
+		Path:       "/users/1",
+		Header:     map[string]string{"If-None-Match": `"1"`},
+		Status:     http.StatusNotModified,
+		WantHeader: map[string]string{"ETag": `"1"`},
+	},
+	{
+		Name:   "get missing user",
+		Method: http.MethodGet,
+		Path:   "/users/99",
+		Status: http.StatusNotFound,
+		Want:   `{"code":404}`,
+	},
+	{
+		Name:        "get missing user as problem details",
+		Method:      http.MethodGet,
+		Path:        "/users/99",
+		Header:      map[string]string{"Accept": "application/problem+json"},
+		Status:      http.StatusNotFound,
+		ContentType: "application/problem+json",
+		Want:        `{"status":404,"code":404}`,
+	},
+	{
+		Name:   "get user with unacceptable Accept",
+		Method: http.MethodGet,
+		Path:   "/users/1",
+		Header: map[string]string{"Accept": "text/html"},
+		Status: http.StatusNotAcceptable,
+		Want:   `{"code":14}`,
+	},
+	{
+		Name:   "get user by malformed id",
+		Method: http.MethodGet,
+		Path:   "/users/abc",
+		Status: http.StatusBadRequest,
+		Want:   `{"code":9}`,
+	},
+
+	// изменение
+	{
+		Name:       "replace user",
+		Method:     http.MethodPut,
+		Path:       "/users/1",
+		Header:     map[string]string{"If-Match": `"1"`},
+		Body:       `{"name":"Alicia"}`,
+		Status:     http.StatusOK,
+		Want:       `{"id":1,"name":"Alicia"}`,
+		WantHeader: map[string]string{"ETag": `"2"`},
+	},
+	{
+		Name:   "replace user with stale ETag",
+		Method: http.MethodPut,
+		Path:   "/users/1",
+		Header: map[string]string{"If-Match": `"1"`},
+		Body:   `{"name":"Alice"}`,
+		Status: http.StatusPreconditionFailed,
+		Want:   `{"code":8}`,
+	},
+	{
+		Name:   "replace user without If-Match",
+		Method: http.MethodPut,
+		Path:   "/users/1",
+		Body:   `{"name":"Alice"}`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":9}`,
+	},
+	{
+		Name:   "replace missing user",
+		Method: http.MethodPut,
+		Path:   "/users/99",
+		Header: map[string]string{"If-Match": "*"},
+		Body:   `{"name":"Alice"}`,
+		Status: http.StatusNotFound,
+		Want:   `{"code":404}`,
+	},
+	{
+		Name:       "patch user",
+		Method:     http.MethodPatch,
+		Path:       "/users/2",
+		Header:     map[string]string{"If-Match": "*"},
+		Body:       `{"name":"Bobby"}`,
+		Status:     http.StatusOK,
+		Want:       `{"id":2,"name":"Bobby"}`,
+		WantHeader: map[string]string{"ETag": `"2"`},
+	},
+	{
+		Name:   "patch user with blank name",
+		Method: http.MethodPatch,
+		Path:   "/users/2",
+		Header: map[string]string{"If-Match": "*"},
+		Body:   `{"name":""}`,
+		Status: http.StatusBadRequest,
+		Want:   `{"code":3,"details":[{"field":"name","rule":"not_blank"}]}`,
+	},
+	{
+		Name:   "patch user to taken name",
+		Method: http.MethodPatch,
+		Path:   "/users/2",
+		Header: map[string]string{"If-Match": "*"},
+		Body:   `{"name":"Alicia"}`,
+		Status: http.StatusConflict,
+		Want:   `{"code":16}`,
+	},
+
+	// списки
+	{
+		Name:   "list users",
+		Method: http.MethodGet,
+		Path:   "/users?limit=2",
+		Status: http.StatusOK,
+		Want:   `{"items":[{"id":1,"name":"Alicia"},{"id":2,"name":"Bobby"}]}`,
+	},
+	{
+		Name:   "list users by name prefix sorted by name descending",
+		Method: http.MethodGet,
+		Path:   "/users?name_prefix=A&sort=-name",
+		Status: http.StatusOK,
+		Want:   `{"items":[{"id":1,"name":"Alicia"}]}`,
+	},
+	{
+		Name:   "list users with limit out of range",
+		Method: http.MethodGet,
+		Path:   "/users?limit=500",
+		Status: http.StatusBadRequest,
+		Want:   `{"code":3,"details":[{"field":"limit","rule":"range"}]}`,
+	},
+	{
+		Name:   "list users with malformed limit",
+		Method: http.MethodGet,
+		Path:   "/users?limit=many",
+		Status: http.StatusBadRequest,
+		Want:   `{"code":9}`,
+	},
+	{
+		Name:   "list users with unknown sort field",
+		Method: http.MethodGet,
+		Path:   "/users?sort=email",
+		Status: http.StatusBadRequest,
+		Want:   `{"code":4}`,
+	},
+
+	// удаление и восстановление
+	{
+		Name:   "delete user",
+		Method: http.MethodDelete,
+		Path:   "/users/2",
+		Status: http.StatusNoContent,
+	},
+	{
+		Name:   "get deleted user",
+		Method: http.MethodGet,
+		Path:   "/users/2",
+		Status: http.StatusGone,
+		Want:   `{"code":410}`,
+	},
+	{
+		Name:   "delete deleted user",
+		Method: http.MethodDelete,
+		Path:   "/users/2",
+		Status: http.StatusGone,
+		Want:   `{"code":410}`,
+	},
+	{
+		Name:   "delete missing user",
+		Method: http.MethodDelete,
+		Path:   "/users/99",
+		Status: http.StatusNotFound,
+		Want:   `{"code":404}`,
+	},
+	{
+		Name:   "list users without deleted",
+		Method: http.MethodGet,
+		Path:   "/users?name_prefix=B",
+		Status: http.StatusOK,
+		Want:   `{"items":[]}`,
+	},
+	{
+		Name:   "list users with deleted without operator token",
+		Method: http.MethodGet,
+		Path:   "/users?name_prefix=B&include_deleted=true",
+		Status: http.StatusForbidden,
+		Want:   `{"code":15}`,
+	},
+	{
+		Name:   "list users with deleted as operator",
+		Method: http.MethodGet,
+		Path:   "/users?name_prefix=B&include_deleted=true",
+		Header: map[string]string{"X-Debug-Token": DebugToken},
+		Status: http.StatusOK,
+		Want:   `{"items":[{"id":2,"name":"Bobby"}]}`,
+	},
+	{
+		Name:   "restore user as operator",
+		Method: http.MethodPost,
+		Path:   "/users/2:restore",
+		Header: map[string]string{"X-Debug-Token": DebugToken},
+		Status: http.StatusOK,
+		Want:   `{"id":2,"name":"Bobby"}`,
+	},
+	{
+		Name:   "restore missing user",
+		Method: http.MethodPost,
+		Path:   "/users/99:restore",
+		Status: http.StatusNotFound,
+		Want:   `{"code":404}`,
+	},
+
+	// история
+	{
+		Name:   "get user history",
+		Method: http.MethodGet,
+		Path:   "/users/2/history",
+		Status: http.StatusOK,
+		Want: `{"items":[
+			{"action":"create","version":1},
+			{"action":"update","version":2},
+			{"action":"delete","version":3},
+			{"action":"restore","version":4,"actor":"operator"}
+		]}`,
+	},
+	{
+		Name:   "get history of missing user",
+		Method: http.MethodGet,
+		Path:   "/users/99/history",
+		Status: http.StatusNotFound,
+		Want:   `{"code":404}`,
+	},
+
+	// маршрутизация
+	{
+		Name:   "unknown path",
+		Method: http.MethodGet,
+		Path:   "/accounts",
+		Status: http.StatusNotFound,
+		Want:   `{"code":11}`,
+	},
+	{
+		Name:   "method not allowed",
+		Method: http.MethodPut,
+		Path:   "/users",
+		Status: http.StatusMethodNotAllowed,
+		Want:   `{"code":12}`,
+	},
+}
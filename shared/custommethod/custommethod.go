@@ -0,0 +1,190 @@
+// Package custommethod добавляет роутерам поддержку пользовательских методов вида POST /users/{id}:restore
+// (https://google.aip.dev/136). Роутеры не допускают литерал после параметра в том же сегменте, поэтому
+// шаблон регистрируется без суффикса ":restore", а метод выбирается по значению параметра при обработке запроса.
+package custommethod
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Cut отделяет имя пользовательского метода от значения параметра пути: "5:restore" -> "5", "restore".
+func Cut(value string) (param string, method string, ok bool) {
+	i := strings.LastIndexByte(value, ':')
+	if i < 0 {
+		return value, "", false
+	}
+
+	return value[:i], value[i+1:], true
+}
+
+// Route - обработчики одного шаблона с параметром Param в последнем сегменте: без пользовательского метода
+// и по методам. Из него роутеры конкретных фреймворков собирают общий обработчик шаблона.
+type Route[H any] struct {
+	Param   string
+	plain   H
+	methods map[string]H
+}
+
+func NewRoute[H any](param string) *Route[H] {
+	return &Route[H]{
+		Param:   param,
+		methods: make(map[string]H),
+	}
+}
+
+// Add добавляет обработчик пользовательского метода method; пустой method - обработчик без метода.
+func (r *Route[H]) Add(method string, handler H) {
+	if method == "" {
+		r.plain = handler
+
+		return
+	}
+
+	r.methods[method] = handler
+}
+
+// Match выбирает обработчик по значению параметра и возвращает значение без суффикса метода.
+func (r *Route[H]) Match(value string) (handler H, param string, ok bool) {
+	param, method, ok := Cut(value)
+	if ok {
+		handler, ok = r.methods[method]
+		if ok {
+			return handler, param, true
+		}
+	}
+
+	return r.plain, value, false
+}
+
+// ServeMux - http.ServeMux, понимающий шаблоны "POST /users/{id}:restore". Подходит как BaseRouter
+// для обработчиков oapi-codegen.
+type ServeMux struct {
+	// NotFound отвечает, если запросу не соответствует ни один шаблон; nil - ответ http.ServeMux
+	NotFound http.Handler
+	// MethodNotAllowed отвечает, если путь есть, но с другими методами; заголовок Allow к этому моменту
+	// уже выставлен. nil - ответ http.ServeMux
+	MethodNotAllowed http.Handler
+
+	mux    *http.ServeMux
+	routes map[string]*Route[http.HandlerFunc]
+}
+
+func NewServeMux() *ServeMux {
+	return &ServeMux{
+		mux:    http.NewServeMux(),
+		routes: make(map[string]*Route[http.HandlerFunc]),
+	}
+}
+
+func (m *ServeMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	base, param, method, ok := splitServeMuxPattern(pattern)
+	if !ok {
+		m.mux.HandleFunc(pattern, handler)
+
+		return
+	}
+
+	r, exists := m.routes[base]
+	if !exists {
+		r = NewRoute[http.HandlerFunc](param)
+		m.routes[base] = r
+
+		m.mux.HandleFunc(base, func(w http.ResponseWriter, req *http.Request) {
+			handler, value, ok := r.Match(req.PathValue(r.Param))
+			if ok {
+				req.SetPathValue(r.Param, value)
+			}
+
+			if handler == nil {
+				m.notFound(w, req)
+
+				return
+			}
+
+			handler(w, req)
+		})
+	}
+
+	r.Add(method, handler)
+}
+
+func (m *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, pattern := m.mux.Handler(r)
+	if pattern != "" || (m.NotFound == nil && m.MethodNotAllowed == nil) {
+		m.mux.ServeHTTP(w, r)
+
+		return
+	}
+
+	// http.ServeMux не сообщает, почему запрос не подошел ни к одному шаблону: 404 и 405 различаются
+	// только по ответу его собственного обработчика
+	recorder := &statusRecorder{header: make(http.Header)}
+	handler.ServeHTTP(recorder, r)
+
+	switch {
+	case recorder.statusCode == http.StatusNotFound:
+		m.notFound(w, r)
+	case recorder.statusCode == http.StatusMethodNotAllowed && m.MethodNotAllowed != nil:
+		w.Header().Set("Allow", recorder.header.Get("Allow"))
+		m.MethodNotAllowed.ServeHTTP(w, r)
+	default:
+		handler.ServeHTTP(w, r)
+	}
+}
+
+func (m *ServeMux) notFound(w http.ResponseWriter, r *http.Request) {
+	if m.NotFound == nil {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	m.NotFound.ServeHTTP(w, r)
+}
+
+// statusRecorder запоминает статус и заголовки ответа, отбрасывая тело.
+type statusRecorder struct {
+	header     http.Header
+	statusCode int
+}
+
+func (r *statusRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+
+	return len(b), nil
+}
+
+// splitServeMuxPattern разбирает шаблон, последний сегмент которого - параметр "{id}" или "{id}:method".
+func splitServeMuxPattern(pattern string) (base string, param string, method string, ok bool) {
+	i := strings.LastIndexByte(pattern, '/')
+	segment := pattern[i+1:]
+
+	if !strings.HasPrefix(segment, "{") {
+		return "", "", "", false
+	}
+
+	param, rest, ok := strings.Cut(segment[1:], "}")
+	if !ok || param == "$" || strings.HasSuffix(param, "...") {
+		return "", "", "", false
+	}
+
+	if rest != "" {
+		method, ok = strings.CutPrefix(rest, ":")
+		if !ok || method == "" {
+			return "", "", "", false
+		}
+	}
+
+	return pattern[:i+1] + "{" + param + "}", param, method, true
+}
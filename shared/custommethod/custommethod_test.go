@@ -0,0 +1,108 @@
+package custommethod
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// echoHandler отвечает именем обработчика и значением параметра id.
+func echoHandler(name string) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(name + " " + r.PathValue("id")))
+	}
+}
+
+func TestServeMux(t *testing.T) {
+	mux := NewServeMux()
+
+	mux.HandleFunc("GET /users/{id}", echoHandler("get"))
+	mux.HandleFunc("POST /users/{id}:restore", echoHandler("restore"))
+	mux.HandleFunc("POST /users/{id}:archive", echoHandler("archive"))
+	mux.HandleFunc("POST /users:batch", echoHandler("batch"))
+
+	tests := []struct {
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{method: http.MethodGet, path: "/users/5", wantStatus: http.StatusOK, wantBody: "get 5"},
+		{method: http.MethodPost, path: "/users/5:restore", wantStatus: http.StatusOK, wantBody: "restore 5"},
+		{method: http.MethodPost, path: "/users/5:archive", wantStatus: http.StatusOK, wantBody: "archive 5"},
+		{method: http.MethodPost, path: "/users:batch", wantStatus: http.StatusOK, wantBody: "batch "},
+		// неизвестный метод достается обычному обработчику вместе с суффиксом
+		{method: http.MethodGet, path: "/users/5:restore", wantStatus: http.StatusOK, wantBody: "get 5:restore"},
+		{method: http.MethodPost, path: "/users/5:unknown", wantStatus: http.StatusNotFound},
+		{method: http.MethodPost, path: "/users/5", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+
+			if tt.wantBody != "" && rr.Body.String() != tt.wantBody {
+				t.Fatalf("body = %q, want %q", rr.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestServeMux_ErrorHandlers(t *testing.T) {
+	mux := NewServeMux()
+	mux.NotFound = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("custom not found"))
+	})
+	mux.MethodNotAllowed = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		_, _ = w.Write([]byte("custom method not allowed"))
+	})
+
+	mux.HandleFunc("GET /users/{id}", echoHandler("get"))
+	mux.HandleFunc("POST /users/{id}:restore", echoHandler("restore"))
+	mux.HandleFunc("POST /users", echoHandler("create"))
+
+	tests := []struct {
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string
+		wantAllow  string
+	}{
+		{method: http.MethodGet, path: "/users/5", wantStatus: http.StatusOK, wantBody: "get 5"},
+		{method: http.MethodGet, path: "/unknown", wantStatus: http.StatusNotFound, wantBody: "custom not found"},
+		{method: http.MethodPost, path: "/users/5:unknown", wantStatus: http.StatusNotFound, wantBody: "custom not found"},
+		{
+			method:     http.MethodDelete,
+			path:       "/users",
+			wantStatus: http.StatusMethodNotAllowed,
+			wantBody:   "custom method not allowed",
+			wantAllow:  "POST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rr.Code, tt.wantStatus)
+			}
+
+			if rr.Body.String() != tt.wantBody {
+				t.Fatalf("body = %q, want %q", rr.Body.String(), tt.wantBody)
+			}
+
+			if got := rr.Header().Get("Allow"); got != tt.wantAllow {
+				t.Fatalf("Allow = %q, want %q", got, tt.wantAllow)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package errcatalog
+
+import (
+	"errors"
+	"net/http"
+
+	"shared/usecases"
+)
+
+// Entry - публичное представление ошибки: код ErrorResponse.code, HTTP-статус и безопасное сообщение.
+type Entry struct {
+	// Name - имя кода в спецификации (x-enum-varnames)
+	Name   string
+	Code   int
+	Status int
+	// Message - сообщение для клиента; текст самой ошибки наружу не отдается
+	Message string
+	// Expose - вместо Message отдается текст ошибки: он написан для клиента (например, нарушения валидации)
+	Expose bool
+	// Description - описание кода в спецификации
+	Description string
+	// Err - доменная ошибка, которой соответствует запись; nil для ошибок, которые возникают вне usecases
+	// (в том числе для ошибок фреймворка: разбор параметров и тела, маршрутизация)
+	Err error
+}
+
+var (
+	NotFound = Entry{
+		Name:        "NotFound",
+		Code:        404,
+		Status:      http.StatusNotFound,
+		Message:     "Not Found",
+		Description: "user does not exist",
+		Err:         usecases.ErrNotFound,
+	}
+	Gone = Entry{
+		Name:        "Gone",
+		Code:        410,
+		Status:      http.StatusGone,
+		Message:     "Gone",
+		Description: "user is deleted and can be restored",
+		Err:         usecases.ErrGone,
+	}
+	NotPublic1 = Entry{
+		Name:        "NotPublic1",
+		Code:        1,
+		Status:      http.StatusInternalServerError,
+		Message:     "Internal Server Error 1",
+		Description: "internal error 1",
+		Err:         usecases.ErrNotPublic1,
+	}
+	NotPublic2 = Entry{
+		Name:        "NotPublic2",
+		Code:        2,
+		Status:      http.StatusInternalServerError,
+		Message:     "Internal Server Error 2",
+		Description: "internal error 2",
+		Err:         usecases.ErrNotPublic2,
+	}
+	Validation = Entry{
+		Name:        "Validation",
+		Code:        3,
+		Status:      http.StatusBadRequest,
+		Expose:      true,
+		Description: "request validation failed, see details",
+		Err:         usecases.ErrValidation,
+	}
+	InvalidSort = Entry{
+		Name:        "InvalidSort",
+		Code:        4,
+		Status:      http.StatusBadRequest,
+		Expose:      true,
+		Description: "unsupported sort field or direction",
+		Err:         usecases.ErrInvalidSort,
+	}
+	RolledBack = Entry{
+		Name:        "RolledBack",
+		Code:        5,
+		Status:      http.StatusUnprocessableEntity,
+		Expose:      true,
+		Description: "batch item was not created because the all-or-nothing batch was rolled back",
+		Err:         usecases.ErrRolledBack,
+	}
+	IdempotencyKeyMismatch = Entry{
+		Name:        "IdempotencyKeyMismatch",
+		Code:        6,
+		Status:      http.StatusUnprocessableEntity,
+		Expose:      true,
+		Description: "Idempotency-Key was already used with a different request",
+	}
+	IdempotencyInProgress = Entry{
+		Name:        "IdempotencyInProgress",
+		Code:        7,
+		Status:      http.StatusConflict,
+		Expose:      true,
+		Description: "request with the same Idempotency-Key is still being processed",
+	}
+	PreconditionFailed = Entry{
+		Name:        "PreconditionFailed",
+		Code:        8,
+		Status:      http.StatusPreconditionFailed,
+		Message:     "Precondition Failed",
+		Description: "If-Match does not match the current user version",
+		Err:         usecases.ErrPreconditionFailed,
+	}
+	InvalidParameter = Entry{
+		Name:        "InvalidParameter",
+		Code:        9,
+		Status:      http.StatusBadRequest,
+		Expose:      true,
+		Description: "path, query or header parameter is missing or malformed",
+	}
+	MalformedBody = Entry{
+		Name:        "MalformedBody",
+		Code:        10,
+		Status:      http.StatusBadRequest,
+		Expose:      true,
+		Description: "request body cannot be decoded into the operation's schema",
+	}
+	RouteNotFound = Entry{
+		Name:        "RouteNotFound",
+		Code:        11,
+		Status:      http.StatusNotFound,
+		Message:     "Not Found",
+		Description: "no operation matches the request path",
+	}
+	MethodNotAllowed = Entry{
+		Name:        "MethodNotAllowed",
+		Code:        12,
+		Status:      http.StatusMethodNotAllowed,
+		Message:     "Method Not Allowed",
+		Description: "the request path does not support the request method",
+	}
+	UnsupportedMediaType = Entry{
+		Name:        "UnsupportedMediaType",
+		Code:        13,
+		Status:      http.StatusUnsupportedMediaType,
+		Message:     "Unsupported Media Type",
+		Description: "request body content type is not supported",
+	}
+	NotAcceptable = Entry{
+		Name:        "NotAcceptable",
+		Code:        14,
+		Status:      http.StatusNotAcceptable,
+		Message:     "Not Acceptable",
+		Description: "none of the media types in Accept can be produced",
+	}
+	Forbidden = Entry{
+		Name:        "Forbidden",
+		Code:        15,
+		Status:      http.StatusForbidden,
+		Message:     "Forbidden",
+		Description: "the request requires the operator token in X-Debug-Token",
+		Err:         usecases.ErrForbidden,
+	}
+	AlreadyExists = Entry{
+		Name:        "AlreadyExists",
+		Code:        16,
+		Status:      http.StatusConflict,
+		Message:     "Conflict",
+		Description: "user name is already taken by another user, including a deleted one",
+		Err:         usecases.ErrAlreadyExists,
+	}
+	Internal = Entry{
+		Name:        "Internal",
+		Code:        -1,
+		Status:      http.StatusInternalServerError,
+		Message:     "Internal Server Error",
+		Description: "unexpected error",
+		Err:         usecases.ErrUnknown,
+	}
+)
+
+// Entries - все записи каталога; из них генерируется перечисление ErrorResponse.code в спецификациях.
+var Entries = []Entry{
+	NotFound,
+	Gone,
+	NotPublic1,
+	NotPublic2,
+	Validation,
+	InvalidSort,
+	RolledBack,
+	IdempotencyKeyMismatch,
+	IdempotencyInProgress,
+	PreconditionFailed,
+	InvalidParameter,
+	MalformedBody,
+	RouteNotFound,
+	MethodNotAllowed,
+	UnsupportedMediaType,
+	NotAcceptable,
+	Forbidden,
+	AlreadyExists,
+	Internal,
+}
+
+// Lookup возвращает первую из entries, которой соответствует err, или Internal.
+// entries - ошибки, описанные в спецификации операции: остальные ошибки клиенту отдаются как внутренние.
+func Lookup(err error, entries ...Entry) Entry {
+	for _, entry := range entries {
+		if entry.Err != nil && errors.Is(err, entry.Err) {
+			return entry
+		}
+	}
+
+	return Internal
+}
+
+// Text - сообщение для клиента об ошибке err.
+func (e Entry) Text(err error) string {
+	if e.Expose && err != nil {
+		return err.Error()
+	}
+
+	return e.Message
+}
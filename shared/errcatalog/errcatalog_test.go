@@ -0,0 +1,96 @@
+package errcatalog
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"shared/usecases"
+)
+
+func TestEntries_Unique(t *testing.T) {
+	codes := make(map[int]string)
+	names := make(map[string]bool)
+
+	for _, entry := range Entries {
+		if name, ok := codes[entry.Code]; ok {
+			t.Fatalf("code %d is used by %s and %s", entry.Code, name, entry.Name)
+		}
+
+		if names[entry.Name] {
+			t.Fatalf("name %s is used twice", entry.Name)
+		}
+
+		if entry.Status == 0 || entry.Description == "" || (entry.Message == "" && !entry.Expose) {
+			t.Fatalf("entry %s is incomplete: %+v", entry.Name, entry)
+		}
+
+		codes[entry.Code] = entry.Name
+		names[entry.Name] = true
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		entries []Entry
+		want    Entry
+	}{
+		{
+			name:    "listed error",
+			err:     usecases.ErrNotFound,
+			entries: []Entry{NotFound, Gone},
+			want:    NotFound,
+		},
+		{
+			name:    "wrapped error",
+			err:     fmt.Errorf("get user: %w", usecases.ErrGone),
+			entries: []Entry{NotFound, Gone},
+			want:    Gone,
+		},
+		{
+			name:    "validation error with fields",
+			err:     &usecases.ValidationError{Fields: []usecases.FieldError{{Field: "name"}}},
+			entries: []Entry{Validation},
+			want:    Validation,
+		},
+		{
+			name:    "repository error wrapping a domain error",
+			err:     fmt.Errorf("insert user: %w: %w", usecases.ErrAlreadyExists, errors.New("UNIQUE constraint failed: users.name")),
+			entries: []Entry{Validation, AlreadyExists},
+			want:    AlreadyExists,
+		},
+		{
+			name:    "error not listed for the operation",
+			err:     usecases.ErrNotFound,
+			entries: []Entry{Validation},
+			want:    Internal,
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("boom"),
+			want: Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Lookup(tt.err, tt.entries...); got != tt.want {
+				t.Fatalf("Lookup() = %s, want %s", got.Name, tt.want.Name)
+			}
+		})
+	}
+}
+
+func TestEntry_Text(t *testing.T) {
+	err := fmt.Errorf("%w: internal details", usecases.ErrNotPublic1)
+
+	if got := NotPublic1.Text(err); got != "Internal Server Error 1" {
+		t.Fatalf("NotPublic1.Text() = %q, want safe message", got)
+	}
+
+	if got := InvalidSort.Text(usecases.ErrInvalidSort); got != usecases.ErrInvalidSort.Error() {
+		t.Fatalf("InvalidSort.Text() = %q, want %q", got, usecases.ErrInvalidSort.Error())
+	}
+}
@@ -0,0 +1,83 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+
+	"shared/usecases"
+)
+
+// Format возвращает ETag версии пользователя.
+func Format(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// ParseIfMatch разбирает заголовок If-Match в ожидаемую версию. Сравнение строгое (RFC 9110, 13.1.1):
+// слабые теги W/"..." и теги, которые не выдавал этот сервер, не совпадают ни с одной версией.
+func ParseIfMatch(header string) usecases.VersionMatch {
+	var match usecases.VersionMatch
+
+	for _, tag := range split(header) {
+		if tag == "*" {
+			match.Any = true
+
+			continue
+		}
+
+		version, ok := parse(tag)
+		if ok {
+			match.Versions = append(match.Versions, version)
+		}
+	}
+
+	return match
+}
+
+// NoneMatch сообщает, совпадает ли заголовок If-None-Match с версией, то есть можно ли ответить на GET 304.
+// Сравнение слабое (RFC 9110, 13.1.2).
+func NoneMatch(header string, version int) bool {
+	for _, tag := range split(header) {
+		if tag == "*" {
+			return true
+		}
+
+		v, ok := parse(strings.TrimPrefix(tag, "W/"))
+		if ok && v == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+func split(header string) []string {
+	var tags []string
+
+	for tag := range strings.SplitSeq(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+func parse(tag string) (int, bool) {
+	value, ok := strings.CutPrefix(tag, `"`)
+	if !ok {
+		return 0, false
+	}
+
+	value, ok = strings.CutSuffix(value, `"`)
+	if !ok {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(value)
+	if err != nil || version < 0 {
+		return 0, false
+	}
+
+	return version, true
+}
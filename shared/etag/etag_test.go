@@ -0,0 +1,84 @@
+package etag
+
+import (
+	"reflect"
+	"testing"
+
+	"shared/usecases"
+)
+
+func TestFormat(t *testing.T) {
+	if got := Format(3); got != `"3"` {
+		t.Fatalf("Format(3) = %s, want %s", got, `"3"`)
+	}
+}
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   usecases.VersionMatch
+	}{
+		{
+			name:   "single",
+			header: `"1"`,
+			want:   usecases.VersionMatch{Versions: []int{1}},
+		},
+		{
+			name:   "list",
+			header: `"1", "2" ,"3"`,
+			want:   usecases.VersionMatch{Versions: []int{1, 2, 3}},
+		},
+		{
+			name:   "any",
+			header: `*`,
+			want:   usecases.VersionMatch{Any: true},
+		},
+		{
+			name:   "weak tags never match",
+			header: `W/"1"`,
+			want:   usecases.VersionMatch{},
+		},
+		{
+			name:   "foreign tags never match",
+			header: `"abc", 1, "-1", "2`,
+			want:   usecases.VersionMatch{},
+		},
+		{
+			name:   "empty",
+			header: ``,
+			want:   usecases.VersionMatch{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseIfMatch(tt.header)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseIfMatch(%q) = %+v, want %+v", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoneMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{header: `"2"`, want: true},
+		{header: `W/"2"`, want: true},
+		{header: `"1", "2"`, want: true},
+		{header: `*`, want: true},
+		{header: `"1"`, want: false},
+		{header: `2`, want: false},
+		{header: ``, want: false},
+	}
+
+	for _, tt := range tests {
+		got := NoneMatch(tt.header, 2)
+		if got != tt.want {
+			t.Errorf("NoneMatch(%q, 2) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
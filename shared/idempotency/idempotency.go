@@ -0,0 +1,371 @@
+package idempotency
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"shared/errcatalog"
+)
+
+// Header - заголовок, в котором клиент передает ключ идемпотентности.
+const Header = "Idempotency-Key"
+
+// ReplayedHeader выставляется в ответе, отданном из хранилища, а не обработчиком.
+const ReplayedHeader = "Idempotent-Replayed"
+
+const maxKeyLength = 255
+
+// MaxBodySize - предел тела запроса, которое middleware читает целиком ради отпечатка. Самое большое валидное тело
+// идемпотентной операции (CreateUser) - имя из usecases.MaxNameLength символов, каждый из которых занимает в JSON
+// не больше 12 байт (\uXXXX\uXXXX); предел оставляет запас на пробелы и другие поля будущих операций.
+const MaxBodySize = 64 << 10
+
+var (
+	ErrKeyMismatch = errors.New("idempotency key was already used with another request")
+	ErrInProgress  = errors.New("request with this idempotency key is in progress")
+)
+
+// Response - сохраненный ответ, который отдается повторно без изменений.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Route - операция, запросы к которой делаются идемпотентными.
+type Route struct {
+	Method string
+	Path   string
+}
+
+type entry struct {
+	key         string
+	fingerprint string
+	expiresAt   time.Time
+	done        bool
+	response    Response
+}
+
+// Store хранит ответы по ключам идемпотентности в памяти процесса. Ключ живет ttl с момента первого запроса.
+// Ключей не больше maxEntries: новый ключ вытесняет самый старый с готовым ответом. Ключи запросов в обработке
+// не вытесняются, иначе запрос мог бы выполниться дважды; их число ограничено числом одновременных запросов.
+// Безопасен для конкурентного использования.
+type Store struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+	entries    map[string]*list.Element
+	// order - записи в порядке создания; ttl у всех одинаковый, поэтому это и порядок истечения
+	order *list.List
+}
+
+func NewStore(ttl time.Duration, maxEntries int) *Store {
+	return &Store{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// Begin начинает обработку запроса с ключом key. Если по ключу уже есть готовый ответ на такой же запрос,
+// он возвращается с replay = true. Иначе ключ резервируется, и вызывающий обязан завершить его
+// через Complete или Release.
+func (s *Store) Begin(key string, fingerprint string) (response Response, replay bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	s.purgeExpired(now)
+
+	if element, ok := s.entries[key]; ok {
+		e := element.Value.(*entry)
+
+		switch {
+		case e.fingerprint != fingerprint:
+			return Response{}, false, ErrKeyMismatch
+		case !e.done:
+			return Response{}, false, ErrInProgress
+		default:
+			return e.response, true, nil
+		}
+	}
+
+	if s.order.Len() >= s.maxEntries {
+		s.evict()
+	}
+
+	s.entries[key] = s.order.PushBack(&entry{
+		key:         key,
+		fingerprint: fingerprint,
+		expiresAt:   now.Add(s.ttl),
+	})
+
+	return Response{}, false, nil
+}
+
+// Complete сохраняет ответ для зарезервированного ключа.
+func (s *Store) Complete(key string, response Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return
+	}
+
+	e := element.Value.(*entry)
+	e.done = true
+	e.response = response
+}
+
+// Release снимает резерв, не сохраняя ответ: следующий запрос с этим ключом будет обработан заново.
+func (s *Store) Release(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return
+	}
+
+	s.remove(element)
+}
+
+// purgeExpired удаляет просроченные ключи с начала очереди.
+func (s *Store) purgeExpired(now time.Time) {
+	for element := s.order.Front(); element != nil; element = s.order.Front() {
+		if now.Before(element.Value.(*entry).expiresAt) {
+			return
+		}
+
+		s.remove(element)
+	}
+}
+
+// evict вытесняет самый старый ключ с готовым ответом.
+func (s *Store) evict() {
+	for element := s.order.Front(); element != nil; element = element.Next() {
+		if element.Value.(*entry).done {
+			s.remove(element)
+
+			return
+		}
+	}
+}
+
+func (s *Store) remove(element *list.Element) {
+	delete(s.entries, element.Value.(*entry).key)
+	s.order.Remove(element)
+}
+
+// Fingerprint - отпечаток тела запроса, по которому повтор отличается от другого запроса с тем же ключом.
+func Fingerprint(body []byte) string {
+	sum := sha256.Sum256(body)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// StoreKey ограничивает область действия ключа методом и путем запроса.
+func StoreKey(method string, path string, key string) string {
+	return method + " " + path + " " + key
+}
+
+// ValidateKey проверяет значение заголовка Idempotency-Key.
+func ValidateKey(key string) error {
+	if len(key) > maxKeyLength {
+		return errors.New("idempotency key is too long")
+	}
+
+	return nil
+}
+
+// Applies сообщает, относится ли запрос к одной из routes.
+func Applies(method string, path string, routes []Route) bool {
+	for _, route := range routes {
+		if route.Method == method && route.Path == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Middleware делает запросы к routes с заголовком Idempotency-Key идемпотентными: первый ответ сохраняется в store
+// и отдается байт в байт на повторы с тем же телом. Ответы 5xx не сохраняются, чтобы повтор мог пройти успешно.
+// Остальные запросы проходят без изменений, даже с заголовком.
+func Middleware(store *Store, routes ...Route) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(Header)
+			if key == "" || !Applies(r.Method, r.URL.Path, routes) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			err := ValidateKey(key)
+			if err != nil {
+				WriteError(w, errcatalog.Validation, err.Error())
+
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					WriteError(w, errcatalog.Validation, "request body is too large")
+
+					return
+				}
+
+				WriteError(w, errcatalog.Validation, "failed to read request body")
+
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			storeKey := StoreKey(r.Method, r.URL.Path, key)
+
+			response, replay, err := store.Begin(storeKey, Fingerprint(body))
+			if err != nil {
+				WriteError(w, StoreError(err), err.Error())
+
+				return
+			}
+
+			if replay {
+				WriteResponse(w, response)
+
+				return
+			}
+
+			recorder := &responseRecorder{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			completed := false
+
+			defer func() {
+				if !completed {
+					store.Release(storeKey)
+				}
+			}()
+
+			next.ServeHTTP(recorder, r)
+
+			if !recorder.wroteHeader {
+				recorder.header = w.Header().Clone()
+			}
+
+			if recorder.statusCode >= http.StatusInternalServerError {
+				return
+			}
+
+			store.Complete(storeKey, Response{
+				StatusCode: recorder.statusCode,
+				Header:     recorder.header,
+				Body:       recorder.body.Bytes(),
+			})
+
+			completed = true
+		})
+	}
+}
+
+// WriteResponse отдает сохраненный ответ.
+func WriteResponse(w http.ResponseWriter, response Response) {
+	for name, values := range response.Header.Clone() {
+		w.Header()[name] = values
+	}
+
+	w.Header().Set(ReplayedHeader, "true")
+	w.WriteHeader(response.StatusCode)
+
+	_, _ = w.Write(response.Body)
+}
+
+// StoreError возвращает запись каталога ошибок для ошибки Store.Begin.
+func StoreError(err error) errcatalog.Entry {
+	switch {
+	case errors.Is(err, ErrKeyMismatch):
+		return errcatalog.IdempotencyKeyMismatch
+	case errors.Is(err, ErrInProgress):
+		return errcatalog.IdempotencyInProgress
+	default:
+		return errcatalog.Internal
+	}
+}
+
+// errorResponse повторяет схему ErrorResponse из спецификации.
+type errorResponse struct {
+	Code  int    `json:"code"`
+	Error string `json:"error"`
+}
+
+// WriteError отвечает в формате ErrorResponse со статусом и кодом entry.
+func WriteError(w http.ResponseWriter, entry errcatalog.Entry, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(entry.Status)
+
+	_ = json.NewEncoder(w).Encode(errorResponse{
+		Code:  entry.Code,
+		Error: message,
+	})
+}
+
+// ErrorBody - тело ответа WriteError для серверов не на net/http.
+func ErrorBody(entry errcatalog.Entry, message string) []byte {
+	// структура из строки и числа всегда сериализуется
+	body, _ := json.Marshal(errorResponse{
+		Code:  entry.Code,
+		Error: message,
+	})
+
+	return body
+}
+
+// responseRecorder пишет ответ клиенту и одновременно запоминает его.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	header      http.Header
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if r.wroteHeader {
+		return
+	}
+
+	r.wroteHeader = true
+	r.statusCode = statusCode
+	r.header = r.ResponseWriter.Header().Clone()
+
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	r.body.Write(b)
+
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,377 @@
+package idempotency
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"shared/errcatalog"
+	"shared/usecases"
+)
+
+const maxEntries = 100
+
+// createUser - операция, которую middleware делает идемпотентной в тестах
+var createUser = Route{Method: http.MethodPost, Path: "/users"}
+
+// countingHandler отвечает 201 с новым id на каждый вызов, чтобы повтор был отличим от повторной обработки.
+func countingHandler(calls *atomic.Int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := calls.Add(1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Number", strconv.FormatInt(id, 10))
+		w.WriteHeader(http.StatusCreated)
+
+		_, _ = w.Write([]byte(`{"id":` + strconv.FormatInt(id, 10) + `}`))
+	})
+}
+
+func doRequest(t *testing.T, handler http.Handler, key string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return doRequestTo(t, handler, "/users", key, body)
+}
+
+func doRequestTo(t *testing.T, handler http.Handler, path string, key string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(Header, key)
+	}
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+
+	return rr
+}
+
+func readErrorCode(t *testing.T, rr *httptest.ResponseRecorder) int {
+	t.Helper()
+
+	var got errorResponse
+
+	err := json.Unmarshal(rr.Body.Bytes(), &got)
+	if err != nil {
+		t.Fatalf("unmarshal error response %q: %v", rr.Body.String(), err)
+	}
+
+	return got.Code
+}
+
+func TestMiddleware_Replay(t *testing.T) {
+	var calls atomic.Int64
+
+	handler := Middleware(NewStore(time.Hour, maxEntries), createUser)(countingHandler(&calls))
+
+	first := doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first status = %d, want %d", first.Code, http.StatusCreated)
+	}
+
+	if first.Header().Get(ReplayedHeader) != "" {
+		t.Fatalf("first response must not be marked as replayed")
+	}
+
+	second := doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	if second.Code != http.StatusCreated {
+		t.Fatalf("replay status = %d, want %d", second.Code, http.StatusCreated)
+	}
+
+	if !bytes.Equal(second.Body.Bytes(), first.Body.Bytes()) {
+		t.Fatalf("replay body = %q, want %q", second.Body.String(), first.Body.String())
+	}
+
+	if got := second.Header().Get("X-Request-Number"); got != "1" {
+		t.Fatalf("replay X-Request-Number = %q, want %q", got, "1")
+	}
+
+	if got := second.Header().Get(ReplayedHeader); got != "true" {
+		t.Fatalf("replay %s = %q, want %q", ReplayedHeader, got, "true")
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("handler calls = %d, want 1", got)
+	}
+
+	other := doRequest(t, handler, "key-2", `{"name":"Alice"}`)
+	if other.Body.String() != `{"id":2}` {
+		t.Fatalf("other key body = %q, want %q", other.Body.String(), `{"id":2}`)
+	}
+
+	withoutKey := doRequest(t, handler, "", `{"name":"Alice"}`)
+	if withoutKey.Body.String() != `{"id":3}` {
+		t.Fatalf("request without key body = %q, want %q", withoutKey.Body.String(), `{"id":3}`)
+	}
+}
+
+func TestMiddleware_KeyMismatch(t *testing.T) {
+	var calls atomic.Int64
+
+	handler := Middleware(NewStore(time.Hour, maxEntries), createUser)(countingHandler(&calls))
+
+	_ = doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+
+	rr := doRequest(t, handler, "key-1", `{"name":"Bob"}`)
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusUnprocessableEntity)
+	}
+
+	if got := readErrorCode(t, rr); got != errcatalog.IdempotencyKeyMismatch.Code {
+		t.Fatalf("code = %d, want %d", got, errcatalog.IdempotencyKeyMismatch.Code)
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("handler calls = %d, want 1", got)
+	}
+}
+
+func TestMiddleware_TTL(t *testing.T) {
+	var calls atomic.Int64
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := NewStore(time.Minute, maxEntries)
+	store.now = func() time.Time { return now }
+
+	handler := Middleware(store, createUser)(countingHandler(&calls))
+
+	_ = doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+
+	now = now.Add(59 * time.Second)
+
+	rr := doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	if rr.Body.String() != `{"id":1}` {
+		t.Fatalf("body before expiry = %q, want %q", rr.Body.String(), `{"id":1}`)
+	}
+
+	now = now.Add(time.Second)
+
+	rr = doRequest(t, handler, "key-1", `{"name":"Bob"}`)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("status after expiry = %d, want %d", rr.Code, http.StatusCreated)
+	}
+
+	if rr.Body.String() != `{"id":2}` {
+		t.Fatalf("body after expiry = %q, want %q", rr.Body.String(), `{"id":2}`)
+	}
+
+	now = now.Add(2 * time.Minute)
+
+	_ = doRequest(t, handler, "key-2", `{"name":"Carol"}`)
+
+	if got := store.order.Len(); got != 1 {
+		t.Fatalf("entries after purge = %d, want 1", got)
+	}
+}
+
+func TestMiddleware_ServerErrorIsNotStored(t *testing.T) {
+	var calls atomic.Int64
+
+	handler := Middleware(NewStore(time.Hour, maxEntries), createUser)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	rr := doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	if rr.Code != http.StatusInternalServerError {
+		t.Fatalf("first status = %d, want %d", rr.Code, http.StatusInternalServerError)
+	}
+
+	rr = doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	if rr.Code != http.StatusCreated {
+		t.Fatalf("retry status = %d, want %d", rr.Code, http.StatusCreated)
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("handler calls = %d, want 2", got)
+	}
+}
+
+func TestMiddleware_InProgress(t *testing.T) {
+	store := NewStore(time.Hour, maxEntries)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	handler := Middleware(store, createUser)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	done := make(chan *httptest.ResponseRecorder)
+
+	go func() {
+		done <- doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	}()
+
+	<-started
+
+	rr := doRequest(t, handler, "key-1", `{"name":"Alice"}`)
+	if rr.Code != http.StatusConflict {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusConflict)
+	}
+
+	if got := readErrorCode(t, rr); got != errcatalog.IdempotencyInProgress.Code {
+		t.Fatalf("code = %d, want %d", got, errcatalog.IdempotencyInProgress.Code)
+	}
+
+	close(release)
+
+	first := <-done
+	if first.Code != http.StatusCreated {
+		t.Fatalf("first status = %d, want %d", first.Code, http.StatusCreated)
+	}
+}
+
+func TestMiddleware_InvalidKey(t *testing.T) {
+	var calls atomic.Int64
+
+	handler := Middleware(NewStore(time.Hour, maxEntries), createUser)(countingHandler(&calls))
+
+	rr := doRequest(t, handler, strings.Repeat("k", maxKeyLength+1), `{"name":"Alice"}`)
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d", rr.Code, http.StatusBadRequest)
+	}
+
+	if got := readErrorCode(t, rr); got != errcatalog.Validation.Code {
+		t.Fatalf("code = %d, want %d", got, errcatalog.Validation.Code)
+	}
+
+	if got := calls.Load(); got != 0 {
+		t.Fatalf("handler calls = %d, want 0", got)
+	}
+}
+
+// Тело больше MaxBodySize отклоняется, не доходя до обработчика, а самое большое валидное тело проходит
+func TestMiddleware_BodySize(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+	}{
+		{
+			name:       "largest valid body",
+			body:       `{"name":"` + strings.Repeat(`\ud83d\ude00`, usecases.MaxNameLength) + `"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "body over the limit",
+			body:       `{"name":"` + strings.Repeat("a", MaxBodySize) + `"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int64
+
+			store := NewStore(time.Hour, maxEntries)
+			handler := Middleware(store, createUser)(countingHandler(&calls))
+
+			rr := doRequest(t, handler, "key-1", tt.body)
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", rr.Code, tt.wantStatus, rr.Body.String())
+			}
+
+			if tt.wantStatus != http.StatusBadRequest {
+				return
+			}
+
+			if got := readErrorCode(t, rr); got != errcatalog.Validation.Code {
+				t.Fatalf("code = %d, want %d", got, errcatalog.Validation.Code)
+			}
+
+			if got := calls.Load(); got != 0 {
+				t.Fatalf("handler calls = %d, want 0", got)
+			}
+
+			if got := store.order.Len(); got != 0 {
+				t.Fatalf("entries = %d, want 0", got)
+			}
+		})
+	}
+}
+
+// Ключ действует только на операции из routes: повтор запроса к другой операции обрабатывается заново
+func TestMiddleware_OtherRoutes(t *testing.T) {
+	var calls atomic.Int64
+
+	store := NewStore(time.Hour, maxEntries)
+	handler := Middleware(store, createUser)(countingHandler(&calls))
+
+	for _, path := range []string{"/users:batch", "/users/1:restore"} {
+		_ = doRequestTo(t, handler, path, "key-1", `{}`)
+
+		rr := doRequestTo(t, handler, path, "key-1", `{}`)
+		if rr.Header().Get(ReplayedHeader) != "" {
+			t.Fatalf("POST %s response must not be replayed", path)
+		}
+	}
+
+	if got := calls.Load(); got != 4 {
+		t.Fatalf("handler calls = %d, want 4", got)
+	}
+
+	if got := store.order.Len(); got != 0 {
+		t.Fatalf("entries = %d, want 0", got)
+	}
+}
+
+func TestStore_MaxEntries(t *testing.T) {
+	store := NewStore(time.Hour, 3)
+
+	begin := func(key string) error {
+		_, _, err := store.Begin(key, "fingerprint")
+
+		return err
+	}
+
+	// key-1 в обработке, остальные с готовым ответом
+	err := begin("key-1")
+	if err != nil {
+		t.Fatalf("Begin(key-1) error = %v", err)
+	}
+
+	for i := 2; i <= 10; i++ {
+		key := "key-" + strconv.Itoa(i)
+
+		err := begin(key)
+		if err != nil {
+			t.Fatalf("Begin(%s) error = %v", key, err)
+		}
+
+		store.Complete(key, Response{StatusCode: http.StatusCreated})
+
+		if got := store.order.Len(); got > 3 {
+			t.Fatalf("entries after %s = %d, want at most 3", key, got)
+		}
+	}
+
+	// ключ в обработке не вытеснен, из готовых остались самые новые
+	for key, want := range map[string]bool{"key-1": true, "key-8": false, "key-9": true, "key-10": true} {
+		_, ok := store.entries[key]
+		if ok != want {
+			t.Errorf("%s stored = %v, want %v", key, ok, want)
+		}
+	}
+
+	if err := begin("key-1"); !errors.Is(err, ErrInProgress) {
+		t.Fatalf("Begin(key-1) error = %v, want %v", err, ErrInProgress)
+	}
+}
@@ -0,0 +1,205 @@
+package incident
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"shared/usecases"
+)
+
+// DebugHeader - заголовок с токеном оператора, включающим отладочный режим (см. Middleware).
+const DebugHeader = "X-Debug-Token"
+
+// Operator - автор изменений в журнале для запросов с токеном оператора. Токен один на всех операторов, поэтому
+// конкретный человек в журнал не попадает.
+const Operator = "operator"
+
+// Incident - внутренняя ошибка, скрытая от клиента. Клиент получает только ID, по которому ее можно найти в Sink.
+type Incident struct {
+	ID string
+	At time.Time
+	// Code - код ErrorResponse, с которым ответили клиенту; 0 - запрос завершился успешно, а сбой скрыт (например,
+	// изменение сохранено, но не записано в журнал)
+	Code int
+	// Chain - сообщения всей цепочки обернутых ошибок, от внешней к внутренней
+	Chain []string
+}
+
+// Sink - хранилище инцидентов на стороне сервера.
+type Sink interface {
+	Record(ctx context.Context, incident Incident)
+}
+
+// Reporter регистрирует инциденты. nil Reporter ничего не регистрирует и возвращает пустой ID.
+type Reporter struct {
+	sink Sink
+	now  func() time.Time
+}
+
+func New(sink Sink) *Reporter {
+	return &Reporter{
+		sink: sink,
+		now:  time.Now,
+	}
+}
+
+// Report сохраняет err в Sink под новым ID. debugMessage - текст err, но только в отладочном режиме (Debug),
+// иначе пустая строка.
+func (r *Reporter) Report(ctx context.Context, code int, err error) (id string, debugMessage string) {
+	if r == nil {
+		return "", ""
+	}
+
+	id = newID()
+
+	r.sink.Record(ctx, Incident{
+		ID:    id,
+		At:    r.now(),
+		Code:  code,
+		Chain: Chain(err),
+	})
+
+	if Debug(ctx) && err != nil {
+		debugMessage = err.Error()
+	}
+
+	return id, debugMessage
+}
+
+// newID - 128 случайных бит в hex
+func newID() string {
+	var b [16]byte
+
+	// crypto/rand.Read не возвращает ошибок
+	_, _ = rand.Read(b[:])
+
+	return hex.EncodeToString(b[:])
+}
+
+// Chain разворачивает цепочку обернутых ошибок (в том числе errors.Join) в список сообщений, в порядке обхода в глубину.
+func Chain(err error) []string {
+	var chain []string
+
+	var walk func(err error)
+
+	walk = func(err error) {
+		if err == nil {
+			return
+		}
+
+		chain = append(chain, err.Error())
+
+		switch wrapped := err.(type) {
+		case interface{ Unwrap() error }:
+			walk(wrapped.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, e := range wrapped.Unwrap() {
+				walk(e)
+			}
+		}
+	}
+
+	walk(err)
+
+	return chain
+}
+
+type debugKey struct{}
+
+// WithDebug включает отладочный режим для запроса.
+func WithDebug(ctx context.Context) context.Context {
+	return context.WithValue(ctx, debugKey{}, true)
+}
+
+// Debug сообщает, включен ли для запроса отладочный режим: в нем клиент получает текст внутренней ошибки.
+func Debug(ctx context.Context) bool {
+	debug, _ := ctx.Value(debugKey{}).(bool)
+
+	return debug
+}
+
+// Authorized сообщает, совпадает ли переданный в DebugHeader токен с токеном операторов.
+// Пустой token отключает отладочный режим.
+func Authorized(header string, token string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(header), []byte(token)) == 1
+}
+
+// Operate включает для запроса отладочный режим и записывает его изменения в журнал от имени Operator.
+func Operate(ctx context.Context) context.Context {
+	return usecases.WithActor(WithDebug(ctx), Operator)
+}
+
+// Middleware выполняет запросы, которые предъявили токен оператора в DebugHeader, от имени оператора (см. Operate).
+func Middleware(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if Authorized(r.Header.Get(DebugHeader), token) {
+				r = r.WithContext(Operate(r.Context()))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// LogSink пишет инциденты в лог в формате JSON, по строке на инцидент.
+type LogSink struct {
+	logger *slog.Logger
+}
+
+func NewLogSink(w io.Writer) *LogSink {
+	return &LogSink{
+		logger: slog.New(slog.NewJSONHandler(w, nil)),
+	}
+}
+
+func (s *LogSink) Record(ctx context.Context, incident Incident) {
+	s.logger.LogAttrs(ctx, slog.LevelError, "internal error",
+		slog.String("incident_id", incident.ID),
+		slog.Time("at", incident.At),
+		slog.Int("code", incident.Code),
+		slog.Any("chain", incident.Chain),
+	)
+}
+
+// ErrNotFound - инцидента с таким ID нет.
+var ErrNotFound = errors.New("incident not found")
+
+// Memory хранит инциденты в памяти процесса. Безопасен для конкурентного использования.
+type Memory struct {
+	mu        sync.RWMutex
+	incidents map[string]Incident
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		incidents: make(map[string]Incident),
+	}
+}
+
+func (m *Memory) Record(ctx context.Context, incident Incident) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.incidents[incident.ID] = incident
+}
+
+func (m *Memory) Get(id string) (Incident, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	incident, ok := m.incidents[id]
+	if !ok {
+		return Incident{}, ErrNotFound
+	}
+
+	return incident, nil
+}
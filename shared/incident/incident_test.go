@@ -0,0 +1,160 @@
+package incident
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"shared/usecases"
+)
+
+func TestChain(t *testing.T) {
+	root := errors.New("disk is full")
+	other := errors.New("retry failed")
+	err := fmt.Errorf("save user: %w", errors.Join(fmt.Errorf("write: %w", root), other))
+
+	want := []string{
+		"save user: write: disk is full\nretry failed",
+		"write: disk is full\nretry failed",
+		"write: disk is full",
+		"disk is full",
+		"retry failed",
+	}
+
+	if got := Chain(err); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Chain() = %q, want %q", got, want)
+	}
+
+	if got := Chain(nil); got != nil {
+		t.Fatalf("Chain(nil) = %q, want nil", got)
+	}
+}
+
+func TestReporter_Report(t *testing.T) {
+	sink := NewMemory()
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := New(sink)
+	r.now = func() time.Time { return at }
+
+	err := fmt.Errorf("get user: %w", errors.New("we can't expose this text 1"))
+
+	id, debugMessage := r.Report(context.Background(), 1, err)
+	if len(id) != 32 {
+		t.Fatalf("Report() id = %q, want 32 hex characters", id)
+	}
+
+	if debugMessage != "" {
+		t.Fatalf("Report() debugMessage = %q, want empty without debug mode", debugMessage)
+	}
+
+	incident, getErr := sink.Get(id)
+	if getErr != nil {
+		t.Fatalf("Get() error = %v", getErr)
+	}
+
+	want := Incident{
+		ID:    id,
+		At:    at,
+		Code:  1,
+		Chain: []string{"get user: we can't expose this text 1", "we can't expose this text 1"},
+	}
+	if !reflect.DeepEqual(incident, want) {
+		t.Fatalf("Get() = %+v, want %+v", incident, want)
+	}
+
+	otherID, debugMessage := r.Report(WithDebug(context.Background()), 1, err)
+	if otherID == id {
+		t.Fatalf("Report() returned the same id twice: %q", id)
+	}
+
+	if debugMessage != err.Error() {
+		t.Fatalf("Report() debugMessage = %q, want %q", debugMessage, err.Error())
+	}
+}
+
+func TestReporter_Nil(t *testing.T) {
+	var r *Reporter
+
+	id, debugMessage := r.Report(WithDebug(context.Background()), -1, errors.New("boom"))
+	if id != "" || debugMessage != "" {
+		t.Fatalf("Report() = %q, %q, want empty", id, debugMessage)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		header string
+		want   bool
+	}{
+		{name: "operator token", token: "secret", header: "secret", want: true},
+		{name: "wrong token", token: "secret", header: "guess"},
+		{name: "no token", token: "secret"},
+		{name: "debug mode disabled", token: "", header: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got   bool
+				actor string
+			)
+
+			handler := Middleware(tt.token)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = Debug(r.Context())
+				actor = usecases.ActorFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			if tt.header != "" {
+				req.Header.Set(DebugHeader, tt.header)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got != tt.want {
+				t.Fatalf("Debug() = %v, want %v", got, tt.want)
+			}
+
+			if want := map[bool]string{true: Operator}[tt.want]; actor != want {
+				t.Fatalf("ActorFromContext() = %q, want %q", actor, want)
+			}
+		})
+	}
+}
+
+func TestLogSink(t *testing.T) {
+	var buf bytes.Buffer
+
+	NewLogSink(&buf).Record(context.Background(), Incident{
+		ID:    "abc",
+		At:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		Code:  -1,
+		Chain: []string{"outer: inner", "inner"},
+	})
+
+	var record struct {
+		Msg        string   `json:"msg"`
+		IncidentID string   `json:"incident_id"`
+		Code       int      `json:"code"`
+		Chain      []string `json:"chain"`
+	}
+
+	err := json.Unmarshal(buf.Bytes(), &record)
+	if err != nil {
+		t.Fatalf("log line %q: %v", buf.String(), err)
+	}
+
+	if record.IncidentID != "abc" || record.Code != -1 || !reflect.DeepEqual(record.Chain, []string{"outer: inner", "inner"}) {
+		t.Fatalf("log record = %+v", record)
+	}
+}
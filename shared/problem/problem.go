@@ -0,0 +1,261 @@
+package problem
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"shared/errcatalog"
+)
+
+const (
+	// ContentType - тип ответа с ошибкой в формате RFC 7807
+	ContentType = "application/problem+json"
+	// DefaultType - тип проблемы, когда ее смысл полностью передается кодом статуса
+	DefaultType = "about:blank"
+
+	jsonContentType = "application/json"
+)
+
+// Details - ответ с ошибкой в формате RFC 7807. Code, Details, IncidentID и DebugMessage - расширения
+// с теми же значениями, что и в ErrorResponse.
+type Details struct {
+	Type         string          `json:"type"`
+	Title        string          `json:"title"`
+	Status       int             `json:"status"`
+	Detail       string          `json:"detail,omitempty"`
+	Instance     string          `json:"instance,omitempty"`
+	Code         int             `json:"code"`
+	Details      json.RawMessage `json:"details,omitempty"`
+	IncidentID   string          `json:"incident_id,omitempty"`
+	DebugMessage string          `json:"debug_message,omitempty"`
+}
+
+// errorResponse - обычный ответ с ошибкой; указатели отличают отсутствующие поля от нулевых
+type errorResponse struct {
+	Code         *int            `json:"code"`
+	Error        *string         `json:"error"`
+	Details      json.RawMessage `json:"details,omitempty"`
+	IncidentID   string          `json:"incident_id,omitempty"`
+	DebugMessage string          `json:"debug_message,omitempty"`
+}
+
+// Preferred сообщает, просит ли клиент заголовком Accept ответы об ошибках в формате application/problem+json.
+// Формат выбирается, только если application/problem+json указан в Accept явно и его вес больше, чем
+// у application/json. При равных весах остается обычный ErrorResponse, если application/json тоже указан явно:
+// так, например, ведет себя клиент go-swagger, перечисляющий в Accept все типы из produces.
+func Preferred(accept string) bool {
+	problemQ, explicit := -1.0, false
+	jsonQ, jsonExplicit := -1.0, false
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+
+		if value, ok := params["q"]; ok {
+			q, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+		}
+
+		switch mediaType {
+		case ContentType:
+			problemQ, explicit = q, true
+		case jsonContentType:
+			jsonQ, jsonExplicit = q, true
+		case "application/*", "*/*":
+			// конкретный тип важнее диапазона, поэтому диапазон задает вес только неуказанному типу
+			if !jsonExplicit {
+				jsonQ = max(jsonQ, q)
+			}
+		}
+	}
+
+	if !explicit || problemQ <= 0 {
+		return false
+	}
+
+	return problemQ > jsonQ || (problemQ == jsonQ && !jsonExplicit)
+}
+
+// Acceptable сообщает, принимает ли клиент с заголовком Accept хотя бы один из типов, в которых отвечает сервер:
+// application/json или application/problem+json, в том числе через application/* и */*. Пустой или
+// полностью неразборчивый Accept принимает все.
+func Acceptable(accept string) bool {
+	parsed := false
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || !strings.Contains(mediaType, "/") {
+			continue
+		}
+
+		parsed = true
+
+		if value, ok := params["q"]; ok {
+			q, err := strconv.ParseFloat(value, 64)
+			if err != nil || q <= 0 {
+				continue
+			}
+		}
+
+		switch mediaType {
+		case jsonContentType, ContentType, "application/*", "*/*":
+			return true
+		}
+	}
+
+	return !parsed
+}
+
+// FromErrorResponse превращает тело ErrorResponse в problem details. ok = false, если body - не ErrorResponse.
+func FromErrorResponse(body []byte, statusCode int, instance string) (Details, bool) {
+	var response errorResponse
+
+	err := json.Unmarshal(body, &response)
+	if err != nil || response.Code == nil || response.Error == nil {
+		return Details{}, false
+	}
+
+	return Details{
+		Type:         DefaultType,
+		Title:        http.StatusText(statusCode),
+		Status:       statusCode,
+		Detail:       *response.Error,
+		Instance:     instance,
+		Code:         *response.Code,
+		Details:      response.Details,
+		IncidentID:   response.IncidentID,
+		DebugMessage: response.DebugMessage,
+	}, true
+}
+
+// Middleware отдает ошибки в формате application/problem+json клиентам, которые предпочитают его (см. Preferred).
+// Ответы со статусом 4xx/5xx и телом ErrorResponse буферизуются и переписываются, остальные проходят как есть.
+// Запросы, Accept которых не принимает ни один из типов сервера (см. Acceptable), получают 406.
+// go-swagger выбирает Content-Type по Accept среди produces и может пометить как application/problem+json
+// ответ, тело которого им не является; такому ответу возвращается application/json.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// представление ошибки зависит от Accept, кэши должны это учитывать
+		w.Header().Add("Vary", "Accept")
+
+		if !Acceptable(r.Header.Get("Accept")) {
+			w.Header().Set("Content-Type", jsonContentType)
+			w.WriteHeader(http.StatusNotAcceptable)
+
+			_, _ = w.Write(NotAcceptableBody())
+
+			return
+		}
+
+		writer := &responseWriter{
+			ResponseWriter: w,
+			convert:        Preferred(r.Header.Get("Accept")),
+			instance:       r.URL.Path,
+		}
+
+		next.ServeHTTP(writer, r)
+
+		writer.flush()
+	})
+}
+
+// NotAcceptableBody - ErrorResponse с кодом NotAcceptable. Он отдается в application/json, хотя клиент его
+// не просил: RFC 9110 разрешает ответить 406 в типе, которого нет в Accept.
+func NotAcceptableBody() []byte {
+	code, text := errcatalog.NotAcceptable.Code, errcatalog.NotAcceptable.Text(nil)
+
+	// структура из строки и числа всегда сериализуется
+	body, _ := json.Marshal(errorResponse{Code: &code, Error: &text})
+
+	return body
+}
+
+// responseWriter пропускает ответ без изменений, пока по статусу и Content-Type не станет ясно,
+// что это ErrorResponse, который нужно переписать (convert); такой ответ копится в buffer и переписывается в flush.
+type responseWriter struct {
+	http.ResponseWriter
+	convert     bool
+	instance    string
+	wroteHeader bool
+	buffering   bool
+	statusCode  int
+	buffer      bytes.Buffer
+}
+
+func (w *responseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+
+	w.wroteHeader = true
+
+	contentType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+	if contentType != jsonContentType && contentType != ContentType {
+		w.ResponseWriter.WriteHeader(statusCode)
+
+		return
+	}
+
+	if !w.convert || statusCode < http.StatusBadRequest {
+		if contentType == ContentType {
+			w.Header().Set("Content-Type", jsonContentType)
+		}
+
+		w.ResponseWriter.WriteHeader(statusCode)
+
+		return
+	}
+
+	w.buffering = true
+	w.statusCode = statusCode
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if w.buffering {
+		return w.buffer.Write(b)
+	}
+
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap нужен http.ResponseController.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) flush() {
+	if !w.buffering {
+		return
+	}
+
+	body := w.buffer.Bytes()
+
+	details, ok := FromErrorResponse(body, w.statusCode, w.instance)
+	if ok {
+		converted, err := json.Marshal(details)
+		if err == nil {
+			body = converted
+
+			w.Header().Set("Content-Type", ContentType)
+		}
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.ResponseWriter.WriteHeader(w.statusCode)
+
+	_, _ = w.ResponseWriter.Write(body)
+}
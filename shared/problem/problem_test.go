@@ -0,0 +1,226 @@
+package problem
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPreferred(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: false},
+		{accept: "*/*", want: false},
+		{accept: "application/json", want: false},
+		{accept: "application/problem+json", want: true},
+		{accept: "application/problem+json, application/json", want: false},
+		{accept: "application/json, application/problem+json", want: false},
+		{accept: "application/problem+json, application/json;q=0.9", want: true},
+		{accept: "application/json, application/problem+json;q=0.9", want: false},
+		{accept: "application/json;q=0.5, application/problem+json", want: true},
+		{accept: "application/problem+json;q=0", want: false},
+		{accept: "application/problem+json;q=0.5, */*", want: false},
+		{accept: "application/problem+json, */*;q=0.1", want: true},
+		{accept: "text/html, application/problem+json;q=0.8", want: true},
+		{accept: "application/problem+json;q=oops", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := Preferred(tt.accept); got != tt.want {
+				t.Fatalf("Preferred(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcceptable(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: true},
+		{accept: "*/*", want: true},
+		{accept: "application/*", want: true},
+		{accept: "application/json", want: true},
+		{accept: "application/problem+json", want: true},
+		{accept: "text/html, application/json;q=0.1", want: true},
+		{accept: "application/json; charset=utf-8", want: true},
+		{accept: "text/html", want: false},
+		{accept: "text/html, application/xml", want: false},
+		{accept: "application/json;q=0", want: false},
+		{accept: "application/json;q=oops", want: false},
+		{accept: "oops", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			if got := Acceptable(tt.accept); got != tt.want {
+				t.Fatalf("Acceptable(%q) = %v, want %v", tt.accept, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		statusCode  int
+		contentType string
+		body        string
+		wantCT      string
+		wantBody    string
+	}{
+		{
+			name:        "error as problem",
+			accept:      ContentType,
+			statusCode:  http.StatusNotFound,
+			contentType: "application/json",
+			body:        `{"code":404,"error":"Not Found"}`,
+			wantCT:      ContentType,
+			wantBody:    `{"type":"about:blank","title":"Not Found","status":404,"detail":"Not Found","instance":"/users/1","code":404}`,
+		},
+		{
+			name:        "validation details are kept",
+			accept:      ContentType,
+			statusCode:  http.StatusBadRequest,
+			contentType: "application/json",
+			body:        `{"code":3,"error":"validation error: name must not be blank","details":[{"field":"name","rule":"not_blank","message":"must not be blank"}]}`,
+			wantCT:      ContentType,
+			wantBody:    `{"type":"about:blank","title":"Bad Request","status":400,"detail":"validation error: name must not be blank","instance":"/users/1","code":3,"details":[{"field":"name","rule":"not_blank","message":"must not be blank"}]}`,
+		},
+		{
+			name:        "incident is kept",
+			accept:      ContentType,
+			statusCode:  http.StatusInternalServerError,
+			contentType: "application/json",
+			body:        `{"code":1,"error":"Internal Server Error","incident_id":"0a1b","debug_message":"load user: boom"}`,
+			wantCT:      ContentType,
+			wantBody:    `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Internal Server Error","instance":"/users/1","code":1,"incident_id":"0a1b","debug_message":"load user: boom"}`,
+		},
+		{
+			name:        "error for client without preference",
+			accept:      "application/json",
+			statusCode:  http.StatusNotFound,
+			contentType: "application/json",
+			body:        `{"code":404,"error":"Not Found"}`,
+			wantCT:      "application/json",
+			wantBody:    `{"code":404,"error":"Not Found"}`,
+		},
+		{
+			name:        "success",
+			accept:      ContentType + ", application/json",
+			statusCode:  http.StatusOK,
+			contentType: "application/json",
+			body:        `{"id":1,"name":"Alice"}`,
+			wantCT:      "application/json",
+			wantBody:    `{"id":1,"name":"Alice"}`,
+		},
+		{
+			name:        "error labeled as problem by the framework",
+			accept:      ContentType,
+			statusCode:  http.StatusNotFound,
+			contentType: ContentType,
+			body:        `{"code":404,"error":"Not Found"}`,
+			wantCT:      ContentType,
+			wantBody:    `{"type":"about:blank","title":"Not Found","status":404,"detail":"Not Found","instance":"/users/1","code":404}`,
+		},
+		{
+			name:        "success labeled as problem by the framework",
+			accept:      ContentType,
+			statusCode:  http.StatusOK,
+			contentType: ContentType,
+			body:        `{"id":1,"name":"Alice"}`,
+			wantCT:      "application/json",
+			wantBody:    `{"id":1,"name":"Alice"}`,
+		},
+		{
+			name:        "error labeled as problem for client without preference",
+			accept:      "application/json, " + ContentType,
+			statusCode:  http.StatusNotFound,
+			contentType: ContentType,
+			body:        `{"code":404,"error":"Not Found"}`,
+			wantCT:      "application/json",
+			wantBody:    `{"code":404,"error":"Not Found"}`,
+		},
+		{
+			name:        "error that is not ErrorResponse",
+			accept:      ContentType,
+			statusCode:  http.StatusUnprocessableEntity,
+			contentType: "application/json",
+			body:        `{"results":[]}`,
+			wantCT:      "application/json",
+			wantBody:    `{"results":[]}`,
+		},
+		{
+			name:        "plain text error",
+			accept:      ContentType,
+			statusCode:  http.StatusMethodNotAllowed,
+			contentType: "text/plain; charset=utf-8",
+			body:        "Method Not Allowed\n",
+			wantCT:      "text/plain; charset=utf-8",
+			wantBody:    "Method Not Allowed\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+			req.Header.Set("Accept", tt.accept)
+
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			if rr.Code != tt.statusCode {
+				t.Fatalf("status code = %d, want %d", rr.Code, tt.statusCode)
+			}
+
+			if got := rr.Header().Get("Content-Type"); got != tt.wantCT {
+				t.Fatalf("content-type = %q, want %q", got, tt.wantCT)
+			}
+
+			if got := rr.Body.String(); got != tt.wantBody {
+				t.Fatalf("body = %s, want %s", got, tt.wantBody)
+			}
+
+			if got := rr.Header().Get("Vary"); got != "Accept" {
+				t.Fatalf("Vary = %q, want %q", got, "Accept")
+			}
+		})
+	}
+}
+
+func TestMiddleware_NotAcceptable(t *testing.T) {
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler called for a request without an acceptable media type")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/users/1", nil)
+	req.Header.Set("Accept", "text/html")
+
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusNotAcceptable {
+		t.Fatalf("status code = %d, want %d", rr.Code, http.StatusNotAcceptable)
+	}
+
+	if got := rr.Header().Get("Content-Type"); got != "application/json" {
+		t.Fatalf("content-type = %q, want %q", got, "application/json")
+	}
+
+	if want := `{"code":14,"error":"Not Acceptable"}`; rr.Body.String() != want {
+		t.Fatalf("body = %s, want %s", rr.Body.String(), want)
+	}
+}
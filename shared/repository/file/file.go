@@ -0,0 +1,524 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"shared/usecases"
+)
+
+const (
+	walFileName      = "users.wal"
+	snapshotFileName = "users.snapshot"
+)
+
+// Repository хранит пользователей в памяти и дублирует каждое изменение в append-only журнал (JSON lines).
+// Когда журнал превышает compactThreshold байт, состояние сохраняется в снапшот, а журнал обнуляется.
+// При старте загружается снапшот и поверх него проигрывается журнал.
+type Repository struct {
+	mu               sync.RWMutex
+	dir              string
+	compactThreshold int64
+	wal              walFile
+	walSize          int64
+	lastID           int
+	users            map[int]usecases.User
+	// names - ID пользователя по имени; имена уникальны
+	names map[string]int
+	// compactAt - размер журнала, при котором запускается компакция; после неудачи он растет (см. compactIfNeeded)
+	compactAt int64
+	logger    *slog.Logger
+}
+
+// walFile - открытый файл журнала; тесты подменяют его файлом, который возвращает ошибки
+type walFile interface {
+	io.Writer
+	Sync() error
+	Truncate(size int64) error
+	Close() error
+}
+
+type record struct {
+	Op        string    `json:"op"`
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at,omitzero"`
+	// Version - версия пользователя после записи; в журналах, записанных до появления версий, она 0
+	Version int `json:"version,omitempty"`
+	// DeletedAt - время пометки об удалении; нулевое у неудаленных пользователей
+	DeletedAt time.Time `json:"deleted_at,omitzero"`
+	// Batch - записи пакетного создания; пакет пишется одной строкой журнала, чтобы применяться целиком
+	Batch []record `json:"batch,omitempty"`
+}
+
+const (
+	opCreate      = "create"
+	opCreateBatch = "create_batch"
+	opUpdate      = "update"
+)
+
+type snapshot struct {
+	LastID int      `json:"last_id"`
+	Users  []record `json:"users"`
+}
+
+// New открывает (или создает) хранилище в директории dir. В logger пишутся сбои компакции: клиент о них
+// не узнает, запись к этому моменту уже в журнале; nil logger - slog.Default().
+func New(dir string, compactThreshold int64, logger *slog.Logger) (*Repository, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, fmt.Errorf("create dir: %w", err)
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	r := &Repository{
+		dir:              dir,
+		compactThreshold: compactThreshold,
+		compactAt:        compactThreshold,
+		logger:           logger,
+		users:            make(map[int]usecases.User),
+		names:            make(map[string]int),
+	}
+
+	err = r.loadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.replay()
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Repository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.wal.Close()
+}
+
+func (r *Repository) GetUser(ctx context.Context, id int) (usecases.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return usecases.User{}, usecases.ErrNotFound
+	}
+
+	return user, nil
+}
+
+func (r *Repository) CreateUser(ctx context.Context, user usecases.User) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, taken := r.names[user.Name]
+	if taken {
+		return 0, usecases.ErrAlreadyExists
+	}
+
+	rec := record{
+		Op:        opCreate,
+		ID:        r.lastID + 1,
+		Name:      user.Name,
+		CreatedAt: user.CreatedAt,
+		Version:   user.Version,
+	}
+
+	err := r.commit(rec)
+	if err != nil {
+		return 0, err
+	}
+
+	return rec.ID, nil
+}
+
+func (r *Repository) CreateUsers(ctx context.Context, users []usecases.User) ([]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rec := record{
+		Op:    opCreateBatch,
+		Batch: make([]record, 0, len(users)),
+	}
+
+	batch := make(map[string]bool, len(users))
+
+	for _, user := range users {
+		_, taken := r.names[user.Name]
+		if taken || batch[user.Name] {
+			return nil, usecases.ErrAlreadyExists
+		}
+
+		batch[user.Name] = true
+	}
+
+	ids := make([]int, 0, len(users))
+
+	for i, user := range users {
+		id := r.lastID + 1 + i
+
+		rec.Batch = append(rec.Batch, record{
+			Op:        opCreate,
+			ID:        id,
+			Name:      user.Name,
+			CreatedAt: user.CreatedAt,
+			Version:   user.Version,
+		})
+
+		ids = append(ids, id)
+	}
+
+	err := r.commit(rec)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user usecases.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[user.ID]
+	if !ok {
+		return usecases.ErrNotFound
+	}
+
+	if stored.Version != user.Version {
+		return usecases.ErrPreconditionFailed
+	}
+
+	id, taken := r.names[user.Name]
+	if taken && id != user.ID {
+		return usecases.ErrAlreadyExists
+	}
+
+	return r.commit(record{Op: opUpdate, ID: user.ID, Name: user.Name, CreatedAt: user.CreatedAt, Version: user.Version + 1, DeletedAt: user.DeletedAt})
+}
+
+func (r *Repository) ListUsers(ctx context.Context, query usecases.ListUsersQuery) ([]usecases.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]usecases.User, 0, len(r.users))
+
+	for _, user := range r.users {
+		if query.Matches(user) {
+			result = append(result, user)
+		}
+	}
+
+	slices.SortFunc(result, query.Compare)
+
+	if len(result) > query.Limit {
+		result = result[:query.Limit]
+	}
+
+	return result, nil
+}
+
+// commit пишет запись в журнал и применяет ее к состоянию в памяти.
+func (r *Repository) commit(rec record) error {
+	err := r.append(rec)
+	if err != nil {
+		return err
+	}
+
+	r.apply(rec)
+	r.compactIfNeeded()
+
+	return nil
+}
+
+func (r *Repository) compactIfNeeded() {
+	if r.walSize < r.compactAt {
+		return
+	}
+
+	// запись уже надежно лежит в журнале, поэтому неудачная компакция не повод отвечать ошибкой: журнал остается
+	// валидным. Следующая попытка - когда журнал вырастет вдвое, чтобы постоянный сбой (например, нехватка места
+	// на диске) не переписывал снапшот на каждой записи
+	err := r.compact()
+	if err != nil {
+		r.compactAt = 2 * r.walSize
+
+		r.logger.LogAttrs(context.Background(), slog.LevelError, "compaction failed",
+			slog.String("dir", r.dir),
+			slog.Int64("wal_size", r.walSize),
+			slog.Int64("next_attempt_at", r.compactAt),
+			slog.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	r.compactAt = r.compactThreshold
+}
+
+func (r *Repository) apply(rec record) {
+	// прежнее имя пользователя освобождается при переименовании
+	stored, ok := r.users[rec.ID]
+	if ok {
+		delete(r.names, stored.Name)
+	}
+
+	switch rec.Op {
+	case opCreate, opUpdate:
+		r.users[rec.ID] = usecases.User{ID: rec.ID, Name: rec.Name, CreatedAt: rec.CreatedAt, Version: rec.Version, DeletedAt: rec.DeletedAt}
+		r.names[rec.Name] = rec.ID
+	case opCreateBatch:
+		for _, item := range rec.Batch {
+			r.apply(item)
+		}
+	}
+
+	if rec.ID > r.lastID {
+		r.lastID = rec.ID
+	}
+}
+
+// append дописывает запись в журнал и дожидается fsync.
+func (r *Repository) append(rec record) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal record: %w", err)
+	}
+
+	line = append(line, '\n')
+
+	// при ошибке запись убирается из журнала (файл открыт с O_APPEND, позиция не важна): вызывающий получит
+	// ошибку и не применит запись, поэтому она не должна вернуться при проигрывании журнала
+	n, err := r.wal.Write(line)
+	if err != nil {
+		truncateErr := r.wal.Truncate(r.walSize)
+
+		return errors.Join(fmt.Errorf("write wal: %w", err), truncateErr)
+	}
+
+	err = r.wal.Sync()
+	if err != nil {
+		truncateErr := r.wal.Truncate(r.walSize)
+
+		return errors.Join(fmt.Errorf("sync wal: %w", err), truncateErr)
+	}
+
+	r.walSize += int64(n)
+
+	return nil
+}
+
+func (r *Repository) loadSnapshot() error {
+	data, err := os.ReadFile(filepath.Join(r.dir, snapshotFileName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("read snapshot: %w", err)
+	}
+
+	var s snapshot
+
+	err = json.Unmarshal(data, &s)
+	if err != nil {
+		return fmt.Errorf("decode snapshot: %w", err)
+	}
+
+	for _, rec := range s.Users {
+		r.apply(rec)
+	}
+
+	if s.LastID > r.lastID {
+		r.lastID = s.LastID
+	}
+
+	return nil
+}
+
+// replay проигрывает журнал поверх снапшота. Оборванная последняя запись (сбой посреди записи)
+// отрезается; поврежденная запись в середине журнала считается ошибкой.
+func (r *Repository) replay() error {
+	wal, err := os.OpenFile(filepath.Join(r.dir, walFileName), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open wal: %w", err)
+	}
+
+	reader := bufio.NewReader(wal)
+
+	var offset int64
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				// последняя запись без перевода строки - запись была прервана
+				err = truncate(wal, offset)
+				if err != nil {
+					return err
+				}
+			}
+
+			break
+		}
+
+		if err != nil {
+			wal.Close()
+
+			return fmt.Errorf("read wal: %w", err)
+		}
+
+		var rec record
+
+		err = json.Unmarshal(bytes.TrimSpace(line), &rec)
+		if err != nil {
+			_, peekErr := reader.Peek(1)
+			if !errors.Is(peekErr, io.EOF) {
+				wal.Close()
+
+				return fmt.Errorf("wal corrupted at offset %d: %w", offset, err)
+			}
+
+			err = truncate(wal, offset)
+			if err != nil {
+				return err
+			}
+
+			break
+		}
+
+		r.apply(rec)
+
+		offset += int64(len(line))
+	}
+
+	r.wal = wal
+	r.walSize = offset
+
+	return nil
+}
+
+func truncate(wal *os.File, size int64) error {
+	err := wal.Truncate(size)
+	if err != nil {
+		wal.Close()
+
+		return fmt.Errorf("truncate torn wal record: %w", err)
+	}
+
+	err = wal.Sync()
+	if err != nil {
+		wal.Close()
+
+		return fmt.Errorf("sync wal: %w", err)
+	}
+
+	return nil
+}
+
+// compact атомарно записывает снапшот текущего состояния и обнуляет журнал.
+// Если процесс упадет между этими шагами, журнал будет проигран поверх снапшота повторно,
+// что безопасно: записи идемпотентны.
+func (r *Repository) compact() error {
+	s := snapshot{
+		LastID: r.lastID,
+		Users:  make([]record, 0, len(r.users)),
+	}
+
+	for id := 1; id <= r.lastID; id++ {
+		user, ok := r.users[id]
+		if !ok {
+			continue
+		}
+
+		s.Users = append(s.Users, record{Op: opCreate, ID: user.ID, Name: user.Name, CreatedAt: user.CreatedAt, Version: user.Version, DeletedAt: user.DeletedAt})
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot: %w", err)
+	}
+
+	err = writeFileAtomic(filepath.Join(r.dir, snapshotFileName), data)
+	if err != nil {
+		return err
+	}
+
+	err = r.wal.Truncate(0)
+	if err != nil {
+		return fmt.Errorf("truncate wal: %w", err)
+	}
+
+	err = r.wal.Sync()
+	if err != nil {
+		return fmt.Errorf("sync wal: %w", err)
+	}
+
+	r.walSize = 0
+
+	return nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("create temp snapshot: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+
+	err = tmp.Sync()
+	if err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("sync snapshot: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("close snapshot: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("rename snapshot: %w", err)
+	}
+
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return fmt.Errorf("open dir: %w", err)
+	}
+	defer dir.Close()
+
+	err = dir.Sync()
+	if err != nil {
+		return fmt.Errorf("sync dir: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,599 @@
+package file
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"shared/usecases"
+)
+
+func createUsers(t *testing.T, r *Repository, names ...string) []int {
+	t.Helper()
+
+	ids := make([]int, 0, len(names))
+
+	for _, name := range names {
+		id, err := r.CreateUser(context.Background(), usecases.User{Name: name})
+		if err != nil {
+			t.Fatalf("CreateUser(%q) error = %v", name, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func reopen(t *testing.T, r *Repository, compactThreshold int64) *Repository {
+	t.Helper()
+
+	err := r.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	r, err = New(r.dir, compactThreshold, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	t.Cleanup(func() { r.Close() })
+
+	return r
+}
+
+func assertUser(t *testing.T, r *Repository, want usecases.User) {
+	t.Helper()
+
+	got, err := r.GetUser(context.Background(), want.ID)
+	if err != nil {
+		t.Fatalf("GetUser(%d) error = %v", want.ID, err)
+	}
+
+	if got != want {
+		t.Fatalf("GetUser(%d) = %+v, want %+v", want.ID, got, want)
+	}
+}
+
+func TestRepository_ReplayAfterRestart(t *testing.T) {
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice", "Bob")
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice"})
+	assertUser(t, r, usecases.User{ID: ids[1], Name: "Bob"})
+
+	next := createUsers(t, r, "Carol")
+	if next[0] != ids[1]+1 {
+		t.Fatalf("CreateUser() id = %d, want %d", next[0], ids[1]+1)
+	}
+
+	_, err = r.GetUser(context.Background(), next[0]+1)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("GetUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_UpdateSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice", "Bob")
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[0], Name: "Carol"})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Carol", Version: 1})
+	assertUser(t, r, usecases.User{ID: ids[1], Name: "Bob"})
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1] + 1, Name: "Dave"})
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("UpdateUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_SoftDeleteSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice")
+	deletedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[0], Name: "Alice", DeletedAt: deletedAt})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice", Version: 1, DeletedAt: deletedAt})
+
+	// пометка об удалении переживает и компакцию
+	r = reopen(t, r, 1)
+	createUsers(t, r, "Bob")
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice", Version: 1, DeletedAt: deletedAt})
+}
+
+func TestRepository_TruncatesTornRecord(t *testing.T) {
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice")
+
+	walPath := filepath.Join(r.dir, walFileName)
+
+	before, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+
+	// имитируем сбой посреди записи
+	_, err = r.wal.Write([]byte(`{"op":"create","id":2,"na`))
+	if err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	after, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+
+	if after.Size() != before.Size() {
+		t.Fatalf("wal size = %d, want %d", after.Size(), before.Size())
+	}
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice"})
+
+	next := createUsers(t, r, "Bob")
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: next[0], Name: "Bob"})
+}
+
+// failingSync - файл журнала, fsync которого завершается ошибкой
+type failingSync struct {
+	*os.File
+}
+
+var errSync = errors.New("sync failed")
+
+func (f failingSync) Sync() error {
+	return errSync
+}
+
+func TestRepository_SyncErrorDropsRecord(t *testing.T) {
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice")
+
+	walPath := filepath.Join(r.dir, walFileName)
+
+	before, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+
+	wal := r.wal.(*os.File)
+	r.wal = failingSync{File: wal}
+
+	_, err = r.CreateUser(context.Background(), usecases.User{Name: "Bob"})
+	if !errors.Is(err, errSync) {
+		t.Fatalf("CreateUser() error = %v, want %v", err, errSync)
+	}
+
+	after, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+
+	if after.Size() != before.Size() {
+		t.Fatalf("wal size = %d, want %d", after.Size(), before.Size())
+	}
+
+	r.wal = wal
+
+	// запись, о неудаче которой узнал вызывающий, не возвращается после перезапуска
+	r = reopen(t, r, 1<<20)
+
+	_, err = r.GetUser(context.Background(), ids[0]+1)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("GetUser(%d) error = %v, want %v", ids[0]+1, err, usecases.ErrNotFound)
+	}
+
+	next := createUsers(t, r, "Carol")
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice"})
+	assertUser(t, r, usecases.User{ID: next[0], Name: "Carol"})
+}
+
+func TestRepository_CorruptedRecordInTheMiddle(t *testing.T) {
+	dir := t.TempDir()
+
+	wal := `{"op":"create","id":1,"name":"Alice"}` + "\n" +
+		`garbage` + "\n" +
+		`{"op":"create","id":2,"name":"Bob"}` + "\n"
+
+	err := os.WriteFile(filepath.Join(dir, walFileName), []byte(wal), 0o644)
+	if err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	_, err = New(dir, 1<<20, nil)
+	if err == nil {
+		t.Fatalf("New() error = nil, want error")
+	}
+}
+
+func TestRepository_Compaction(t *testing.T) {
+	r, err := New(t.TempDir(), 64, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice", "Bob", "Carol")
+
+	_, err = os.Stat(filepath.Join(r.dir, snapshotFileName))
+	if err != nil {
+		t.Fatalf("snapshot was not written: %v", err)
+	}
+
+	if r.walSize >= r.compactThreshold {
+		t.Fatalf("wal size = %d, want < %d", r.walSize, r.compactThreshold)
+	}
+
+	r = reopen(t, r, 64)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice"})
+	assertUser(t, r, usecases.User{ID: ids[1], Name: "Bob"})
+	assertUser(t, r, usecases.User{ID: ids[2], Name: "Carol"})
+
+	next := createUsers(t, r, "Dave")
+	if next[0] != ids[2]+1 {
+		t.Fatalf("CreateUser() id = %d, want %d", next[0], ids[2]+1)
+	}
+}
+
+// Неудачная компакция пишется в лог и повторяется, только когда журнал вырастет вдвое.
+func TestRepository_CompactionFailure(t *testing.T) {
+	var logs bytes.Buffer
+
+	r, err := New(t.TempDir(), 0, slog.New(slog.NewJSONHandler(&logs, nil)))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	// снапшот не заменить, пока на его месте непустая директория
+	blocker := filepath.Join(r.dir, snapshotFileName, "blocker")
+
+	err = os.MkdirAll(blocker, 0o755)
+	if err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+
+	// записи одной длины: попытки на 1-й, 2-й, 4-й и 8-й
+	ids := createUsers(t, r, "user1", "user2", "user3", "user4", "user5", "user6", "user7", "user8")
+
+	attempts := strings.Count(logs.String(), "compaction failed")
+	if attempts != 4 {
+		t.Fatalf("compaction failures logged = %d, want 4; logs:\n%s", attempts, logs.String())
+	}
+
+	err = os.RemoveAll(filepath.Join(r.dir, snapshotFileName))
+	if err != nil {
+		t.Fatalf("RemoveAll() error = %v", err)
+	}
+
+	for i := 0; r.walSize > 0; i++ {
+		if i == 16 {
+			t.Fatalf("wal size = %d after %d more writes, want compaction", r.walSize, i)
+		}
+
+		createUsers(t, r, fmt.Sprintf("more%d", i))
+	}
+
+	if r.compactAt != r.compactThreshold {
+		t.Fatalf("compactAt = %d after successful compaction, want %d", r.compactAt, r.compactThreshold)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[7], Name: "user8"})
+}
+
+func TestRepository_ListUsers(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	ids := createUsers(t, r, "Alice", "Bob", "Carol", "Dave")
+
+	// удаленный пользователь не попадает в список
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Bob", DeletedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	query := usecases.ListUsersQuery{
+		Sort:  []usecases.SortKey{{Field: usecases.SortByID}},
+		Limit: 2,
+	}
+
+	got, err := r.ListUsers(ctx, query)
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	want := []usecases.User{{ID: ids[0], Name: "Alice"}, {ID: ids[2], Name: "Carol"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListUsers() = %+v, want %+v", got, want)
+	}
+
+	query.After = &usecases.User{ID: ids[2]}
+
+	got, err = r.ListUsers(ctx, query)
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	want = []usecases.User{{ID: ids[3], Name: "Dave"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListUsers() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRepository_CreatedAtSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	want := usecases.User{Name: "Alice", CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 123, time.UTC)}
+
+	want.ID, err = r.CreateUser(ctx, want)
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, want)
+
+	// компакция тоже должна сохранить время создания
+	r = reopen(t, r, 0)
+
+	err = r.UpdateUser(ctx, usecases.User{ID: want.ID, Name: "Alicia", CreatedAt: want.CreatedAt})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	want.Name = "Alicia"
+	want.Version = 1
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, want)
+}
+
+func TestRepository_CreateUsers(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	first := createUsers(t, r, "Alice")
+
+	ids, err := r.CreateUsers(ctx, []usecases.User{{Name: "Bob"}, {Name: "Carol"}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{first[0] + 1, first[0] + 2}) {
+		t.Fatalf("CreateUsers() ids = %v, want [%d %d]", ids, first[0]+1, first[0]+2)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Bob"})
+	assertUser(t, r, usecases.User{ID: ids[1], Name: "Carol"})
+}
+
+// Пакет пишется одной записью журнала, поэтому оборванная запись пакета теряется целиком.
+func TestRepository_TornBatchRecord(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	r, err := New(dir, 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids := createUsers(t, r, "Alice")
+
+	_, err = r.CreateUsers(ctx, []usecases.User{{Name: "Bob"}, {Name: "Carol"}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	err = r.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	walPath := filepath.Join(dir, walFileName)
+
+	info, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+
+	// отрезаем хвост пакетной записи вместе с переводом строки
+	err = os.Truncate(walPath, info.Size()-10)
+	if err != nil {
+		t.Fatalf("Truncate() error = %v", err)
+	}
+
+	r, err = New(dir, 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	assertUser(t, r, usecases.User{ID: ids[0], Name: "Alice"})
+
+	_, err = r.GetUser(ctx, ids[0]+1)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("GetUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_VersionSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Bob", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: id, Name: "Bob", Version: 2})
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Carol", Version: 1})
+	if !errors.Is(err, usecases.ErrPreconditionFailed) {
+		t.Fatalf("UpdateUser() with stale version error = %v, want %v", err, usecases.ErrPreconditionFailed)
+	}
+
+	// и после компакции
+	r = reopen(t, r, 0)
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Carol", Version: 2})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	assertUser(t, r, usecases.User{ID: id, Name: "Carol", Version: 3})
+}
+
+func TestRepository_UniqueName(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(t.TempDir(), 1<<20, nil)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	ids, err := r.CreateUsers(ctx, []usecases.User{{Name: "Alice", Version: 1}, {Name: "Bob", Version: 1}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() with a taken name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// пакет с занятым или повторяющимся именем не сохраняется целиком
+	for _, names := range [][]string{{"Carol", "Bob"}, {"Carol", "Carol"}} {
+		_, err = r.CreateUsers(ctx, []usecases.User{{Name: names[0], Version: 1}, {Name: names[1], Version: 1}})
+		if !errors.Is(err, usecases.ErrAlreadyExists) {
+			t.Fatalf("CreateUsers(%v) error = %v, want %v", names, err, usecases.ErrAlreadyExists)
+		}
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("UpdateUser() to a taken name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// удаленный пользователь сохраняет имя, а переименованный освобождает прежнее
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[0], Name: "Alice", Version: 1, DeletedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() with a deleted user's name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Robert", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	// занятые имена восстанавливаются из журнала, а после компакции - из снапшота
+	r = reopen(t, r, 0)
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() after restart error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	_, err = r.CreateUsers(ctx, []usecases.User{{Name: "Bob", Version: 1}, {Name: "Carol", Version: 1}})
+	if err != nil {
+		t.Fatalf("CreateUsers() with a freed name error = %v", err)
+	}
+
+	r = reopen(t, r, 1<<20)
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Bob", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() after compaction error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+}
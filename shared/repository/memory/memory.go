@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"shared/usecases"
+)
+
+// Repository хранит пользователей в памяти процесса. Безопасен для конкурентного использования.
+type Repository struct {
+	mu     sync.RWMutex
+	lastID int
+	users  map[int]usecases.User
+	// names - ID пользователя по имени; имена уникальны
+	names map[string]int
+}
+
+func New() *Repository {
+	return &Repository{
+		users: make(map[int]usecases.User),
+		names: make(map[string]int),
+	}
+}
+
+func (r *Repository) GetUser(ctx context.Context, id int) (usecases.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, ok := r.users[id]
+	if !ok {
+		return usecases.User{}, usecases.ErrNotFound
+	}
+
+	return user, nil
+}
+
+// CreateUser сохраняет пользователя и возвращает присвоенный ему ID. ID монотонно возрастают, начиная с 1.
+func (r *Repository) CreateUser(ctx context.Context, user usecases.User) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, taken := r.names[user.Name]
+	if taken {
+		return 0, usecases.ErrAlreadyExists
+	}
+
+	r.lastID++
+
+	user.ID = r.lastID
+	r.store(user)
+
+	return user.ID, nil
+}
+
+func (r *Repository) CreateUsers(ctx context.Context, users []usecases.User) ([]int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	batch := make(map[string]bool, len(users))
+
+	for _, user := range users {
+		_, taken := r.names[user.Name]
+		if taken || batch[user.Name] {
+			return nil, usecases.ErrAlreadyExists
+		}
+
+		batch[user.Name] = true
+	}
+
+	ids := make([]int, 0, len(users))
+
+	for _, user := range users {
+		r.lastID++
+
+		user.ID = r.lastID
+		r.store(user)
+
+		ids = append(ids, user.ID)
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user usecases.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.users[user.ID]
+	if !ok {
+		return usecases.ErrNotFound
+	}
+
+	if stored.Version != user.Version {
+		return usecases.ErrPreconditionFailed
+	}
+
+	id, taken := r.names[user.Name]
+	if taken && id != user.ID {
+		return usecases.ErrAlreadyExists
+	}
+
+	delete(r.names, stored.Name)
+
+	user.Version++
+	r.store(user)
+
+	return nil
+}
+
+func (r *Repository) store(user usecases.User) {
+	r.users[user.ID] = user
+	r.names[user.Name] = user.ID
+}
+
+func (r *Repository) ListUsers(ctx context.Context, query usecases.ListUsersQuery) ([]usecases.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]usecases.User, 0, len(r.users))
+
+	for _, user := range r.users {
+		if query.Matches(user) {
+			result = append(result, user)
+		}
+	}
+
+	slices.SortFunc(result, query.Compare)
+
+	if len(result) > query.Limit {
+		result = result[:query.Limit]
+	}
+
+	return result, nil
+}
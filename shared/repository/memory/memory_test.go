@@ -0,0 +1,340 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"shared/usecases"
+)
+
+func TestRepository_CreateAndGetUser(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	if id != 1 {
+		t.Fatalf("CreateUser() id = %d, want 1", id)
+	}
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: 1, Name: "Alice"}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+
+	_, err = r.GetUser(ctx, 2)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("GetUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_ConcurrentCreateUser(t *testing.T) {
+	const (
+		writers   = 16
+		perWriter = 100
+	)
+
+	ctx := context.Background()
+	r := New()
+
+	ids := make(chan int, writers*perWriter)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < perWriter; i++ {
+				name := strconv.Itoa(w) + "-" + strconv.Itoa(i)
+
+				id, err := r.CreateUser(ctx, usecases.User{Name: name})
+				if err != nil {
+					t.Errorf("CreateUser() error = %v", err)
+
+					return
+				}
+
+				_, err = r.GetUser(ctx, id)
+				if err != nil {
+					t.Errorf("GetUser(%d) error = %v", id, err)
+
+					return
+				}
+
+				ids <- id
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[int]bool, writers*perWriter)
+
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("duplicate id %d", id)
+		}
+
+		seen[id] = true
+	}
+
+	for id := 1; id <= writers*perWriter; id++ {
+		if !seen[id] {
+			t.Fatalf("id %d was not assigned", id)
+		}
+	}
+}
+
+func TestRepository_UpdateUser(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Bob"})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Bob", Version: 1}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id + 1, Name: "Carol"})
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("UpdateUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_ListUsers_FilterAndSort(t *testing.T) {
+	ctx := context.Background()
+
+	r := New()
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	users := []usecases.User{
+		{Name: "ann", CreatedAt: base.Add(1 * time.Hour)},
+		{Name: "bob", CreatedAt: base.Add(2 * time.Hour)},
+		{Name: "anna", CreatedAt: base.Add(3 * time.Hour)},
+		{Name: "annie", CreatedAt: base.Add(3 * time.Hour)},
+		{Name: "Ann", CreatedAt: base.Add(5 * time.Hour)},
+	}
+
+	for i := range users {
+		id, err := r.CreateUser(ctx, users[i])
+		if err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+
+		users[i].ID = id
+	}
+
+	tests := []struct {
+		name  string
+		query usecases.ListUsersQuery
+		want  []int
+	}{
+		{
+			name: "name prefix is case sensitive",
+			query: usecases.ListUsersQuery{
+				NamePrefix: "ann",
+				Sort:       []usecases.SortKey{{Field: usecases.SortByID}},
+			},
+			want: []int{1, 3, 4},
+		},
+		{
+			name: "created after is exclusive",
+			query: usecases.ListUsersQuery{
+				CreatedAfter: base.Add(2 * time.Hour),
+				Sort:         []usecases.SortKey{{Field: usecases.SortByID}},
+			},
+			want: []int{3, 4, 5},
+		},
+		{
+			name: "name asc",
+			query: usecases.ListUsersQuery{
+				Sort: []usecases.SortKey{{Field: usecases.SortByName}},
+			},
+			want: []int{5, 1, 3, 4, 2},
+		},
+		{
+			name: "created at asc, id desc",
+			query: usecases.ListUsersQuery{
+				Sort: []usecases.SortKey{{Field: usecases.SortByCreatedAt}, {Field: usecases.SortByID, Desc: true}},
+			},
+			want: []int{1, 2, 4, 3, 5},
+		},
+		{
+			name: "after key with mixed directions",
+			query: usecases.ListUsersQuery{
+				Sort:  []usecases.SortKey{{Field: usecases.SortByCreatedAt}, {Field: usecases.SortByID, Desc: true}},
+				After: &users[3],
+			},
+			want: []int{3, 5},
+		},
+		{
+			name: "created at desc",
+			query: usecases.ListUsersQuery{
+				Sort: []usecases.SortKey{{Field: usecases.SortByCreatedAt, Desc: true}, {Field: usecases.SortByID}},
+			},
+			want: []int{5, 3, 4, 2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Limit = 10
+
+			got, err := r.ListUsers(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("ListUsers() error = %v", err)
+			}
+
+			ids := make([]int, 0, len(got))
+
+			for _, user := range got {
+				ids = append(ids, user.ID)
+			}
+
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Fatalf("ListUsers() ids = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+}
+
+func TestRepository_CreateUsers(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+
+	_, err := r.CreateUser(ctx, usecases.User{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	ids, err := r.CreateUsers(ctx, []usecases.User{{Name: "Bob"}, {Name: "Carol"}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{2, 3}) {
+		t.Fatalf("CreateUsers() ids = %v, want [2 3]", ids)
+	}
+
+	got, err := r.GetUser(ctx, 3)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: 3, Name: "Carol"}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRepository_UpdateUser_Version(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Bob", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Carol", Version: 1})
+	if !errors.Is(err, usecases.ErrPreconditionFailed) {
+		t.Fatalf("UpdateUser() with stale version error = %v, want %v", err, usecases.ErrPreconditionFailed)
+	}
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Bob", Version: 2}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRepository_UniqueName(t *testing.T) {
+	ctx := context.Background()
+	r := New()
+
+	ids, err := r.CreateUsers(ctx, []usecases.User{{Name: "Alice", Version: 1}, {Name: "Bob", Version: 1}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() with a taken name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// пакет с занятым или повторяющимся именем не сохраняется целиком
+	for _, names := range [][]string{{"Carol", "Bob"}, {"Carol", "Carol"}} {
+		_, err = r.CreateUsers(ctx, []usecases.User{{Name: names[0], Version: 1}, {Name: names[1], Version: 1}})
+		if !errors.Is(err, usecases.ErrAlreadyExists) {
+			t.Fatalf("CreateUsers(%v) error = %v, want %v", names, err, usecases.ErrAlreadyExists)
+		}
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("UpdateUser() to a taken name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// удаленный пользователь сохраняет имя, а переименованный освобождает прежнее
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[0], Name: "Alice", Version: 1, DeletedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() with a deleted user's name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Robert", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	_, err = r.CreateUsers(ctx, []usecases.User{{Name: "Bob", Version: 1}, {Name: "Carol", Version: 1}})
+	if err != nil {
+		t.Fatalf("CreateUsers() with a freed name error = %v", err)
+	}
+}
@@ -0,0 +1,5 @@
+-- время создания в наносекундах Unix; 0 - неизвестно (пользователи, созданные до миграции)
+ALTER TABLE users ADD COLUMN created_at INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX users_name_idx ON users (name);
+CREATE INDEX users_created_at_idx ON users (created_at);
@@ -0,0 +1,2 @@
+-- версия для оптимистичной блокировки (ETag / If-Match); существующие пользователи получают версию 1
+ALTER TABLE users ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
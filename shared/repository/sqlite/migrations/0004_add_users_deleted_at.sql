@@ -0,0 +1,2 @@
+-- пометка об удалении (время в наносекундах); 0 - пользователь не удален
+ALTER TABLE users ADD COLUMN deleted_at INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- имена уникальны среди всех пользователей, включая удаленных
+CREATE UNIQUE INDEX users_name ON users (name);
@@ -0,0 +1,434 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sqlitedriver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"shared/usecases"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Repository хранит пользователей в SQLite через database/sql.
+type Repository struct {
+	db *sql.DB
+}
+
+// New открывает базу по dsn и применяет к ней недостающие миграции.
+func New(ctx context.Context, dsn string) (*Repository, error) {
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite: %w", err)
+	}
+
+	// SQLite допускает только одного писателя, поэтому сериализуем доступ на уровне пула
+	db.SetMaxOpenConns(1)
+
+	err = migrate(ctx, db)
+	if err != nil {
+		db.Close()
+
+		return nil, err
+	}
+
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+func (r *Repository) Close() error {
+	return r.db.Close()
+}
+
+func (r *Repository) GetUser(ctx context.Context, id int) (usecases.User, error) {
+	var user usecases.User
+
+	err := scanUser(r.db.QueryRowContext(ctx, `SELECT id, name, created_at, version, deleted_at FROM users WHERE id = ?`, id), &user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return usecases.User{}, usecases.ErrNotFound
+		}
+
+		return usecases.User{}, fmt.Errorf("select user %d: %w", id, err)
+	}
+
+	return user, nil
+}
+
+func (r *Repository) CreateUser(ctx context.Context, user usecases.User) (int, error) {
+	result, err := r.db.ExecContext(ctx, `INSERT INTO users (name, created_at, version) VALUES (?, ?, ?)`, user.Name, toUnixNano(user.CreatedAt), user.Version)
+	if err != nil {
+		return 0, fmt.Errorf("insert user: %w", mapError(err))
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, fmt.Errorf("insert user: %w", err)
+	}
+
+	return int(id), nil
+}
+
+func (r *Repository) CreateUsers(ctx context.Context, users []usecases.User) ([]int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("insert users: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO users (name, created_at, version) VALUES (?, ?, ?)`)
+	if err != nil {
+		return nil, fmt.Errorf("insert users: %w", err)
+	}
+	defer stmt.Close()
+
+	ids := make([]int, 0, len(users))
+
+	for _, user := range users {
+		result, err := stmt.ExecContext(ctx, user.Name, toUnixNano(user.CreatedAt), user.Version)
+		if err != nil {
+			return nil, fmt.Errorf("insert users: %w", mapError(err))
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("insert users: %w", err)
+		}
+
+		ids = append(ids, int(id))
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("insert users: %w", err)
+	}
+
+	return ids, nil
+}
+
+func (r *Repository) UpdateUser(ctx context.Context, user usecases.User) error {
+	result, err := r.db.ExecContext(ctx, `UPDATE users SET name = ?, deleted_at = ?, version = version + 1 WHERE id = ? AND version = ?`, user.Name, toUnixNano(user.DeletedAt), user.ID, user.Version)
+	if err != nil {
+		return fmt.Errorf("update user %d: %w", user.ID, mapError(err))
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update user %d: %w", user.ID, err)
+	}
+
+	if affected > 0 {
+		return nil
+	}
+
+	// ни одной строки: либо пользователя нет, либо его версия уже другая
+	var exists bool
+
+	err = r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = ?)`, user.ID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("update user %d: %w", user.ID, err)
+	}
+
+	if !exists {
+		return usecases.ErrNotFound
+	}
+
+	return usecases.ErrPreconditionFailed
+}
+
+// sortColumns - соответствие полей сортировки колонкам; в запрос подставляются только эти имена.
+var sortColumns = map[usecases.SortField]string{
+	usecases.SortByID:        "id",
+	usecases.SortByName:      "name",
+	usecases.SortByCreatedAt: "created_at",
+}
+
+func (r *Repository) ListUsers(ctx context.Context, query usecases.ListUsersQuery) ([]usecases.User, error) {
+	sqlQuery, args, err := buildListQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]usecases.User, 0, query.Limit)
+
+	for rows.Next() {
+		var user usecases.User
+
+		err = scanUser(rows, &user)
+		if err != nil {
+			return nil, fmt.Errorf("scan user: %w", err)
+		}
+
+		users = append(users, user)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("select users: %w", err)
+	}
+
+	return users, nil
+}
+
+// buildListQuery собирает SELECT по фильтрам и сортировке запроса. Условие "после курсора" при
+// сортировке по нескольким ключам с разными направлениями раскрывается в цепочку
+// (k1 > v1) OR (k1 = v1 AND k2 < v2) OR ..., так как сравнение кортежей в SQLite знает только одно направление.
+func buildListQuery(query usecases.ListUsersQuery) (string, []any, error) {
+	var (
+		where []string
+		args  []any
+	)
+
+	if !query.IncludeDeleted {
+		where = append(where, `deleted_at = 0`)
+	}
+
+	if query.NamePrefix != "" {
+		where = append(where, `substr(name, 1, length(?)) = ?`)
+		args = append(args, query.NamePrefix, query.NamePrefix)
+	}
+
+	if !query.CreatedAfter.IsZero() {
+		where = append(where, `created_at > ?`)
+		args = append(args, toUnixNano(query.CreatedAfter))
+	}
+
+	orderBy := make([]string, 0, len(query.Sort))
+
+	for _, key := range query.Sort {
+		column, ok := sortColumns[key.Field]
+		if !ok {
+			return "", nil, fmt.Errorf("%w: unknown field %q", usecases.ErrInvalidSort, key.Field)
+		}
+
+		if key.Desc {
+			orderBy = append(orderBy, column+" DESC")
+		} else {
+			orderBy = append(orderBy, column)
+		}
+	}
+
+	if query.After != nil {
+		after := map[usecases.SortField]any{
+			usecases.SortByID:        query.After.ID,
+			usecases.SortByName:      query.After.Name,
+			usecases.SortByCreatedAt: toUnixNano(query.After.CreatedAt),
+		}
+
+		var or []string
+
+		for i, key := range query.Sort {
+			and := make([]string, 0, i+1)
+
+			for _, prev := range query.Sort[:i] {
+				and = append(and, sortColumns[prev.Field]+" = ?")
+				args = append(args, after[prev.Field])
+			}
+
+			op := " > ?"
+			if key.Desc {
+				op = " < ?"
+			}
+
+			and = append(and, sortColumns[key.Field]+op)
+			args = append(args, after[key.Field])
+
+			or = append(or, "("+strings.Join(and, " AND ")+")")
+		}
+
+		if len(or) > 0 {
+			where = append(where, "("+strings.Join(or, " OR ")+")")
+		}
+	}
+
+	var b strings.Builder
+
+	b.WriteString(`SELECT id, name, created_at, version, deleted_at FROM users`)
+
+	if len(where) > 0 {
+		b.WriteString(" WHERE " + strings.Join(where, " AND "))
+	}
+
+	if len(orderBy) > 0 {
+		b.WriteString(" ORDER BY " + strings.Join(orderBy, ", "))
+	}
+
+	b.WriteString(" LIMIT ?")
+	args = append(args, query.Limit)
+
+	return b.String(), args, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row scanner, user *usecases.User) error {
+	var createdAt, deletedAt int64
+
+	err := row.Scan(&user.ID, &user.Name, &createdAt, &user.Version, &deletedAt)
+	if err != nil {
+		return err
+	}
+
+	user.CreatedAt = fromUnixNano(createdAt)
+	user.DeletedAt = fromUnixNano(deletedAt)
+
+	return nil
+}
+
+// toUnixNano и fromUnixNano переводят время в колонки created_at и deleted_at и обратно; нулевое время хранится как 0.
+func toUnixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+func fromUnixNano(n int64) time.Time {
+	if n == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, n).UTC()
+}
+
+// mapError переводит нарушение уникальности имени в usecases.ErrAlreadyExists, сохраняя исходную ошибку в цепочке.
+func mapError(err error) error {
+	var sqliteErr *sqlitedriver.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	if sqliteErr.Code() != sqlite3.SQLITE_CONSTRAINT_UNIQUE {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", usecases.ErrAlreadyExists, err)
+}
+
+type migration struct {
+	version int
+	name    string
+}
+
+// migrate применяет встроенные миграции migrations/NNNN_*.sql по возрастанию версии.
+// Каждая миграция выполняется в отдельной транзакции вместе с записью о ней в schema_migrations.
+func migrate(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY)`)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	list, err := listMigrations()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range list {
+		err = apply(ctx, db, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listMigrations() ([]migration, error) {
+	entries, err := fs.ReadDir(migrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	list := make([]migration, 0, len(entries))
+
+	for _, entry := range entries {
+		prefix, _, ok := strings.Cut(entry.Name(), "_")
+		if !ok {
+			return nil, fmt.Errorf("migration %q: name must be NNNN_description.sql", entry.Name())
+		}
+
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("migration %q: bad version: %w", entry.Name(), err)
+		}
+
+		list = append(list, migration{
+			version: version,
+			name:    entry.Name(),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].version < list[j].version
+	})
+
+	for i := 1; i < len(list); i++ {
+		if list[i].version == list[i-1].version {
+			return nil, fmt.Errorf("migrations %q and %q have the same version", list[i-1].name, list[i].name)
+		}
+	}
+
+	return list, nil
+}
+
+func apply(ctx context.Context, db *sql.DB, m migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", m.name, err)
+	}
+	defer tx.Rollback()
+
+	var applied bool
+
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = ?)`, m.version).Scan(&applied)
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", m.name, err)
+	}
+
+	if applied {
+		return nil
+	}
+
+	query, err := migrations.ReadFile("migrations/" + m.name)
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", m.name, err)
+	}
+
+	_, err = tx.ExecContext(ctx, string(query))
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", m.name, err)
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version) VALUES (?)`, m.version)
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", m.name, err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("migration %s: %w", m.name, err)
+	}
+
+	return nil
+}
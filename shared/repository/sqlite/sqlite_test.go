@@ -0,0 +1,444 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"shared/usecases"
+)
+
+func TestRepository_CreateAndGetUser(t *testing.T) {
+	ctx := context.Background()
+	dsn := filepath.Join(t.TempDir(), "users.db")
+
+	r, err := New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	err = r.Close()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// повторное открытие не должно заново применять миграции и терять данные
+	r, err = New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Alice"}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+
+	nextID, err := r.CreateUser(ctx, usecases.User{Name: "Bob"})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	if nextID <= id {
+		t.Fatalf("CreateUser() id = %d, want > %d", nextID, id)
+	}
+
+	_, err = r.GetUser(ctx, nextID+1)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("GetUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_SoftDelete(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	deletedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Alice", Version: 1, DeletedAt: deletedAt})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Alice", Version: 2, DeletedAt: deletedAt}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+
+	users, err := r.ListUsers(ctx, usecases.ListUsersQuery{Sort: []usecases.SortKey{{Field: usecases.SortByID}}, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	if len(users) != 0 {
+		t.Fatalf("ListUsers() = %+v, want no users", users)
+	}
+
+	users, err = r.ListUsers(ctx, usecases.ListUsersQuery{IncludeDeleted: true, Sort: []usecases.SortKey{{Field: usecases.SortByID}}, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(users, []usecases.User{want}) {
+		t.Fatalf("ListUsers() with IncludeDeleted = %+v, want %+v", users, []usecases.User{want})
+	}
+}
+
+func TestRepository_UpdateUser(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Bob"})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Bob", Version: 1}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id + 1, Name: "Carol"})
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("UpdateUser() error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestRepository_ListUsers(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	for _, name := range []string{"Alice", "Bob", "Carol", "Dave"} {
+		_, err = r.CreateUser(ctx, usecases.User{Name: name})
+		if err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+	}
+
+	// удаленный пользователь не попадает в список
+	err = r.UpdateUser(ctx, usecases.User{ID: 2, Name: "Bob", DeletedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	query := usecases.ListUsersQuery{
+		Sort:  []usecases.SortKey{{Field: usecases.SortByID}},
+		Limit: 2,
+	}
+
+	got, err := r.ListUsers(ctx, query)
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	want := []usecases.User{{ID: 1, Name: "Alice"}, {ID: 3, Name: "Carol"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListUsers() = %+v, want %+v", got, want)
+	}
+
+	query.After = &usecases.User{ID: 3}
+
+	got, err = r.ListUsers(ctx, query)
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	want = []usecases.User{{ID: 4, Name: "Dave"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListUsers() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRepository_ListUsers_FilterAndSort(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	users := []usecases.User{
+		{Name: "ann", CreatedAt: base.Add(1 * time.Hour)},
+		{Name: "bob", CreatedAt: base.Add(2 * time.Hour)},
+		{Name: "anna", CreatedAt: base.Add(3 * time.Hour)},
+		{Name: "annie", CreatedAt: base.Add(3 * time.Hour)},
+		{Name: "Ann", CreatedAt: base.Add(5 * time.Hour)},
+	}
+
+	for i := range users {
+		users[i].ID, err = r.CreateUser(ctx, users[i])
+		if err != nil {
+			t.Fatalf("CreateUser() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		query usecases.ListUsersQuery
+		want  []int
+	}{
+		{
+			name: "name prefix is case sensitive",
+			query: usecases.ListUsersQuery{
+				NamePrefix: "ann",
+				Sort:       []usecases.SortKey{{Field: usecases.SortByID}},
+			},
+			want: []int{1, 3, 4},
+		},
+		{
+			name: "created after is exclusive",
+			query: usecases.ListUsersQuery{
+				CreatedAfter: base.Add(2 * time.Hour),
+				Sort:         []usecases.SortKey{{Field: usecases.SortByID}},
+			},
+			want: []int{3, 4, 5},
+		},
+		{
+			name: "name asc",
+			query: usecases.ListUsersQuery{
+				Sort: []usecases.SortKey{{Field: usecases.SortByName}},
+			},
+			want: []int{5, 1, 3, 4, 2},
+		},
+		{
+			name: "created at asc, id desc",
+			query: usecases.ListUsersQuery{
+				Sort: []usecases.SortKey{{Field: usecases.SortByCreatedAt}, {Field: usecases.SortByID, Desc: true}},
+			},
+			want: []int{1, 2, 4, 3, 5},
+		},
+		{
+			name: "after key with mixed directions",
+			query: usecases.ListUsersQuery{
+				Sort:  []usecases.SortKey{{Field: usecases.SortByCreatedAt}, {Field: usecases.SortByID, Desc: true}},
+				After: &users[3],
+			},
+			want: []int{3, 5},
+		},
+		{
+			name: "created at desc",
+			query: usecases.ListUsersQuery{
+				Sort: []usecases.SortKey{{Field: usecases.SortByCreatedAt, Desc: true}, {Field: usecases.SortByID}},
+			},
+			want: []int{5, 3, 4, 2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.query.Limit = 10
+
+			got, err := r.ListUsers(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("ListUsers() error = %v", err)
+			}
+
+			ids := make([]int, 0, len(got))
+
+			for _, user := range got {
+				ids = append(ids, user.ID)
+			}
+
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Fatalf("ListUsers() ids = %v, want %v", ids, tt.want)
+			}
+		})
+	}
+
+	got, err := r.GetUser(ctx, users[0].ID)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	if got != users[0] {
+		t.Fatalf("GetUser() = %+v, want %+v", got, users[0])
+	}
+}
+
+func TestRepository_CreateUsers(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	ids, err := r.CreateUsers(ctx, []usecases.User{{Name: "Alice"}, {Name: "Bob"}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(ids, []int{1, 2}) {
+		t.Fatalf("CreateUsers() ids = %v, want [1 2]", ids)
+	}
+
+	// ошибка посреди пакета должна откатить уже вставленные строки
+	_, err = r.db.ExecContext(ctx, `CREATE TRIGGER reject_carol BEFORE INSERT ON users WHEN NEW.name = 'Carol' BEGIN SELECT RAISE(ABORT, 'carol'); END`)
+	if err != nil {
+		t.Fatalf("create trigger: %v", err)
+	}
+
+	_, err = r.CreateUsers(ctx, []usecases.User{{Name: "Dave"}, {Name: "Carol"}})
+	if err == nil {
+		t.Fatalf("CreateUsers() error = nil, want error")
+	}
+
+	got, err := r.ListUsers(ctx, usecases.ListUsersQuery{
+		Sort:  []usecases.SortKey{{Field: usecases.SortByID}},
+		Limit: 10,
+	})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	want := []usecases.User{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListUsers() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRepository_UpdateUser_Version(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	id, err := r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if err != nil {
+		t.Fatalf("CreateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Bob", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id, Name: "Carol", Version: 1})
+	if !errors.Is(err, usecases.ErrPreconditionFailed) {
+		t.Fatalf("UpdateUser() with stale version error = %v, want %v", err, usecases.ErrPreconditionFailed)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: id + 1, Name: "Carol", Version: 1})
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("UpdateUser() of missing user error = %v, want %v", err, usecases.ErrNotFound)
+	}
+
+	got, err := r.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Bob", Version: 2}
+	if got != want {
+		t.Fatalf("GetUser() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRepository_UniqueName(t *testing.T) {
+	ctx := context.Background()
+
+	r, err := New(ctx, filepath.Join(t.TempDir(), "users.db"))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer r.Close()
+
+	ids, err := r.CreateUsers(ctx, []usecases.User{{Name: "Alice", Version: 1}, {Name: "Bob", Version: 1}})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() with a taken name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// пакет с занятым или повторяющимся именем не сохраняется целиком
+	for _, names := range [][]string{{"Carol", "Bob"}, {"Carol", "Carol"}} {
+		_, err = r.CreateUsers(ctx, []usecases.User{{Name: names[0], Version: 1}, {Name: names[1], Version: 1}})
+		if !errors.Is(err, usecases.ErrAlreadyExists) {
+			t.Fatalf("CreateUsers(%v) error = %v, want %v", names, err, usecases.ErrAlreadyExists)
+		}
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("UpdateUser() to a taken name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// удаленный пользователь сохраняет имя, а переименованный освобождает прежнее
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[0], Name: "Alice", Version: 1, DeletedAt: time.Now()})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	_, err = r.CreateUser(ctx, usecases.User{Name: "Alice", Version: 1})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUser() with a deleted user's name error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	err = r.UpdateUser(ctx, usecases.User{ID: ids[1], Name: "Robert", Version: 1})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	_, err = r.CreateUsers(ctx, []usecases.User{{Name: "Bob", Version: 1}, {Name: "Carol", Version: 1}})
+	if err != nil {
+		t.Fatalf("CreateUsers() with a freed name error = %v", err)
+	}
+}
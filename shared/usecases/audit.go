@@ -0,0 +1,117 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// IncidentReporter регистрирует сбои, скрытые от клиента (см. incident.Reporter). code - код ответа клиенту,
+// 0 - запрос завершился успешно.
+type IncidentReporter interface {
+	Report(ctx context.Context, code int, err error) (id string, debugMessage string)
+}
+
+// AuditLog - append-only журнал изменений пользователей.
+type AuditLog interface {
+	// Append дописывает entry в конец журнала и возвращает ее с заполненными Seq, PrevHash и Hash.
+	Append(ctx context.Context, entry AuditEntry) (AuditEntry, error)
+	// UserHistory возвращает записи пользователя id в порядке добавления.
+	UserHistory(ctx context.Context, id int) ([]AuditEntry, error)
+}
+
+type AuditAction string
+
+const (
+	AuditActionCreate  AuditAction = "create"
+	AuditActionUpdate  AuditAction = "update"
+	AuditActionDelete  AuditAction = "delete"
+	AuditActionRestore AuditAction = "restore"
+)
+
+// AuditEntry - одно изменение пользователя. Hash считается по всем остальным полям, включая PrevHash -
+// хэш предыдущей записи журнала, поэтому переписать или удалить запись незаметно нельзя.
+type AuditEntry struct {
+	// Seq - номер записи во всем журнале, начиная с 1
+	Seq    int
+	UserID int
+	Action AuditAction
+	// Actor - автор изменения из WithActor; пустой для анонимных запросов
+	Actor string
+	At    time.Time
+	// Version - версия пользователя после изменения
+	Version  int
+	Changes  []AuditChange
+	PrevHash string
+	Hash     string
+}
+
+// AuditChange - изменение одного поля. Пустое значение означает его отсутствие.
+type AuditChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+type actorKey struct{}
+
+// WithActor кладет в контекст аутентифицированного автора запроса, чтобы он попал в журнал изменений.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext возвращает автора, положенного WithActor, или пустую строку.
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+
+	return actor
+}
+
+// UserHistory отдает журнал изменений пользователя, в том числе удаленного.
+func (u *UseCases) UserHistory(ctx context.Context, id int) ([]AuditEntry, error) {
+	_, err := u.repository.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.auditLog.UserHistory(ctx, id)
+}
+
+// audit записывает в журнал переход пользователя из before в after. Изменение к этому моменту уже сохранено:
+// если вернуть клиенту ошибку, повтор запроса сделает его еще раз, поэтому сбой журнала регистрируется как
+// инцидент, а запрос завершается успешно.
+func (u *UseCases) audit(ctx context.Context, action AuditAction, before, after User) {
+	_, err := u.auditLog.Append(ctx, AuditEntry{
+		UserID:  after.ID,
+		Action:  action,
+		Actor:   ActorFromContext(ctx),
+		At:      time.Now().UTC(),
+		Version: after.Version,
+		Changes: diffUsers(before, after),
+	})
+	if err != nil && u.incidents != nil {
+		u.incidents.Report(ctx, 0, fmt.Errorf("%w: %s user %d version %d: %w", ErrAuditFailed, action, after.ID, after.Version, err))
+	}
+}
+
+func diffUsers(before, after User) []AuditChange {
+	changes := make([]AuditChange, 0, 2)
+
+	if before.Name != after.Name {
+		changes = append(changes, AuditChange{Field: "name", From: before.Name, To: after.Name})
+	}
+
+	if !before.DeletedAt.Equal(after.DeletedAt) {
+		changes = append(changes, AuditChange{Field: "deleted_at", From: formatAuditTime(before.DeletedAt), To: formatAuditTime(after.DeletedAt)})
+	}
+
+	return changes
+}
+
+func formatAuditTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
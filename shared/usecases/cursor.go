@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// cursor - содержимое непрозрачного курсора пагинации. Клиенту он отдается как base64 от JSON,
+// поэтому формат можно расширять, не ломая API.
+type cursor struct {
+	AfterID        int       `json:"after_id"`
+	AfterName      string    `json:"after_name,omitempty"`
+	AfterCreatedAt time.Time `json:"after_created_at,omitzero"`
+	// Sort - сортировка, для которой выдан курсор
+	Sort string `json:"sort"`
+}
+
+func encodeCursor(c cursor) string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		// в структуре нет типов, которые могут не сериализоваться
+		panic(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor{}, newValidationError("cursor", RuleFormat, "is invalid")
+	}
+
+	var c cursor
+
+	err = json.Unmarshal(data, &c)
+	if err != nil || c.AfterID < 0 {
+		return cursor{}, newValidationError("cursor", RuleFormat, "is invalid")
+	}
+
+	return c, nil
+}
@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type SortField string
+
+const (
+	SortByID        SortField = "id"
+	SortByName      SortField = "name"
+	SortByCreatedAt SortField = "created_at"
+)
+
+type SortKey struct {
+	Field SortField
+	Desc  bool
+}
+
+// ListUsersQuery - запрос к репозиторию за страницей пользователей.
+type ListUsersQuery struct {
+	NamePrefix string
+	// CreatedAfter - нижняя граница времени создания (не включительно); нулевое значение - без фильтра
+	CreatedAfter time.Time
+	// IncludeDeleted - не отбрасывать удаленных пользователей
+	IncludeDeleted bool
+	// Sort всегда заканчивается ключом по ID, поэтому порядок полный
+	Sort []SortKey
+	// After - ключ последнего пользователя предыдущей страницы; nil - с начала
+	After *User
+	Limit int
+}
+
+// Matches сообщает, подходит ли пользователь под фильтры запроса и лежит ли он после After.
+// Нужен репозиториям, которые фильтруют в памяти.
+func (q ListUsersQuery) Matches(user User) bool {
+	if user.Deleted() && !q.IncludeDeleted {
+		return false
+	}
+
+	if !strings.HasPrefix(user.Name, q.NamePrefix) {
+		return false
+	}
+
+	if !q.CreatedAfter.IsZero() && !user.CreatedAt.After(q.CreatedAfter) {
+		return false
+	}
+
+	return q.After == nil || q.Compare(user, *q.After) > 0
+}
+
+// Compare сравнивает пользователей в порядке q.Sort, подходит для slices.SortFunc.
+func (q ListUsersQuery) Compare(a, b User) int {
+	for _, key := range q.Sort {
+		var c int
+
+		switch key.Field {
+		case SortByID:
+			c = cmp.Compare(a.ID, b.ID)
+		case SortByName:
+			c = strings.Compare(a.Name, b.Name)
+		case SortByCreatedAt:
+			c = a.CreatedAt.Compare(b.CreatedAt)
+		}
+
+		if key.Desc {
+			c = -c
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// parseSort разбирает поля вида "name", "-id". Если ID среди них нет, он добавляется последним ключом,
+// чтобы порядок был полным и курсор однозначно указывал на позицию.
+func parseSort(fields []string) ([]SortKey, error) {
+	keys := make([]SortKey, 0, len(fields)+1)
+	seen := make(map[SortField]bool, len(fields))
+
+	for _, field := range fields {
+		var key SortKey
+
+		name, desc := strings.CutPrefix(field, "-")
+
+		key.Field = SortField(name)
+		key.Desc = desc
+
+		switch key.Field {
+		case SortByID, SortByName, SortByCreatedAt:
+		default:
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidSort, name)
+		}
+
+		if seen[key.Field] {
+			return nil, fmt.Errorf("%w: duplicate field %q", ErrInvalidSort, name)
+		}
+
+		seen[key.Field] = true
+
+		keys = append(keys, key)
+	}
+
+	if !seen[SortByID] {
+		keys = append(keys, SortKey{Field: SortByID})
+	}
+
+	return keys, nil
+}
+
+func formatSort(keys []SortKey) string {
+	fields := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		if key.Desc {
+			fields = append(fields, "-"+string(key.Field))
+		} else {
+			fields = append(fields, string(key.Field))
+		}
+	}
+
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,451 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type UseCases struct {
+	repository Repository
+	auditLog   AuditLog
+	incidents  IncidentReporter
+}
+
+type Repository interface {
+	GetUser(ctx context.Context, id int) (User, error)
+	// CreateUser возвращает ErrAlreadyExists, если имя user уже занято.
+	CreateUser(ctx context.Context, user User) (int, error)
+	// CreateUsers сохраняет пользователей атомарно (либо все, либо ни одного) и возвращает их ID в том же порядке.
+	// Если хотя бы одно имя занято или повторяется в users, не сохраняется ни один и возвращается ErrAlreadyExists.
+	CreateUsers(ctx context.Context, users []User) ([]int, error)
+	// UpdateUser сохраняет user и увеличивает его версию, если сохраненная версия равна user.Version,
+	// иначе возвращает ErrPreconditionFailed. Если имя занято другим пользователем, возвращает ErrAlreadyExists.
+	UpdateUser(ctx context.Context, user User) error
+	// ListUsers возвращает до query.Limit пользователей, подходящих под query, в порядке query.Sort.
+	ListUsers(ctx context.Context, query ListUsersQuery) ([]User, error)
+}
+
+// New - use cases поверх repository и auditLog. incidents получает сбои записи в журнал: изменение к этому
+// моменту уже сохранено, и клиент о сбое не узнает; nil incidents такие сбои не регистрирует.
+func New(repository Repository, auditLog AuditLog, incidents IncidentReporter) *UseCases {
+	return &UseCases{
+		repository: repository,
+		auditLog:   auditLog,
+		incidents:  incidents,
+	}
+}
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrNotPublic1  = errors.New("we can't expose this text 1")
+	ErrNotPublic2  = errors.New("we can't expose this text 2")
+	ErrUnknown     = errors.New("unknown error")
+	ErrValidation  = errors.New("validation error")
+	ErrInvalidSort = errors.New("invalid sort")
+	ErrRolledBack  = errors.New("rolled back")
+	// ErrPreconditionFailed - версия пользователя не совпала с ожидаемой (If-Match)
+	ErrPreconditionFailed = errors.New("precondition failed")
+	// ErrAlreadyExists - имя занято другим пользователем; имена уникальны среди всех пользователей, включая удаленных,
+	// чтобы восстановление не приводило к повторам
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrGone - пользователь удален (помечен удаленным) и может быть восстановлен через RestoreUser
+	ErrGone = errors.New("gone")
+	// ErrForbidden - запрос доступен только аутентифицированному автору (см. WithActor)
+	ErrForbidden = errors.New("forbidden")
+	// ErrAuditFailed - изменение сохранено, но не записано в журнал; клиенту не возвращается (см. IncidentReporter)
+	ErrAuditFailed = errors.New("audit log write failed")
+)
+
+func (u *UseCases) GetUser(ctx context.Context, id int) (User, error) {
+	user, err := u.repository.GetUser(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+
+	if user.Deleted() {
+		return User{}, ErrGone
+	}
+
+	return user, nil
+}
+
+type User struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	// Version увеличивается при каждом изменении пользователя, начиная с 1
+	Version int
+	// DeletedAt - время удаления; нулевое значение у неудаленных пользователей
+	DeletedAt time.Time
+}
+
+func (u User) Deleted() bool {
+	return !u.DeletedAt.IsZero()
+}
+
+// VersionMatch - ожидаемая версия пользователя при изменении (If-Match).
+type VersionMatch struct {
+	// Any - подходит любая существующая версия
+	Any      bool
+	Versions []int
+}
+
+func (m VersionMatch) Matches(version int) bool {
+	return m.Any || slices.Contains(m.Versions, version)
+}
+
+type CreateUserRequestDTO struct {
+	Name string
+}
+
+func (u *UseCases) CreateUsers(ctx context.Context, createUserRequestDTO CreateUserRequestDTO) (int, error) {
+	err := validateCreateUser(createUserRequestDTO)
+	if err != nil {
+		return 0, err
+	}
+
+	user := User{
+		Name:      createUserRequestDTO.Name,
+		CreatedAt: time.Now().UTC(),
+		Version:   1,
+	}
+
+	id, err := u.repository.CreateUser(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+
+	user.ID = id
+
+	u.audit(ctx, AuditActionCreate, User{}, user)
+
+	return id, nil
+}
+
+func validateCreateUser(createUserRequestDTO CreateUserRequestDTO) error {
+	return validateName(createUserRequestDTO.Name)
+}
+
+const MaxBatchSize = 100
+
+type CreateUsersBatchRequestDTO struct {
+	Items []CreateUserRequestDTO
+	// AllOrNothing - если хотя бы один элемент не проходит валидацию, не создавать ни одного
+	AllOrNothing bool
+}
+
+// CreateUserResult - результат для одного элемента пакета: либо ID, либо Err.
+type CreateUserResult struct {
+	ID  int
+	Err error
+}
+
+type CreateUsersBatchResult struct {
+	// Results - в том же порядке, что и элементы запроса
+	Results []CreateUserResult
+	// RolledBack - пакет отклонен целиком, ни один пользователь не создан
+	RolledBack bool
+}
+
+// CreateUsersBatch создает пользователей пакетом. Ошибки валидации отдельных элементов возвращаются в их результатах,
+// error - только для ошибок всего запроса. Валидные элементы сохраняются одним атомарным вызовом репозитория.
+func (u *UseCases) CreateUsersBatch(ctx context.Context, createUsersBatchRequestDTO CreateUsersBatchRequestDTO) (CreateUsersBatchResult, error) {
+	items := createUsersBatchRequestDTO.Items
+
+	if len(items) == 0 || len(items) > MaxBatchSize {
+		return CreateUsersBatchResult{}, newValidationError("items", RuleLength, fmt.Sprintf("must contain between 1 and %d items", MaxBatchSize))
+	}
+
+	results := make([]CreateUserResult, len(items))
+	users := make([]User, 0, len(items))
+	createdAt := time.Now().UTC()
+	failed := false
+
+	for i, item := range items {
+		err := validateCreateUser(item)
+		if err != nil {
+			results[i].Err = err
+			failed = true
+
+			continue
+		}
+
+		users = append(users, User{
+			Name:      item.Name,
+			CreatedAt: createdAt,
+			Version:   1,
+		})
+	}
+
+	if failed && createUsersBatchRequestDTO.AllOrNothing {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Err = ErrRolledBack
+			}
+		}
+
+		return CreateUsersBatchResult{Results: results, RolledBack: true}, nil
+	}
+
+	if len(users) > 0 {
+		ids, err := u.repository.CreateUsers(ctx, users)
+		if err != nil {
+			return CreateUsersBatchResult{}, err
+		}
+
+		// ID идут по порядку валидных элементов
+		next := 0
+
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].ID = ids[next]
+				next++
+			}
+		}
+
+		for i, user := range users {
+			user.ID = ids[i]
+
+			u.audit(ctx, AuditActionCreate, User{}, user)
+		}
+	}
+
+	return CreateUsersBatchResult{Results: results}, nil
+}
+
+type UpdateUserRequestDTO struct {
+	Name    string
+	IfMatch VersionMatch
+}
+
+func (u *UseCases) UpdateUser(ctx context.Context, id int, updateUserRequestDTO UpdateUserRequestDTO) (User, error) {
+	err := validateName(updateUserRequestDTO.Name)
+	if err != nil {
+		return User{}, err
+	}
+
+	user, err := u.GetUser(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+
+	if !updateUserRequestDTO.IfMatch.Matches(user.Version) {
+		return User{}, ErrPreconditionFailed
+	}
+
+	before := user
+	user.Name = updateUserRequestDTO.Name
+
+	return u.saveUser(ctx, AuditActionUpdate, before, user)
+}
+
+// PatchUserRequestDTO - частичное обновление: nil-поля не меняются.
+type PatchUserRequestDTO struct {
+	Name    *string
+	IfMatch VersionMatch
+}
+
+func (u *UseCases) PatchUser(ctx context.Context, id int, patchUserRequestDTO PatchUserRequestDTO) (User, error) {
+	if patchUserRequestDTO.Name != nil {
+		err := validateName(*patchUserRequestDTO.Name)
+		if err != nil {
+			return User{}, err
+		}
+	}
+
+	user, err := u.GetUser(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+
+	if !patchUserRequestDTO.IfMatch.Matches(user.Version) {
+		return User{}, ErrPreconditionFailed
+	}
+
+	before := user
+
+	if patchUserRequestDTO.Name != nil {
+		user.Name = *patchUserRequestDTO.Name
+	}
+
+	return u.saveUser(ctx, AuditActionUpdate, before, user)
+}
+
+// saveUser сохраняет пользователя, прочитанного как before и измененного в user, и записывает изменение в журнал.
+// Если его успели изменить после чтения, репозиторий вернет ErrPreconditionFailed.
+func (u *UseCases) saveUser(ctx context.Context, action AuditAction, before, user User) (User, error) {
+	err := u.repository.UpdateUser(ctx, user)
+	if err != nil {
+		return User{}, err
+	}
+
+	user.Version++
+
+	u.audit(ctx, action, before, user)
+
+	return user, nil
+}
+
+// maxModifyAttempts - сколько раз DeleteUser и RestoreUser перечитывают пользователя,
+// если его изменили между чтением и записью
+const maxModifyAttempts = 5
+
+// DeleteUser помечает пользователя удаленным, не стирая его: после этого GetUser возвращает ErrGone,
+// а RestoreUser может вернуть пользователя. Повторное удаление тоже возвращает ErrGone.
+func (u *UseCases) DeleteUser(ctx context.Context, id int) error {
+	_, err := u.modifyUser(ctx, id, AuditActionDelete, func(user *User) (bool, error) {
+		if user.Deleted() {
+			return false, ErrGone
+		}
+
+		user.DeletedAt = time.Now().UTC()
+
+		return true, nil
+	})
+
+	return err
+}
+
+// RestoreUser снимает с пользователя пометку об удалении. Восстановление неудаленного пользователя ничего не меняет.
+func (u *UseCases) RestoreUser(ctx context.Context, id int) (User, error) {
+	return u.modifyUser(ctx, id, AuditActionRestore, func(user *User) (bool, error) {
+		if !user.Deleted() {
+			return false, nil
+		}
+
+		user.DeletedAt = time.Time{}
+
+		return true, nil
+	})
+}
+
+// modifyUser читает пользователя, применяет к нему modify и сохраняет, если modify вернул true.
+// Изменения, не зависящие от версии клиента, не должны падать из-за параллельной записи,
+// поэтому при ErrPreconditionFailed попытка повторяется.
+func (u *UseCases) modifyUser(ctx context.Context, id int, action AuditAction, modify func(user *User) (bool, error)) (User, error) {
+	for attempt := 1; ; attempt++ {
+		user, err := u.repository.GetUser(ctx, id)
+		if err != nil {
+			return User{}, err
+		}
+
+		before := user
+
+		changed, err := modify(&user)
+		if err != nil {
+			return User{}, err
+		}
+
+		if !changed {
+			return user, nil
+		}
+
+		user, err = u.saveUser(ctx, action, before, user)
+		if errors.Is(err, ErrPreconditionFailed) && attempt < maxModifyAttempts {
+			continue
+		}
+
+		return user, err
+	}
+}
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+type ListUsersRequestDTO struct {
+	// Limit - размер страницы; 0 означает DefaultListLimit
+	Limit  int
+	Cursor string
+	// NamePrefix, CreatedAfter - фильтры; пустое значение означает отсутствие фильтра
+	NamePrefix   string
+	CreatedAfter time.Time
+	// Sort - поля сортировки, "-" перед именем означает сортировку по убыванию
+	Sort []string
+	// IncludeDeleted - отдавать и удаленных пользователей; только аутентифицированному автору
+	IncludeDeleted bool
+}
+
+type UsersPage struct {
+	Users []User
+	// NextCursor пустой на последней странице
+	NextCursor string
+}
+
+// ListUsers отдает страницу пользователей, отфильтрованных и отсортированных по запросу (по умолчанию - по ID).
+// Пагинация по ключу (а не по смещению): курсор хранит ключ сортировки последнего выданного пользователя,
+// и следующая страница начинается строго после него. Поэтому параллельное создание пользователей
+// не приводит к повторам и пропускам среди уже существующих.
+func (u *UseCases) ListUsers(ctx context.Context, listUsersRequestDTO ListUsersRequestDTO) (UsersPage, error) {
+	if listUsersRequestDTO.IncludeDeleted && ActorFromContext(ctx) == "" {
+		return UsersPage{}, fmt.Errorf("include deleted users: %w", ErrForbidden)
+	}
+
+	limit := listUsersRequestDTO.Limit
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+
+	if limit < 1 || limit > MaxListLimit {
+		return UsersPage{}, newValidationError("limit", RuleRange, fmt.Sprintf("must be between 1 and %d", MaxListLimit))
+	}
+
+	sort, err := parseSort(listUsersRequestDTO.Sort)
+	if err != nil {
+		return UsersPage{}, err
+	}
+
+	query := ListUsersQuery{
+		NamePrefix:     listUsersRequestDTO.NamePrefix,
+		CreatedAfter:   listUsersRequestDTO.CreatedAfter,
+		IncludeDeleted: listUsersRequestDTO.IncludeDeleted,
+		Sort:           sort,
+		// запрашиваем на одного больше, чтобы понять, есть ли следующая страница
+		Limit: limit + 1,
+	}
+
+	if listUsersRequestDTO.Cursor != "" {
+		c, err := decodeCursor(listUsersRequestDTO.Cursor)
+		if err != nil {
+			return UsersPage{}, err
+		}
+
+		// ключ из курсора имеет смысл только для той сортировки, с которой он был выдан
+		if c.Sort != formatSort(sort) {
+			return UsersPage{}, newValidationError("cursor", RuleFormat, "does not match sort")
+		}
+
+		query.After = &User{
+			ID:        c.AfterID,
+			Name:      c.AfterName,
+			CreatedAt: c.AfterCreatedAt,
+		}
+	}
+
+	users, err := u.repository.ListUsers(ctx, query)
+	if err != nil {
+		return UsersPage{}, err
+	}
+
+	page := UsersPage{
+		Users: users,
+	}
+
+	if len(users) > limit {
+		last := users[limit-1]
+
+		page.Users = users[:limit]
+		page.NextCursor = encodeCursor(cursor{
+			AfterID:        last.ID,
+			AfterName:      last.Name,
+			AfterCreatedAt: last.CreatedAt,
+			Sort:           formatSort(sort),
+		})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,864 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"shared/audit"
+	"shared/repository/memory"
+	"shared/usecases"
+)
+
+func TestUseCases_ListUsers(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	for i := 0; i < 5; i++ {
+		_, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "user" + strconv.Itoa(i)})
+		if err != nil {
+			t.Fatalf("CreateUsers() error = %v", err)
+		}
+	}
+
+	var (
+		ids    []int
+		cursor string
+		pages  int
+	)
+
+	for {
+		page, err := u.ListUsers(ctx, usecases.ListUsersRequestDTO{Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+
+		pages++
+
+		for _, user := range page.Users {
+			ids = append(ids, user.ID)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+
+		cursor = page.NextCursor
+	}
+
+	if pages != 3 {
+		t.Fatalf("pages = %d, want 3", pages)
+	}
+
+	want := []int{1, 2, 3, 4, 5}
+	if len(ids) != len(want) {
+		t.Fatalf("ids = %v, want %v", ids, want)
+	}
+
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ids = %v, want %v", ids, want)
+		}
+	}
+}
+
+func TestUseCases_ListUsers_Validation(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	tests := []struct {
+		name string
+		dto  usecases.ListUsersRequestDTO
+	}{
+		{name: "negative limit", dto: usecases.ListUsersRequestDTO{Limit: -1}},
+		{name: "limit above max", dto: usecases.ListUsersRequestDTO{Limit: usecases.MaxListLimit + 1}},
+		{name: "not base64 cursor", dto: usecases.ListUsersRequestDTO{Cursor: "!!!"}},
+		{name: "not json cursor", dto: usecases.ListUsersRequestDTO{Cursor: "bm90IGpzb24"}},
+		// курсор выдан для сортировки по id: {"after_id":1,"sort":"id"}
+		{name: "cursor from another sort", dto: usecases.ListUsersRequestDTO{Cursor: "eyJhZnRlcl9pZCI6MSwic29ydCI6ImlkIn0", Sort: []string{"name"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := u.ListUsers(ctx, tt.dto)
+			if !errors.Is(err, usecases.ErrValidation) {
+				t.Fatalf("ListUsers() error = %v, want %v", err, usecases.ErrValidation)
+			}
+		})
+	}
+}
+
+// Пока идет постраничный обход, другие клиенты создают пользователей.
+// Каждый пользователь, существовавший до начала обхода, должен встретиться ровно один раз, и порядок не должен нарушаться.
+func TestUseCases_ListUsers_StableUnderConcurrentCreates(t *testing.T) {
+	const existing = 50
+
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	for i := 0; i < existing; i++ {
+		_, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "user" + strconv.Itoa(i)})
+		if err != nil {
+			t.Fatalf("CreateUsers() error = %v", err)
+		}
+	}
+
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				_, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "concurrent"})
+				if err != nil {
+					t.Errorf("CreateUsers() error = %v", err)
+
+					return
+				}
+			}
+		}()
+	}
+
+	seen := make(map[int]int)
+	lastID := 0
+	cursor := ""
+
+	for lastID < existing {
+		page, err := u.ListUsers(ctx, usecases.ListUsersRequestDTO{Limit: 7, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+
+		for _, user := range page.Users {
+			if user.ID <= lastID {
+				t.Fatalf("id %d after %d: order broken", user.ID, lastID)
+			}
+
+			lastID = user.ID
+			seen[user.ID]++
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+
+		cursor = page.NextCursor
+	}
+
+	close(stop)
+	wg.Wait()
+
+	for id := 1; id <= existing; id++ {
+		if seen[id] != 1 {
+			t.Fatalf("user %d seen %d times, want 1", id, seen[id])
+		}
+	}
+}
+
+func TestUseCases_ListUsers_SortAndFilter(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	for _, name := range []string{"carol", "alice", "bob", "alicia", "dave"} {
+		_, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: name})
+		if err != nil {
+			t.Fatalf("CreateUsers() error = %v", err)
+		}
+	}
+
+	listAll := func(dto usecases.ListUsersRequestDTO) []int {
+		t.Helper()
+
+		var ids []int
+
+		for {
+			page, err := u.ListUsers(ctx, dto)
+			if err != nil {
+				t.Fatalf("ListUsers() error = %v", err)
+			}
+
+			for _, user := range page.Users {
+				ids = append(ids, user.ID)
+			}
+
+			if page.NextCursor == "" {
+				return ids
+			}
+
+			dto.Cursor = page.NextCursor
+		}
+	}
+
+	got := listAll(usecases.ListUsersRequestDTO{Limit: 2, Sort: []string{"name", "-id"}})
+	want := []int{2, 4, 3, 1, 5}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("sort=name,-id ids = %v, want %v", got, want)
+	}
+
+	got = listAll(usecases.ListUsersRequestDTO{Limit: 1, NamePrefix: "a", Sort: []string{"created_at"}})
+	want = []int{2, 4}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("name_prefix=a&sort=created_at ids = %v, want %v", got, want)
+	}
+
+	got = listAll(usecases.ListUsersRequestDTO{CreatedAfter: time.Now().Add(time.Hour)})
+	if len(got) != 0 {
+		t.Fatalf("created_after in the future ids = %v, want none", got)
+	}
+}
+
+func TestUseCases_ListUsers_InvalidSort(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	tests := []struct {
+		name string
+		sort []string
+	}{
+		{name: "unknown field", sort: []string{"email"}},
+		{name: "duplicate field", sort: []string{"name", "-name"}},
+		{name: "empty field", sort: []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := u.ListUsers(ctx, usecases.ListUsersRequestDTO{Sort: tt.sort})
+			if !errors.Is(err, usecases.ErrInvalidSort) {
+				t.Fatalf("ListUsers() error = %v, want %v", err, usecases.ErrInvalidSort)
+			}
+		})
+	}
+}
+
+func TestUseCases_UpdateUser_KeepsCreatedAt(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	id, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	created, err := u.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	if created.CreatedAt.IsZero() {
+		t.Fatalf("GetUser() CreatedAt is zero")
+	}
+
+	updated, err := u.UpdateUser(ctx, id, usecases.UpdateUserRequestDTO{Name: "Alicia", IfMatch: usecases.VersionMatch{Any: true}})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	if !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("UpdateUser() CreatedAt = %v, want %v", updated.CreatedAt, created.CreatedAt)
+	}
+}
+
+func TestUseCases_UpdateUser_IfMatch(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	id, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	created, err := u.GetUser(ctx, id)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	if created.Version != 1 {
+		t.Fatalf("GetUser() Version = %d, want 1", created.Version)
+	}
+
+	updated, err := u.UpdateUser(ctx, id, usecases.UpdateUserRequestDTO{Name: "Alicia", IfMatch: usecases.VersionMatch{Versions: []int{1}}})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	if updated.Version != 2 {
+		t.Fatalf("UpdateUser() Version = %d, want 2", updated.Version)
+	}
+
+	// второй писатель прочитал версию 1 и не должен затереть изменение первого
+	_, err = u.UpdateUser(ctx, id, usecases.UpdateUserRequestDTO{Name: "Bob", IfMatch: usecases.VersionMatch{Versions: []int{1}}})
+	if !errors.Is(err, usecases.ErrPreconditionFailed) {
+		t.Fatalf("UpdateUser() error = %v, want %v", err, usecases.ErrPreconditionFailed)
+	}
+
+	name := "Alice"
+
+	_, err = u.PatchUser(ctx, id, usecases.PatchUserRequestDTO{Name: &name})
+	if !errors.Is(err, usecases.ErrPreconditionFailed) {
+		t.Fatalf("PatchUser() without If-Match error = %v, want %v", err, usecases.ErrPreconditionFailed)
+	}
+
+	patched, err := u.PatchUser(ctx, id, usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Versions: []int{5, 2}}})
+	if err != nil {
+		t.Fatalf("PatchUser() error = %v", err)
+	}
+
+	want := usecases.User{ID: id, Name: "Alice", CreatedAt: created.CreatedAt, Version: 3}
+	if patched != want {
+		t.Fatalf("PatchUser() = %+v, want %+v", patched, want)
+	}
+}
+
+func TestUseCases_DeleteAndRestoreUser(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	id, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	err = u.DeleteUser(ctx, id)
+	if err != nil {
+		t.Fatalf("DeleteUser() error = %v", err)
+	}
+
+	_, err = u.GetUser(ctx, id)
+	if !errors.Is(err, usecases.ErrGone) {
+		t.Fatalf("GetUser() error = %v, want %v", err, usecases.ErrGone)
+	}
+
+	err = u.DeleteUser(ctx, id)
+	if !errors.Is(err, usecases.ErrGone) {
+		t.Fatalf("DeleteUser() second time error = %v, want %v", err, usecases.ErrGone)
+	}
+
+	_, err = u.UpdateUser(ctx, id, usecases.UpdateUserRequestDTO{Name: "Alicia", IfMatch: usecases.VersionMatch{Any: true}})
+	if !errors.Is(err, usecases.ErrGone) {
+		t.Fatalf("UpdateUser() error = %v, want %v", err, usecases.ErrGone)
+	}
+
+	page, err := u.ListUsers(ctx, usecases.ListUsersRequestDTO{})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	if len(page.Users) != 0 {
+		t.Fatalf("ListUsers() = %+v, want no users", page.Users)
+	}
+
+	_, err = u.ListUsers(ctx, usecases.ListUsersRequestDTO{IncludeDeleted: true})
+	if !errors.Is(err, usecases.ErrForbidden) {
+		t.Fatalf("ListUsers() anonymous with IncludeDeleted error = %v, want %v", err, usecases.ErrForbidden)
+	}
+
+	page, err = u.ListUsers(usecases.WithActor(ctx, "admin"), usecases.ListUsersRequestDTO{IncludeDeleted: true})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	if len(page.Users) != 1 || !page.Users[0].Deleted() {
+		t.Fatalf("ListUsers() with IncludeDeleted = %+v, want one deleted user", page.Users)
+	}
+
+	restored, err := u.RestoreUser(ctx, id)
+	if err != nil {
+		t.Fatalf("RestoreUser() error = %v", err)
+	}
+
+	// удаление и восстановление - изменения, поэтому версия растет
+	want := usecases.User{ID: id, Name: "Alice", CreatedAt: restored.CreatedAt, Version: 3}
+	if restored != want {
+		t.Fatalf("RestoreUser() = %+v, want %+v", restored, want)
+	}
+
+	again, err := u.RestoreUser(ctx, id)
+	if err != nil {
+		t.Fatalf("RestoreUser() second time error = %v", err)
+	}
+
+	if again != want {
+		t.Fatalf("RestoreUser() second time = %+v, want %+v", again, want)
+	}
+
+	_, err = u.RestoreUser(ctx, id+1)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("RestoreUser() unknown id error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestUseCases_CreateUsersBatch(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	batch, err := u.CreateUsersBatch(ctx, usecases.CreateUsersBatchRequestDTO{
+		Items: []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: ""}, {Name: "Bob"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateUsersBatch() error = %v", err)
+	}
+
+	if batch.RolledBack {
+		t.Fatalf("CreateUsersBatch() RolledBack = true, want false")
+	}
+
+	if batch.Results[0].ID != 1 || batch.Results[2].ID != 2 {
+		t.Fatalf("CreateUsersBatch() results = %+v, want ids 1 and 2 for valid items", batch.Results)
+	}
+
+	if !errors.Is(batch.Results[1].Err, usecases.ErrValidation) {
+		t.Fatalf("CreateUsersBatch() results[1].Err = %v, want %v", batch.Results[1].Err, usecases.ErrValidation)
+	}
+
+	user, err := u.GetUser(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+
+	if user.Name != "Bob" {
+		t.Fatalf("GetUser() name = %q, want %q", user.Name, "Bob")
+	}
+}
+
+// Занятое имя, в том числе удаленным пользователем, отклоняет создание (пакет - целиком) и изменение без записи в журнал.
+func TestUseCases_NameTaken(t *testing.T) {
+	ctx := context.Background()
+	auditLog := audit.New()
+	u := usecases.New(memory.New(), auditLog, nil)
+
+	alice, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	bob, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Bob"})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	_, err = u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUsers() error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	_, err = u.CreateUsersBatch(ctx, usecases.CreateUsersBatchRequestDTO{
+		Items: []usecases.CreateUserRequestDTO{{Name: "Carol"}, {Name: "Bob"}},
+	})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("CreateUsersBatch() error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	_, err = u.UpdateUser(ctx, bob, usecases.UpdateUserRequestDTO{Name: "Alice", IfMatch: usecases.VersionMatch{Any: true}})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("UpdateUser() error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	// удаление не освобождает имя
+	err = u.DeleteUser(ctx, alice)
+	if err != nil {
+		t.Fatalf("DeleteUser() error = %v", err)
+	}
+
+	name := "Alice"
+
+	_, err = u.PatchUser(ctx, bob, usecases.PatchUserRequestDTO{Name: &name, IfMatch: usecases.VersionMatch{Any: true}})
+	if !errors.Is(err, usecases.ErrAlreadyExists) {
+		t.Fatalf("PatchUser() error = %v, want %v", err, usecases.ErrAlreadyExists)
+	}
+
+	history, err := auditLog.UserHistory(ctx, bob)
+	if err != nil {
+		t.Fatalf("UserHistory() error = %v", err)
+	}
+
+	if len(history) != 1 {
+		t.Fatalf("UserHistory() = %+v, want only the creation", history)
+	}
+}
+
+func TestUseCases_CreateUsersBatch_AllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	batch, err := u.CreateUsersBatch(ctx, usecases.CreateUsersBatchRequestDTO{
+		Items:        []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: ""}},
+		AllOrNothing: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateUsersBatch() error = %v", err)
+	}
+
+	if !batch.RolledBack {
+		t.Fatalf("CreateUsersBatch() RolledBack = false, want true")
+	}
+
+	if !errors.Is(batch.Results[0].Err, usecases.ErrRolledBack) {
+		t.Fatalf("CreateUsersBatch() results[0].Err = %v, want %v", batch.Results[0].Err, usecases.ErrRolledBack)
+	}
+
+	if !errors.Is(batch.Results[1].Err, usecases.ErrValidation) {
+		t.Fatalf("CreateUsersBatch() results[1].Err = %v, want %v", batch.Results[1].Err, usecases.ErrValidation)
+	}
+
+	page, err := u.ListUsers(ctx, usecases.ListUsersRequestDTO{})
+	if err != nil {
+		t.Fatalf("ListUsers() error = %v", err)
+	}
+
+	if len(page.Users) != 0 {
+		t.Fatalf("ListUsers() = %+v, want no users after rollback", page.Users)
+	}
+}
+
+func TestUseCases_CreateUsersBatch_Size(t *testing.T) {
+	ctx := context.Background()
+	u := usecases.New(memory.New(), audit.New(), nil)
+
+	tests := []struct {
+		name  string
+		items int
+	}{
+		{name: "empty", items: 0},
+		{name: "too large", items: usecases.MaxBatchSize + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := u.CreateUsersBatch(ctx, usecases.CreateUsersBatchRequestDTO{
+				Items: make([]usecases.CreateUserRequestDTO, tt.items),
+			})
+			if !errors.Is(err, usecases.ErrValidation) {
+				t.Fatalf("CreateUsersBatch() error = %v, want %v", err, usecases.ErrValidation)
+			}
+		})
+	}
+}
+
+func TestUseCases_UserHistory(t *testing.T) {
+	ctx := usecases.WithActor(context.Background(), "admin")
+	auditLog := audit.New()
+	u := usecases.New(memory.New(), auditLog, nil)
+
+	id, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	// изменения другого пользователя попадают в ту же цепочку, но не в историю id
+	_, err = u.CreateUsersBatch(context.Background(), usecases.CreateUsersBatchRequestDTO{
+		Items: []usecases.CreateUserRequestDTO{{Name: "Bob"}},
+	})
+	if err != nil {
+		t.Fatalf("CreateUsersBatch() error = %v", err)
+	}
+
+	_, err = u.UpdateUser(ctx, id, usecases.UpdateUserRequestDTO{Name: "Alicia", IfMatch: usecases.VersionMatch{Any: true}})
+	if err != nil {
+		t.Fatalf("UpdateUser() error = %v", err)
+	}
+
+	// запрос без изменений полей все равно меняет версию
+	_, err = u.PatchUser(ctx, id, usecases.PatchUserRequestDTO{IfMatch: usecases.VersionMatch{Any: true}})
+	if err != nil {
+		t.Fatalf("PatchUser() error = %v", err)
+	}
+
+	err = u.DeleteUser(ctx, id)
+	if err != nil {
+		t.Fatalf("DeleteUser() error = %v", err)
+	}
+
+	_, err = u.RestoreUser(context.Background(), id)
+	if err != nil {
+		t.Fatalf("RestoreUser() error = %v", err)
+	}
+
+	// восстановление неудаленного пользователя ничего не меняет и в журнал не попадает
+	_, err = u.RestoreUser(ctx, id)
+	if err != nil {
+		t.Fatalf("RestoreUser() second time error = %v", err)
+	}
+
+	history, err := u.UserHistory(ctx, id)
+	if err != nil {
+		t.Fatalf("UserHistory() error = %v", err)
+	}
+
+	if len(history) != 5 {
+		t.Fatalf("UserHistory() = %+v, want 5 entries", history)
+	}
+
+	deletedAt := history[3].Changes[0].To
+
+	type summary struct {
+		Seq     int
+		Action  usecases.AuditAction
+		Actor   string
+		Version int
+		Changes []usecases.AuditChange
+	}
+
+	want := []summary{
+		{Seq: 1, Action: usecases.AuditActionCreate, Actor: "admin", Version: 1, Changes: []usecases.AuditChange{{Field: "name", To: "Alice"}}},
+		{Seq: 3, Action: usecases.AuditActionUpdate, Actor: "admin", Version: 2, Changes: []usecases.AuditChange{{Field: "name", From: "Alice", To: "Alicia"}}},
+		{Seq: 4, Action: usecases.AuditActionUpdate, Actor: "admin", Version: 3, Changes: []usecases.AuditChange{}},
+		{Seq: 5, Action: usecases.AuditActionDelete, Actor: "admin", Version: 4, Changes: []usecases.AuditChange{{Field: "deleted_at", To: deletedAt}}},
+		{Seq: 6, Action: usecases.AuditActionRestore, Version: 5, Changes: []usecases.AuditChange{{Field: "deleted_at", From: deletedAt}}},
+	}
+
+	got := make([]summary, 0, len(history))
+
+	for _, entry := range history {
+		got = append(got, summary{Seq: entry.Seq, Action: entry.Action, Actor: entry.Actor, Version: entry.Version, Changes: entry.Changes})
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("UserHistory() = %+v, want %+v", got, want)
+	}
+
+	if deletedAt == "" {
+		t.Fatalf("delete entry has empty deleted_at")
+	}
+
+	err = auditLog.Verify()
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	_, err = u.UserHistory(ctx, id+100)
+	if !errors.Is(err, usecases.ErrNotFound) {
+		t.Fatalf("UserHistory() unknown id error = %v, want %v", err, usecases.ErrNotFound)
+	}
+}
+
+func TestUseCases_CreateUsers_Validation(t *testing.T) {
+	tooLong := strings.Repeat("a", usecases.MaxNameLength+1)
+
+	tests := []struct {
+		name       string
+		userName   string
+		wantFields []usecases.FieldError
+	}{
+		{
+			name:     "valid",
+			userName: "Anne-Marie O'Neil Jr.",
+		},
+		{
+			name:     "valid non-latin",
+			userName: "Zoë Łukasiewicz",
+		},
+		{
+			name:     "max length",
+			userName: strings.Repeat("ж", usecases.MaxNameLength),
+		},
+		{
+			name:       "empty",
+			userName:   "",
+			wantFields: []usecases.FieldError{{Field: "name", Rule: usecases.RuleNotBlank, Message: "must not be blank"}},
+		},
+		{
+			name:       "only spaces",
+			userName:   " \t ",
+			wantFields: []usecases.FieldError{{Field: "name", Rule: usecases.RuleNotBlank, Message: "must not be blank"}},
+		},
+		{
+			name:       "too long",
+			userName:   tooLong,
+			wantFields: []usecases.FieldError{{Field: "name", Rule: usecases.RuleLength, Message: "must be at most 100 characters long"}},
+		},
+		{
+			name:       "forbidden characters",
+			userName:   "<script>",
+			wantFields: []usecases.FieldError{{Field: "name", Rule: usecases.RuleCharset, Message: "may contain only letters, digits, spaces and - ' . _"}},
+		},
+		{
+			name:     "too long and forbidden characters",
+			userName: tooLong + "!",
+			wantFields: []usecases.FieldError{
+				{Field: "name", Rule: usecases.RuleLength, Message: "must be at most 100 characters long"},
+				{Field: "name", Rule: usecases.RuleCharset, Message: "may contain only letters, digits, spaces and - ' . _"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := usecases.New(memory.New(), audit.New(), nil)
+
+			_, err := u.CreateUsers(context.Background(), usecases.CreateUserRequestDTO{Name: tt.userName})
+
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("CreateUsers() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, usecases.ErrValidation) {
+				t.Fatalf("CreateUsers() error = %v, want %v", err, usecases.ErrValidation)
+			}
+
+			var validationErr *usecases.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("CreateUsers() error = %T, want *usecases.ValidationError", err)
+			}
+
+			if !reflect.DeepEqual(validationErr.Fields, tt.wantFields) {
+				t.Fatalf("Fields = %+v, want %+v", validationErr.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+// failingAuditLog - журнал, в который нельзя записать
+type failingAuditLog struct{}
+
+var errAuditUnavailable = errors.New("audit log unavailable")
+
+func (failingAuditLog) Append(ctx context.Context, entry usecases.AuditEntry) (usecases.AuditEntry, error) {
+	return usecases.AuditEntry{}, errAuditUnavailable
+}
+
+func (failingAuditLog) UserHistory(ctx context.Context, id int) ([]usecases.AuditEntry, error) {
+	return nil, nil
+}
+
+// recordedIncidents - IncidentReporter, запоминающий ошибки
+type recordedIncidents struct {
+	codes []int
+	errs  []error
+}
+
+func (r *recordedIncidents) Report(ctx context.Context, code int, err error) (string, string) {
+	r.codes = append(r.codes, code)
+	r.errs = append(r.errs, err)
+
+	return strconv.Itoa(len(r.errs)), ""
+}
+
+// Сбой журнала после сохранения не должен превращаться в ошибку: клиент повторил бы запрос и создал дубликат
+func TestUseCases_AuditFailure(t *testing.T) {
+	tests := []struct {
+		name          string
+		call          func(ctx context.Context, u *usecases.UseCases) error
+		wantIncidents int
+		wantUsers     int
+	}{
+		{
+			name: "create user",
+			call: func(ctx context.Context, u *usecases.UseCases) error {
+				_, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+
+				return err
+			},
+			wantIncidents: 1,
+			wantUsers:     1,
+		},
+		{
+			name: "create batch",
+			call: func(ctx context.Context, u *usecases.UseCases) error {
+				batch, err := u.CreateUsersBatch(ctx, usecases.CreateUsersBatchRequestDTO{
+					Items: []usecases.CreateUserRequestDTO{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}},
+				})
+				if err != nil {
+					return err
+				}
+
+				for i, result := range batch.Results {
+					if result.Err != nil || result.ID != i+1 {
+						t.Fatalf("CreateUsersBatch() results = %+v, want ids 1, 2 and 3", batch.Results)
+					}
+				}
+
+				return nil
+			},
+			wantIncidents: 3,
+			wantUsers:     3,
+		},
+		{
+			name: "update user",
+			call: func(ctx context.Context, u *usecases.UseCases) error {
+				id, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+				if err != nil {
+					return err
+				}
+
+				user, err := u.UpdateUser(ctx, id, usecases.UpdateUserRequestDTO{Name: "Alicia", IfMatch: usecases.VersionMatch{Any: true}})
+				if err != nil {
+					return err
+				}
+
+				if user.Name != "Alicia" || user.Version != 2 {
+					t.Fatalf("UpdateUser() = %+v, want name Alicia and version 2", user)
+				}
+
+				return nil
+			},
+			wantIncidents: 2,
+			wantUsers:     1,
+		},
+		{
+			name: "delete user",
+			call: func(ctx context.Context, u *usecases.UseCases) error {
+				id, err := u.CreateUsers(ctx, usecases.CreateUserRequestDTO{Name: "Alice"})
+				if err != nil {
+					return err
+				}
+
+				return u.DeleteUser(ctx, id)
+			},
+			wantIncidents: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			incidents := &recordedIncidents{}
+			u := usecases.New(memory.New(), failingAuditLog{}, incidents)
+
+			err := tt.call(ctx, u)
+			if err != nil {
+				t.Fatalf("error = %v, want nil: the change is saved", err)
+			}
+
+			if len(incidents.errs) != tt.wantIncidents {
+				t.Fatalf("incidents = %v, want %d", incidents.errs, tt.wantIncidents)
+			}
+
+			for i, err := range incidents.errs {
+				if incidents.codes[i] != 0 || !errors.Is(err, usecases.ErrAuditFailed) || !errors.Is(err, errAuditUnavailable) {
+					t.Fatalf("incident %d = code %d, %v; want code 0 wrapping %v and %v", i, incidents.codes[i], err, usecases.ErrAuditFailed, errAuditUnavailable)
+				}
+			}
+
+			page, err := u.ListUsers(ctx, usecases.ListUsersRequestDTO{Limit: 10})
+			if err != nil {
+				t.Fatalf("ListUsers() error = %v", err)
+			}
+
+			if len(page.Users) != tt.wantUsers {
+				t.Fatalf("ListUsers() = %+v, want %d users", page.Users, tt.wantUsers)
+			}
+		})
+	}
+}
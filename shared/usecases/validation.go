@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Правила валидации, нарушение которых описывается в FieldError.Rule
+const (
+	// RuleNotBlank - значение не должно быть пустым или состоять из одних пробелов
+	RuleNotBlank = "not_blank"
+	// RuleLength - длина строки или размер списка вне допустимых границ
+	RuleLength = "length"
+	// RuleCharset - строка содержит недопустимые символы
+	RuleCharset = "charset"
+	// RuleRange - число вне допустимого диапазона
+	RuleRange = "range"
+	// RuleFormat - значение не удалось разобрать
+	RuleFormat = "format"
+	// RuleRequired - обязательное поле отсутствует
+	RuleRequired = "required"
+	// RuleType - значение другого типа, чем в спецификации
+	RuleType = "type"
+	// RuleUnknown - поле не описано в спецификации
+	RuleUnknown = "unknown"
+)
+
+// MaxNameLength - максимальная длина имени в символах (рунах)
+const MaxNameLength = 100
+
+// FieldError - нарушение одного правила валидации одним полем запроса.
+type FieldError struct {
+	Field   string
+	Rule    string
+	Message string
+}
+
+// ValidationError - ошибка валидации с перечнем нарушений по полям. errors.Is(err, ErrValidation) для нее истинно.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	violations := make([]string, 0, len(e.Fields))
+
+	for _, f := range e.Fields {
+		violations = append(violations, f.Field+" "+f.Message)
+	}
+
+	return ErrValidation.Error() + ": " + strings.Join(violations, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+func newValidationError(field, rule, message string) *ValidationError {
+	return &ValidationError{
+		Fields: []FieldError{{Field: field, Rule: rule, Message: message}},
+	}
+}
+
+// validateName проверяет имя пользователя. Нарушения возвращаются все сразу, кроме пустого имени:
+// для него остальные правила не имеют смысла.
+func validateName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return newValidationError("name", RuleNotBlank, "must not be blank")
+	}
+
+	var fields []FieldError
+
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		fields = append(fields, FieldError{
+			Field:   "name",
+			Rule:    RuleLength,
+			Message: fmt.Sprintf("must be at most %d characters long", MaxNameLength),
+		})
+	}
+
+	if strings.IndexFunc(name, isForbiddenNameRune) >= 0 {
+		fields = append(fields, FieldError{
+			Field:   "name",
+			Rule:    RuleCharset,
+			Message: "may contain only letters, digits, spaces and - ' . _",
+		})
+	}
+
+	if len(fields) > 0 {
+		return &ValidationError{Fields: fields}
+	}
+
+	return nil
+}
+
+func isForbiddenNameRune(r rune) bool {
+	switch {
+	case unicode.IsLetter(r), unicode.IsDigit(r), unicode.IsMark(r):
+		return false
+	case r == ' ', r == '-', r == '\'', r == '.', r == '_':
+		return false
+	default:
+		return true
+	}
+}
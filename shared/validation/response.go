@@ -0,0 +1,172 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+
+	"shared/incident"
+)
+
+// ErrUnexpectedBody - у ответа есть тело, хотя в спецификации у него нет содержимого.
+var ErrUnexpectedBody = errors.New("response has a body, but the spec describes none")
+
+// ResponseError - ответ, не соответствующий спецификации операции.
+type ResponseError struct {
+	OperationID string
+	StatusCode  int
+	Err         error
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s: response %d does not match the spec: %v", e.OperationID, e.StatusCode, e.Err)
+}
+
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateResponse проверяет ответ операции operationID: статус должен быть описан в ней, Content-Type - быть
+// среди типов ответа с этим статусом, тело и заголовки - соответствовать схемам. Ошибка - *ResponseError.
+func (v *Validator) ValidateResponse(operationID string, statusCode int, header http.Header, body []byte) error {
+	route, ok := v.operations[operationID]
+	if !ok {
+		return fmt.Errorf("unknown operation %q", operationID)
+	}
+
+	return v.validateResponse(route, statusCode, header, body)
+}
+
+// FindOperation возвращает operationID операции спецификации, к которой относится запрос.
+func (v *Validator) FindOperation(r *http.Request) (operationID string, ok bool) {
+	route, _, err := v.router.FindRoute(r)
+	if err != nil {
+		return "", false
+	}
+
+	return route.Operation.OperationID, true
+}
+
+func (v *Validator) validateResponse(route *routers.Route, statusCode int, header http.Header, body []byte) error {
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request: &http.Request{Method: route.Method},
+			Route:   route,
+		},
+		Status:  statusCode,
+		Header:  header,
+		Options: v.responseOptions,
+	}
+	input.SetBodyBytes(body)
+
+	// kin-openapi не проверяет тело ответа, у которого в спецификации нет содержимого
+	err := openapi3filter.ValidateResponse(context.Background(), input)
+	if err == nil && len(body) > 0 && !hasContent(route, statusCode) {
+		err = ErrUnexpectedBody
+	}
+
+	if err != nil {
+		return &ResponseError{OperationID: route.Operation.OperationID, StatusCode: statusCode, Err: err}
+	}
+
+	return nil
+}
+
+func hasContent(route *routers.Route, statusCode int) bool {
+	response := route.Operation.Responses.Status(statusCode)
+	if response == nil {
+		response = route.Operation.Responses.Default()
+	}
+
+	return response != nil && response.Value != nil && len(response.Value.Content) > 0
+}
+
+// ResponseMiddleware проверяет ответы на запросы к операциям спецификации и передает нарушения в report.
+// Ответ уходит клиенту без изменений: проверяется его копия после того, как next закончил работу.
+func (v *Validator) ResponseMiddleware(report func(r *http.Request, err error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, _, err := v.router.FindRoute(r)
+			if err != nil || r.Method == http.MethodHead {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			recorder := &responseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+
+			next.ServeHTTP(recorder, r)
+
+			err = v.validateResponse(route, recorder.statusCode, w.Header(), recorder.body.Bytes())
+			if err != nil {
+				report(r, err)
+			}
+		})
+	}
+}
+
+// LogResponses - ResponseMiddleware для отладочного режима: проверяются только ответы на запросы с incident.Debug,
+// нарушения пишутся в logger.
+func (v *Validator) LogResponses(logger *slog.Logger) func(http.Handler) http.Handler {
+	check := v.ResponseMiddleware(func(r *http.Request, err error) {
+		LogResponseError(r.Context(), logger, r.Method, r.URL.Path, err)
+	})
+
+	return func(next http.Handler) http.Handler {
+		checked := check(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if incident.Debug(r.Context()) {
+				checked.ServeHTTP(w, r)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// LogResponseError пишет в logger нарушение спецификации в ответе на запрос method path.
+func LogResponseError(ctx context.Context, logger *slog.Logger, method string, path string, err error) {
+	logger.LogAttrs(ctx, slog.LevelWarn, "response does not match the spec",
+		slog.String("method", method),
+		slog.String("path", path),
+		slog.String("error", err.Error()),
+	)
+}
+
+// responseRecorder пропускает ответ к клиенту и копирует статус и тело для проверки.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.wroteHeader = true
+		r.statusCode = statusCode
+	}
+
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	r.body.Write(b)
+
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap нужен http.ResponseController.
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
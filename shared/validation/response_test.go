@@ -0,0 +1,180 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"shared/incident"
+)
+
+func TestValidator_ValidateResponse(t *testing.T) {
+	jsonHeader := func(extra ...string) http.Header {
+		header := http.Header{"Content-Type": {"application/json"}}
+
+		for i := 0; i+1 < len(extra); i += 2 {
+			header.Set(extra[i], extra[i+1])
+		}
+
+		return header
+	}
+
+	tests := []struct {
+		name        string
+		operationID string
+		statusCode  int
+		header      http.Header
+		body        string
+		wantErr     bool
+	}{
+		{
+			name:        "conforming response",
+			operationID: "GetUserById",
+			statusCode:  http.StatusOK,
+			header:      jsonHeader("ETag", `"1"`),
+			body:        `{"id":1,"name":"Alice"}`,
+		},
+		{
+			name:        "conforming problem details",
+			operationID: "GetUserById",
+			statusCode:  http.StatusNotFound,
+			header:      http.Header{"Content-Type": {"application/problem+json"}},
+			body:        `{"type":"about:blank","title":"Not Found","status":404,"code":404}`,
+		},
+		{
+			name:        "conforming response without content",
+			operationID: "DeleteUser",
+			statusCode:  http.StatusNoContent,
+			header:      http.Header{},
+		},
+		{
+			name:        "undocumented status",
+			operationID: "CreateUser",
+			statusCode:  http.StatusOK,
+			header:      jsonHeader(),
+			body:        `{"id":1}`,
+			wantErr:     true,
+		},
+		{
+			name:        "undocumented content type",
+			operationID: "GetUserById",
+			statusCode:  http.StatusOK,
+			header:      http.Header{"Content-Type": {"text/plain"}, "Etag": {`"1"`}},
+			body:        "Alice",
+			wantErr:     true,
+		},
+		{
+			name:        "body does not match the schema",
+			operationID: "GetUserById",
+			statusCode:  http.StatusOK,
+			header:      jsonHeader("ETag", `"1"`),
+			body:        `{"id":"1"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "error code out of the catalog",
+			operationID: "GetUserById",
+			statusCode:  http.StatusNotFound,
+			header:      jsonHeader(),
+			body:        `{"code":999,"error":"Not Found"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "missing required header",
+			operationID: "GetUserById",
+			statusCode:  http.StatusOK,
+			header:      jsonHeader(),
+			body:        `{"id":1,"name":"Alice"}`,
+			wantErr:     true,
+		},
+		{
+			name:        "body where the spec describes none",
+			operationID: "DeleteUser",
+			statusCode:  http.StatusNoContent,
+			header:      jsonHeader(),
+			body:        `{}`,
+			wantErr:     true,
+		},
+		{
+			name:        "unknown operation",
+			operationID: "ArchiveUser",
+			statusCode:  http.StatusOK,
+			header:      jsonHeader(),
+			wantErr:     true,
+		},
+	}
+
+	v := newValidator(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateResponse(tt.operationID, tt.statusCode, tt.header, []byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidator_ResponseMiddleware(t *testing.T) {
+	v := newValidator(t)
+
+	var reported error
+
+	handler := v.ResponseMiddleware(func(r *http.Request, err error) {
+		reported = err
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/users", nil))
+
+	if rr.Code != http.StatusOK || rr.Body.String() != `{"id":1}` {
+		t.Fatalf("response = %d %q, want it to pass unchanged", rr.Code, rr.Body.String())
+	}
+
+	var responseErr *ResponseError
+	if !errors.As(reported, &responseErr) || responseErr.OperationID != "CreateUser" || responseErr.StatusCode != http.StatusOK {
+		t.Fatalf("reported = %v, want CreateUser response 200", reported)
+	}
+
+	reported = nil
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/accounts", nil))
+
+	if reported != nil {
+		t.Fatalf("reported = %v for a path outside the spec, want nil", reported)
+	}
+}
+
+func TestValidator_LogResponses(t *testing.T) {
+	v := newValidator(t)
+
+	var logs bytes.Buffer
+
+	handler := v.LogResponses(slog.New(slog.NewJSONHandler(&logs, nil)))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/users", nil)
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if logs.Len() != 0 {
+		t.Fatalf("logs = %q outside debug mode, want none", logs.String())
+	}
+
+	handler.ServeHTTP(httptest.NewRecorder(), req.WithContext(incident.WithDebug(req.Context())))
+
+	if !strings.Contains(logs.String(), "response does not match the spec") {
+		t.Fatalf("logs = %q in debug mode, want the violation", logs.String())
+	}
+}
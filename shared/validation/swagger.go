@@ -0,0 +1,40 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+)
+
+// FromSwagger - New для спецификации Swagger 2.0 в JSON (restapi.SwaggerJSON): она переводится в OpenAPI 3.
+func FromSwagger(raw []byte) (*Validator, error) {
+	var doc openapi2.T
+
+	err := json.Unmarshal(raw, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("decode swagger spec: %w", err)
+	}
+
+	// без consumes go-swagger принимает application/json, а openapi2conv - любой тип
+	if len(doc.Consumes) == 0 {
+		doc.Consumes = []string{"application/json"}
+	}
+
+	// openapi2conv берет типы ответов только из produces операции, а у нас они заданы для всей спецификации
+	for _, pathItem := range doc.Paths {
+		for _, operation := range pathItem.Operations() {
+			if len(operation.Produces) == 0 {
+				operation.Produces = doc.Produces
+			}
+		}
+	}
+
+	spec, err := openapi2conv.ToV3(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("convert swagger spec: %w", err)
+	}
+
+	return New(spec)
+}
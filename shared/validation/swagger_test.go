@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/oasdiff/yaml"
+
+	"shared/errcatalog"
+)
+
+func TestFromSwagger(t *testing.T) {
+	raw, err := os.ReadFile("../../go-swagger/swagger.yaml")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	raw, err = yaml.YAMLToJSON(raw)
+	if err != nil {
+		t.Fatalf("YAMLToJSON() error = %v", err)
+	}
+
+	v, err := FromSwagger(raw)
+	if err != nil {
+		t.Fatalf("FromSwagger() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		wantEntry   *errcatalog.Entry
+	}{
+		{name: "valid body", contentType: "application/json", body: `{"name":"Alice"}`},
+		{name: "missing required field", contentType: "application/json", body: `{}`, wantEntry: &errcatalog.Validation},
+		{name: "unsupported content type", contentType: "text/plain", body: `name=Alice`, wantEntry: &errcatalog.UnsupportedMediaType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			err := v.Validate(req)
+
+			var validationErr *Error
+			if errors.As(err, &validationErr) != (tt.wantEntry != nil) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantEntry)
+			}
+
+			if tt.wantEntry != nil && validationErr.Entry != *tt.wantEntry {
+				t.Fatalf("Entry = %s, want %s; error: %v", validationErr.Entry.Name, tt.wantEntry.Name, err)
+			}
+		})
+	}
+
+	err = v.ValidateResponse("GetUserById", http.StatusNotFound, http.Header{"Content-Type": {"application/json"}}, []byte(`{"code":404,"error":"Not Found"}`))
+	if err != nil {
+		t.Fatalf("ValidateResponse() error = %v for the go-swagger error response", err)
+	}
+}
@@ -0,0 +1,290 @@
+package validation
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+
+	"shared/errcatalog"
+	"shared/usecases"
+)
+
+// Error - запрос, не соответствующий спецификации. Entry - запись каталога для ответа: InvalidParameter,
+// MalformedBody, UnsupportedMediaType или Validation; у Validation Err - *usecases.ValidationError с нарушениями по полям.
+type Error struct {
+	Entry errcatalog.Entry
+	Err   error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Validator проверяет запросы и ответы по спецификации: параметры, наличие и Content-Type тела, тело по схеме.
+type Validator struct {
+	router          routers.Router
+	operations      map[string]*routers.Route
+	requestOptions  *openapi3filter.Options
+	responseOptions *openapi3filter.Options
+}
+
+// New готовит проверку по spec и меняет ее: объекты в телах запросов закрываются для полей, которых нет
+// в схеме (additionalProperties: false), а servers убираются, чтобы маршруты находились на любом хосте.
+func New(spec *openapi3.T) (*Validator, error) {
+	spec.Servers = nil
+
+	operations := make(map[string]*routers.Route)
+
+	for path, pathItem := range spec.Paths.Map() {
+		for method, operation := range pathItem.Operations() {
+			operations[operation.OperationID] = &routers.Route{
+				Spec:      spec,
+				Path:      path,
+				PathItem:  pathItem,
+				Method:    method,
+				Operation: operation,
+			}
+
+			if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+				continue
+			}
+
+			for _, mediaType := range operation.RequestBody.Value.Content {
+				closeObjects(mediaType.Schema, make(map[*openapi3.Schema]bool))
+			}
+		}
+	}
+
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("build router: %w", err)
+	}
+
+	return &Validator{
+		router:     router,
+		operations: operations,
+		requestOptions: &openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+		responseOptions: &openapi3filter.Options{
+			MultiError:            true,
+			IncludeResponseStatus: true,
+		},
+	}, nil
+}
+
+// closeObjects запрещает неизвестные поля во всех объектах схемы, где additionalProperties не задан явно.
+func closeObjects(ref *openapi3.SchemaRef, visited map[*openapi3.Schema]bool) {
+	if ref == nil || ref.Value == nil || visited[ref.Value] {
+		return
+	}
+
+	schema := ref.Value
+	visited[schema] = true
+
+	if schema.Type.Is(openapi3.TypeObject) && schema.AdditionalProperties.Has == nil && schema.AdditionalProperties.Schema == nil {
+		schema.AdditionalProperties.Has = openapi3.BoolPtr(false)
+	}
+
+	for _, property := range schema.Properties {
+		closeObjects(property, visited)
+	}
+
+	closeObjects(schema.Items, visited)
+	closeObjects(schema.AdditionalProperties.Schema, visited)
+}
+
+// Validate проверяет запрос; ошибка - всегда *Error. Запрос к пути или методу, которых нет в спецификации,
+// не проверяется: на него ответит роутер. Тело после проверки остается доступным обработчику.
+func (v *Validator) Validate(r *http.Request) error {
+	route, pathParams, err := v.router.FindRoute(r)
+	if err != nil {
+		return nil
+	}
+
+	err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: pathParams,
+		Route:      route,
+		Options:    v.requestOptions,
+	})
+	if err != nil {
+		return classify(err)
+	}
+
+	return nil
+}
+
+// Middleware отвечает onError на запросы, не прошедшие Validate, не вызывая next.
+func (v *Validator) Middleware(onError func(w http.ResponseWriter, r *http.Request, err error)) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			err := v.Validate(r)
+			if err != nil {
+				onError(w, r, err)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// classify выбирает запись каталога для ошибок kin-openapi. Нарушения схемы собираются в одну ошибку валидации,
+// остальные ошибки (неразобранный параметр, нет тела, неподдерживаемый Content-Type) важнее их: с ними
+// нарушения схемы не имеют смысла.
+func classify(err error) *Error {
+	var fields []usecases.FieldError
+
+	for _, requestErr := range requestErrors(err) {
+		violations, ok := schemaViolations(requestErr)
+		if !ok {
+			return &Error{Entry: requestEntry(requestErr), Err: requestErr}
+		}
+
+		fields = append(fields, violations...)
+	}
+
+	if len(fields) == 0 {
+		return &Error{Entry: errcatalog.InvalidParameter, Err: err}
+	}
+
+	// kin-openapi обходит поля объекта в случайном порядке, а ответ должен быть одинаковым
+	slices.SortStableFunc(fields, func(a, b usecases.FieldError) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return &Error{Entry: errcatalog.Validation, Err: &usecases.ValidationError{Fields: fields}}
+}
+
+// requestErrors раскладывает ошибку ValidateRequest на ошибки отдельных параметров и тела.
+func requestErrors(err error) []*openapi3filter.RequestError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var requestErrs []*openapi3filter.RequestError
+
+		for _, inner := range e {
+			requestErrs = append(requestErrs, requestErrors(inner)...)
+		}
+
+		return requestErrs
+	case *openapi3filter.RequestError:
+		return []*openapi3filter.RequestError{e}
+	default:
+		return nil
+	}
+}
+
+// requestEntry - запись каталога для ошибки, которая не сводится к нарушениям схемы.
+func requestEntry(requestErr *openapi3filter.RequestError) errcatalog.Entry {
+	switch {
+	case requestErr.Parameter != nil:
+		return errcatalog.InvalidParameter
+	case requestErr.Err == nil && strings.HasPrefix(requestErr.Reason, "header Content-Type has unexpected value"):
+		return errcatalog.UnsupportedMediaType
+	default:
+		return errcatalog.MalformedBody
+	}
+}
+
+// schemaViolations - нарушения по полям, если ошибка состоит только из нарушений схемы значения.
+// Поле параметра называется его именем, поле тела - путем в JSON через точку.
+func schemaViolations(requestErr *openapi3filter.RequestError) ([]usecases.FieldError, bool) {
+	schemaErrs, ok := schemaErrors(requestErr.Err)
+	if !ok {
+		return nil, false
+	}
+
+	fields := make([]usecases.FieldError, 0, len(schemaErrs))
+
+	for _, schemaErr := range schemaErrs {
+		path := schemaErr.JSONPointer()
+
+		var property string
+		if _, err := fmt.Sscanf(schemaErr.Reason, "property %q is unsupported", &property); err == nil {
+			path = append(path, property)
+		}
+
+		if requestErr.Parameter != nil {
+			path = append([]string{requestErr.Parameter.Name}, path...)
+		}
+
+		field := strings.Join(path, ".")
+		if field == "" {
+			field = "body"
+		}
+
+		rule, message := describe(schemaErr)
+
+		fields = append(fields, usecases.FieldError{Field: field, Rule: rule, Message: message})
+	}
+
+	return fields, true
+}
+
+func schemaErrors(err error) ([]*openapi3.SchemaError, bool) {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var schemaErrs []*openapi3.SchemaError
+
+		for _, inner := range e {
+			innerErrs, ok := schemaErrors(inner)
+			if !ok {
+				return nil, false
+			}
+
+			schemaErrs = append(schemaErrs, innerErrs...)
+		}
+
+		return schemaErrs, len(schemaErrs) > 0
+	case *openapi3.SchemaError:
+		return []*openapi3.SchemaError{e}, true
+	default:
+		return nil, false
+	}
+}
+
+// describe переводит нарушенное ключевое слово схемы в правило usecases и сообщение в том же стиле,
+// что и у проверок usecases. Для остальных ключевых слов остается сообщение kin-openapi.
+func describe(schemaErr *openapi3.SchemaError) (rule string, message string) {
+	schema := schemaErr.Schema
+
+	switch schemaErr.SchemaField {
+	case "required":
+		return usecases.RuleRequired, "is required"
+	case "properties":
+		return usecases.RuleUnknown, "is not allowed"
+	case "type", "nullable":
+		return usecases.RuleType, "must be of type " + strings.Join(schema.Type.Slice(), " or ")
+	case "format":
+		return usecases.RuleFormat, "must be a valid " + schema.Format
+	case "minLength":
+		return usecases.RuleLength, fmt.Sprintf("must be at least %d characters long", schema.MinLength)
+	case "maxLength":
+		return usecases.RuleLength, fmt.Sprintf("must be at most %d characters long", *schema.MaxLength)
+	case "minItems":
+		return usecases.RuleLength, fmt.Sprintf("must contain at least %d items", schema.MinItems)
+	case "maxItems":
+		return usecases.RuleLength, fmt.Sprintf("must contain at most %d items", *schema.MaxItems)
+	case "minimum":
+		return usecases.RuleRange, fmt.Sprintf("must be at least %g", *schema.Min)
+	case "maximum":
+		return usecases.RuleRange, fmt.Sprintf("must be at most %g", *schema.Max)
+	case "exclusiveMinimum", "exclusiveMaximum", "multipleOf":
+		return usecases.RuleRange, schemaErr.Reason
+	default:
+		return usecases.RuleFormat, schemaErr.Reason
+	}
+}
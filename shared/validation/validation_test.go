@@ -0,0 +1,248 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"shared/errcatalog"
+	"shared/usecases"
+)
+
+func newValidator(t *testing.T) *Validator {
+	t.Helper()
+
+	spec, err := openapi3.NewLoader().LoadFromFile("../../oapi-codegen/openapi.yaml")
+	if err != nil {
+		t.Fatalf("LoadFromFile() error = %v", err)
+	}
+
+	v, err := New(spec)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return v
+}
+
+func TestValidator_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		wantEntry   *errcatalog.Entry
+		wantFields  []usecases.FieldError
+	}{
+		{
+			name:        "valid body",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "application/json",
+			body:        `{"name":"Alice"}`,
+		},
+		{
+			name:        "missing required field",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "application/json",
+			body:        `{}`,
+			wantEntry:   &errcatalog.Validation,
+			wantFields:  []usecases.FieldError{{Field: "name", Rule: usecases.RuleRequired, Message: "is required"}},
+		},
+		{
+			name:        "wrong type and unknown field",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "application/json",
+			body:        `{"name":5,"admin":true}`,
+			wantEntry:   &errcatalog.Validation,
+			wantFields: []usecases.FieldError{
+				{Field: "admin", Rule: usecases.RuleUnknown, Message: "is not allowed"},
+				{Field: "name", Rule: usecases.RuleType, Message: "must be of type string"},
+			},
+		},
+		{
+			name:        "nested fields",
+			method:      http.MethodPost,
+			target:      "/users:batch",
+			contentType: "application/json",
+			body:        `{"items":[{"name":"Alice"},{"nick":"bob"}]}`,
+			wantEntry:   &errcatalog.Validation,
+			wantFields: []usecases.FieldError{
+				{Field: "items.1.name", Rule: usecases.RuleRequired, Message: "is required"},
+				{Field: "items.1.nick", Rule: usecases.RuleUnknown, Message: "is not allowed"},
+			},
+		},
+		{
+			name:        "too few items",
+			method:      http.MethodPost,
+			target:      "/users:batch",
+			contentType: "application/json",
+			body:        `{"items":[]}`,
+			wantEntry:   &errcatalog.Validation,
+			wantFields:  []usecases.FieldError{{Field: "items", Rule: usecases.RuleLength, Message: "must contain at least 1 items"}},
+		},
+		{
+			name:        "body is not an object",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "application/json",
+			body:        `["Alice"]`,
+			wantEntry:   &errcatalog.Validation,
+			wantFields:  []usecases.FieldError{{Field: "body", Rule: usecases.RuleType, Message: "must be of type object"}},
+		},
+		{
+			name:        "missing body",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "application/json",
+			wantEntry:   &errcatalog.MalformedBody,
+		},
+		{
+			name:        "malformed body",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "application/json",
+			body:        `{"name":`,
+			wantEntry:   &errcatalog.MalformedBody,
+		},
+		{
+			name:        "unsupported content type",
+			method:      http.MethodPost,
+			target:      "/users",
+			contentType: "text/plain",
+			body:        `{"name":"Alice"}`,
+			wantEntry:   &errcatalog.UnsupportedMediaType,
+		},
+		{
+			name:      "out of range query parameter",
+			method:    http.MethodGet,
+			target:    "/users?limit=500",
+			wantEntry: &errcatalog.Validation,
+			wantFields: []usecases.FieldError{
+				{Field: "limit", Rule: usecases.RuleRange, Message: "must be at most 100"},
+			},
+		},
+		{
+			name:      "invalid format of query parameter",
+			method:    http.MethodGet,
+			target:    "/users?created_after=yesterday",
+			wantEntry: &errcatalog.Validation,
+			wantFields: []usecases.FieldError{
+				{Field: "created_after", Rule: usecases.RuleFormat, Message: "must be a valid date-time"},
+			},
+		},
+		{
+			name:      "malformed path parameter",
+			method:    http.MethodGet,
+			target:    "/users/abc",
+			wantEntry: &errcatalog.InvalidParameter,
+		},
+		{
+			name:      "malformed path parameter of custom method",
+			method:    http.MethodPost,
+			target:    "/users/abc:restore",
+			wantEntry: &errcatalog.InvalidParameter,
+		},
+		{
+			name:        "missing required header",
+			method:      http.MethodPatch,
+			target:      "/users/1",
+			contentType: "application/json",
+			body:        `{"name":"Alice"}`,
+			wantEntry:   &errcatalog.InvalidParameter,
+		},
+		{
+			name:   "unknown path is left to the router",
+			method: http.MethodGet,
+			target: "/accounts",
+		},
+		{
+			name:   "unknown method is left to the router",
+			method: http.MethodPut,
+			target: "/users",
+		},
+	}
+
+	v := newValidator(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewBufferString(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			err := v.Validate(req)
+			if tt.wantEntry == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			var validationErr *Error
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want *Error", err)
+			}
+
+			if validationErr.Entry != *tt.wantEntry {
+				t.Fatalf("Entry = %s, want %s; error: %v", validationErr.Entry.Name, tt.wantEntry.Name, err)
+			}
+
+			var fieldsErr *usecases.ValidationError
+			if errors.As(err, &fieldsErr) != (tt.wantFields != nil) {
+				t.Fatalf("error = %v, want fields %+v", err, tt.wantFields)
+			}
+
+			if tt.wantFields != nil && !reflect.DeepEqual(fieldsErr.Fields, tt.wantFields) {
+				t.Fatalf("Fields = %+v, want %+v", fieldsErr.Fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestValidator_Middleware(t *testing.T) {
+	v := newValidator(t)
+
+	var gotBody string
+
+	handler := v.Middleware(func(w http.ResponseWriter, r *http.Request, err error) {
+		w.WriteHeader(http.StatusBadRequest)
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/users", bytes.NewBufferString(`{"name":"Alice"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusOK || gotBody != `{"name":"Alice"}` {
+		t.Fatalf("status code = %d, body seen by handler = %q; want 200 and the original body", rr.Code, gotBody)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/users", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	gotBody = ""
+	rr = httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	if rr.Code != http.StatusBadRequest || gotBody != "" {
+		t.Fatalf("status code = %d, handler called = %v; want 400 without calling the handler", rr.Code, gotBody != "")
+	}
+}
@@ -0,0 +1,43 @@
+// Команда bundle собирает спецификацию OpenAPI 3, разбитую на файлы (schemas/, paths/, responses/ и т.п.),
+// в один документ без внешних $ref: компоненты из других файлов переносятся в components, path item
+// подставляются на место ссылок (см. specbundle.File).
+//
+//	go run ./cmd/bundle [-o openapi.bundled.yaml] openapi.yaml
+//
+// Результат пишется в -o или в stdout. Генераторы в Makefile получают собранный документ.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"tools/specbundle"
+)
+
+func main() {
+	output := flag.String("o", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: bundle [-o openapi.bundled.yaml] openapi.yaml")
+		os.Exit(2)
+	}
+
+	result, err := specbundle.File(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(result)
+	} else {
+		err = os.WriteFile(*output, result, 0o644)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
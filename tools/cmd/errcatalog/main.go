@@ -0,0 +1,64 @@
+// Команда errcatalog генерирует перечисление кодов ErrorResponse.code и ProblemDetails.code в спецификациях
+// из каталога ошибок server/errcatalog.
+//
+//	go run ./cmd/errcatalog [-check] spec.yaml...
+//
+// С -check спецификации не меняются: команда завершается с ошибкой, если какая-то из них устарела.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+
+	"shared/errcatalog"
+)
+
+func main() {
+	check := flag.Bool("check", false, "fail if a spec is out of date instead of updating it")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: errcatalog [-check] spec.yaml...")
+		os.Exit(2)
+	}
+
+	outdated := false
+
+	for _, path := range flag.Args() {
+		spec, err := os.ReadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		updated, err := updateSpec(spec, errcatalog.Entries)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			os.Exit(1)
+		}
+
+		if bytes.Equal(spec, updated) {
+			continue
+		}
+
+		if *check {
+			fmt.Fprintf(os.Stderr, "%s: error codes are out of date, run errcatalog\n", path)
+
+			outdated = true
+
+			continue
+		}
+
+		err = os.WriteFile(path, updated, 0o644)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	if outdated {
+		os.Exit(1)
+	}
+}
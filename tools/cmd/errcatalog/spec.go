@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"shared/errcatalog"
+)
+
+// schemas - схемы, поле code которых генерируется из каталога
+var schemas = []string{"ErrorResponse", "ProblemDetails"}
+
+var errPathNotFound = errors.New("path not found")
+
+// updateSpec заменяет описание поля code в схемах ответов с ошибкой на сгенерированное из entries.
+// Остальной текст спецификации, включая комментарии и форматирование, не меняется.
+func updateSpec(spec []byte, entries []errcatalog.Entry) ([]byte, error) {
+	var doc yaml.Node
+
+	err := yaml.Unmarshal(spec, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, errors.New("empty spec")
+	}
+
+	root := doc.Content[0]
+
+	prefix := []string{"components", "schemas"}
+	if lookup(root, "swagger") != nil {
+		prefix = []string{"definitions"}
+	}
+
+	lines := strings.SplitAfter(string(spec), "\n")
+
+	// замены идут снизу вверх, чтобы номера строк еще не замененных полей оставались верными
+	type field struct {
+		key   *yaml.Node
+		value *yaml.Node
+	}
+
+	var fields []field
+
+	for _, schema := range schemas {
+		path := append(append([]string{}, prefix...), schema, "properties", "code")
+
+		key, value := find(root, path)
+		if key == nil {
+			return nil, fmt.Errorf("%s: %w", strings.Join(path, "."), errPathNotFound)
+		}
+
+		if value.Kind != yaml.MappingNode || value.Style == yaml.FlowStyle {
+			return nil, fmt.Errorf("%s: expected block mapping", strings.Join(path, "."))
+		}
+
+		fields = append(fields, field{key: key, value: value})
+	}
+
+	for i := len(fields) - 1; i >= 0; i-- {
+		lines = replaceBlock(lines, fields[i].key, fields[i].value, entries)
+	}
+
+	return []byte(strings.Join(lines, "")), nil
+}
+
+// replaceBlock заменяет строки значения key (все строки с отступом больше, чем у key) на сгенерированные.
+func replaceBlock(lines []string, key *yaml.Node, value *yaml.Node, entries []errcatalog.Entry) []string {
+	keyIndent := key.Column - 1
+	indent := strings.Repeat(" ", value.Column-1)
+	step := strings.Repeat(" ", value.Column-key.Column)
+
+	start := key.Line
+	end := start
+
+	for i := start; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], "\r\n")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if len(line)-len(strings.TrimLeft(line, " ")) <= keyIndent {
+			break
+		}
+
+		end = i + 1
+	}
+
+	block := generate(entries, indent, step)
+
+	result := make([]string, 0, len(lines)-(end-start)+len(block))
+	result = append(result, lines[:start]...)
+	result = append(result, block...)
+	result = append(result, lines[end:]...)
+
+	return result
+}
+
+// generate - описание поля code: тип, перечисление кодов и их имена с описаниями.
+func generate(entries []errcatalog.Entry, indent string, step string) []string {
+	var buf bytes.Buffer
+
+	buf.WriteString(indent + "type: integer\n")
+	buf.WriteString(indent + "description: |\n")
+	buf.WriteString(indent + step + "Error code. Generated from the error catalog (errcatalog), do not edit by hand.\n")
+
+	for _, entry := range entries {
+		fmt.Fprintf(&buf, "%s%s* `%d` %s (HTTP %d) - %s\n", indent, step, entry.Code, entry.Name, entry.Status, entry.Description)
+	}
+
+	buf.WriteString(indent + "enum:\n")
+
+	for _, entry := range entries {
+		buf.WriteString(indent + step + "- " + strconv.Itoa(entry.Code) + "\n")
+	}
+
+	buf.WriteString(indent + "x-enum-varnames:\n")
+
+	for _, entry := range entries {
+		buf.WriteString(indent + step + "- " + entry.Name + "\n")
+	}
+
+	return strings.SplitAfter(buf.String(), "\n")
+}
+
+func find(node *yaml.Node, path []string) (*yaml.Node, *yaml.Node) {
+	var key *yaml.Node
+
+	for _, name := range path {
+		if node.Kind != yaml.MappingNode {
+			return nil, nil
+		}
+
+		key, node = lookupPair(node, name)
+		if key == nil {
+			return nil, nil
+		}
+	}
+
+	return key, node
+}
+
+func lookup(node *yaml.Node, name string) *yaml.Node {
+	_, value := lookupPair(node, name)
+
+	return value
+}
+
+func lookupPair(node *yaml.Node, name string) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == name {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"shared/errcatalog"
+)
+
+var testEntries = []errcatalog.Entry{errcatalog.NotFound, errcatalog.Internal}
+
+const openAPISpec = `openapi: 3.0.3
+components:
+    schemas:
+        ErrorResponse:
+            type: object
+            properties:
+                code:
+                    type: integer
+
+                error:
+                    type: string
+        ProblemDetails:
+            type: object
+            properties:
+                code:
+                    type: integer
+                    description: old
+`
+
+const openAPIWant = `openapi: 3.0.3
+components:
+    schemas:
+        ErrorResponse:
+            type: object
+            properties:
+                code:
+                    type: integer
+                    description: |
+                        Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+                        * ` + "`404`" + ` NotFound (HTTP 404) - user does not exist
+                        * ` + "`-1`" + ` Internal (HTTP 500) - unexpected error
+                    enum:
+                        - 404
+                        - -1
+                    x-enum-varnames:
+                        - NotFound
+                        - Internal
+
+                error:
+                    type: string
+        ProblemDetails:
+            type: object
+            properties:
+                code:
+                    type: integer
+                    description: |
+                        Error code. Generated from the error catalog (errcatalog), do not edit by hand.
+                        * ` + "`404`" + ` NotFound (HTTP 404) - user does not exist
+                        * ` + "`-1`" + ` Internal (HTTP 500) - unexpected error
+                    enum:
+                        - 404
+                        - -1
+                    x-enum-varnames:
+                        - NotFound
+                        - Internal
+`
+
+func TestUpdateSpec(t *testing.T) {
+	got, err := updateSpec([]byte(openAPISpec), testEntries)
+	if err != nil {
+		t.Fatalf("updateSpec() error = %v", err)
+	}
+
+	if string(got) != openAPIWant {
+		t.Fatalf("updateSpec() =\n%s\nwant\n%s", got, openAPIWant)
+	}
+
+	again, err := updateSpec(got, testEntries)
+	if err != nil {
+		t.Fatalf("updateSpec() second run error = %v", err)
+	}
+
+	if string(again) != string(got) {
+		t.Fatalf("updateSpec() is not idempotent:\n%s", again)
+	}
+}
+
+func TestUpdateSpec_Swagger(t *testing.T) {
+	spec := `swagger: "2.0"
+definitions:
+  ErrorResponse:
+    properties:
+      code:
+        type: integer
+  ProblemDetails:
+    properties:
+      code:
+        type: integer
+`
+
+	got, err := updateSpec([]byte(spec), testEntries)
+	if err != nil {
+		t.Fatalf("updateSpec() error = %v", err)
+	}
+
+	want := "      code:\n        type: integer\n        description: |\n          Error code."
+	if !strings.Contains(string(got), want) {
+		t.Fatalf("updateSpec() =\n%s\nwant indentation of the file", got)
+	}
+
+	if strings.Count(string(got), "x-enum-varnames:") != 2 {
+		t.Fatalf("updateSpec() =\n%s\nwant both schemas updated", got)
+	}
+}
+
+func TestUpdateSpec_MissingSchema(t *testing.T) {
+	_, err := updateSpec([]byte("openapi: 3.0.3\ncomponents:\n    schemas: {}\n"), testEntries)
+	if err == nil {
+		t.Fatal("updateSpec() error = nil, want error for missing ErrorResponse")
+	}
+}
+
+// Спецификации в репозитории должны совпадать с каталогом; swagger.yaml генерируется из openapi.yaml (см. swagger2)
+func TestSpecsUpToDate(t *testing.T) {
+	for _, path := range []string{"../../../oapi-codegen/openapi.yaml", "../../../ogen-go/openapi.yaml"} {
+		spec, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+
+		updated, err := updateSpec(spec, errcatalog.Entries)
+		if err != nil {
+			t.Fatalf("%s: updateSpec() error = %v", path, err)
+		}
+
+		if string(updated) != string(spec) {
+			t.Fatalf("%s is out of date, run make errcatalog", path)
+		}
+	}
+}
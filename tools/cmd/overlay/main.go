@@ -0,0 +1,51 @@
+// Команда overlay применяет к общей спецификации файлы OpenAPI Overlay 1.0 с расширениями одного генератора
+// (x-nullable для go-swagger, x-go-type для oapi-codegen, x-ogen-* для ogen), чтобы в общей спецификации их
+// не было. Спецификация, разбитая на файлы, сначала собирается в один документ (см. specbundle.File).
+//
+//	go run ./cmd/overlay [-o openapi.bundled.yaml] openapi.yaml [overlay.yaml...]
+//
+// Overlay применяются по порядку (см. specoverlay.Apply), результат пишется в -o или в stdout. Без overlay
+// команда только собирает спецификацию.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"tools/specbundle"
+	"tools/specoverlay"
+)
+
+func main() {
+	output := flag.String("o", "", "output file, stdout if empty")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: overlay [-o openapi.bundled.yaml] openapi.yaml [overlay.yaml...]")
+		os.Exit(2)
+	}
+
+	spec, err := specbundle.File(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	result, err := specoverlay.Apply(spec, flag.Args()[1:]...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(result)
+	} else {
+		err = os.WriteFile(*output, result, 0o644)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"tools/specbundle"
+	"tools/specoverlay"
+)
+
+// Сервер ogen встраивает собранную спецификацию, она должна совпадать с ogen-go/openapi.yaml
+func TestOgenSpecUpToDate(t *testing.T) {
+	spec, err := specbundle.File("../../../ogen-go/openapi.yaml")
+	if err != nil {
+		t.Fatalf("File() error = %v", err)
+	}
+
+	want, err := specoverlay.Apply(spec)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	got, err := os.ReadFile("../../../ogen-go/server/openapi.bundled.yaml")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	if string(got) != string(want) {
+		t.Fatal("ogen-go/server/openapi.bundled.yaml is out of date, run make generate in ogen-go/server")
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// allowance - известное расхождение из файла -allow
+type allowance struct {
+	pattern *regexp.Regexp
+	used    bool
+}
+
+// loadAllowed читает файл известных расхождений: регулярное выражение на строку, пустые строки
+// и комментарии # пропускаются.
+func loadAllowed(path string) ([]*allowance, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseAllowed(data, path)
+}
+
+func parseAllowed(data []byte, path string) ([]*allowance, error) {
+	var result []*allowance
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		pattern, err := regexp.Compile(text)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+
+		result = append(result, &allowance{pattern: pattern})
+	}
+
+	return result, scanner.Err()
+}
+
+// allowed сообщает, известно ли расхождение diff, и отмечает совпавшие выражения.
+func allowed(allowances []*allowance, diff string) bool {
+	ok := false
+
+	for _, allowance := range allowances {
+		if allowance.pattern.MatchString(diff) {
+			allowance.used = true
+			ok = true
+		}
+	}
+
+	return ok
+}
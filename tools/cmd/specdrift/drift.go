@@ -0,0 +1,407 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// primaryMediaType - тип, схема которого сравнивается со схемой ответа Swagger 2.0
+const primaryMediaType = "application/json"
+
+// comparison накапливает расхождения двух спецификаций. Описания, примеры, summary и расширения x-* не
+// сравниваются: они не меняют контракт API.
+type comparison struct {
+	// swagger - одна из спецификаций в Swagger 2.0. В ней у заголовков ответа нет required, типы ответов
+	// (produces) задаются на всю операцию, а схема у ответа одна на все типы, поэтому у ответов сравниваются
+	// только наличие и схема primaryMediaType.
+	swagger bool
+	// nameA, nameB - пути спецификаций для сообщений
+	nameA, nameB string
+	diffs        []string
+}
+
+// compare возвращает расхождения спецификации b с a; каждое - строка вида "где: что".
+func compare(a *spec, b *spec) []string {
+	c := &comparison{swagger: a.swagger || b.swagger, nameA: a.path, nameB: b.path}
+
+	c.compareInfo(a.doc.Info, b.doc.Info)
+	c.comparePaths(a.doc.Paths, b.doc.Paths)
+	c.compareSchemas(a.doc.Components.Schemas, b.doc.Components.Schemas)
+
+	return c.diffs
+}
+
+func (c *comparison) add(where string, format string, args ...any) {
+	c.diffs = append(c.diffs, where+": "+fmt.Sprintf(format, args...))
+}
+
+// only записывает, что where есть только в одной спецификации: в первой, если inA.
+func (c *comparison) only(where string, inA bool) {
+	name := c.nameB
+	if inA {
+		name = c.nameA
+	}
+
+	c.add(where, "only in %s", name)
+}
+
+// value сравнивает значения и записывает расхождение name: a != b.
+func (c *comparison) value(where string, name string, a any, b any) {
+	if !reflect.DeepEqual(a, b) {
+		c.add(where, "%s %s != %s", name, show(a), show(b))
+	}
+}
+
+func (c *comparison) compareInfo(a *openapi3.Info, b *openapi3.Info) {
+	c.value("info", "title", a.Title, b.Title)
+	c.value("info", "version", a.Version, b.Version)
+
+	var licenseA, licenseB string
+
+	if a.License != nil {
+		licenseA = a.License.Name
+	}
+
+	if b.License != nil {
+		licenseB = b.License.Name
+	}
+
+	c.value("info", "license", licenseA, licenseB)
+}
+
+func (c *comparison) comparePaths(a *openapi3.Paths, b *openapi3.Paths) {
+	pathsA, pathsB := a.Map(), b.Map()
+
+	for _, path := range union(pathsA, pathsB) {
+		itemA, itemB := pathsA[path], pathsB[path]
+
+		var operationsA, operationsB map[string]*openapi3.Operation
+
+		if itemA != nil {
+			operationsA = itemA.Operations()
+		}
+
+		if itemB != nil {
+			operationsB = itemB.Operations()
+		}
+
+		for _, method := range union(operationsA, operationsB) {
+			where := method + " " + path
+
+			operationA, operationB := operationsA[method], operationsB[method]
+
+			switch {
+			case operationA == nil:
+				c.only(where, false)
+			case operationB == nil:
+				c.only(where, true)
+			default:
+				c.compareOperation(where, itemA, operationA, itemB, operationB)
+			}
+		}
+	}
+}
+
+func (c *comparison) compareOperation(where string, itemA *openapi3.PathItem, a *openapi3.Operation, itemB *openapi3.PathItem, b *openapi3.Operation) {
+	c.value(where, "operationId", a.OperationID, b.OperationID)
+
+	parametersA, parametersB := parameters(itemA, a), parameters(itemB, b)
+
+	for _, key := range union(parametersA, parametersB) {
+		parameterWhere := where + ": parameter " + key
+
+		parameterA, parameterB := parametersA[key], parametersB[key]
+
+		switch {
+		case parameterA == nil:
+			c.only(parameterWhere, false)
+		case parameterB == nil:
+			c.only(parameterWhere, true)
+		default:
+			c.value(parameterWhere, "required", parameterA.Required, parameterB.Required)
+			c.value(parameterWhere, "style", parameterStyle(parameterA), parameterStyle(parameterB))
+			c.compareSchema(parameterWhere, parameterA.Schema, parameterB.Schema)
+		}
+	}
+
+	c.compareRequestBody(where+": request body", a.RequestBody, b.RequestBody)
+	c.compareResponses(where, a.Responses, b.Responses)
+}
+
+// parameters - параметры операции с параметрами пути, ключ - "in name".
+func parameters(item *openapi3.PathItem, operation *openapi3.Operation) map[string]*openapi3.Parameter {
+	result := make(map[string]*openapi3.Parameter)
+
+	for _, list := range []openapi3.Parameters{item.Parameters, operation.Parameters} {
+		for _, ref := range list {
+			if ref.Value != nil {
+				result[ref.Value.In+" "+ref.Value.Name] = ref.Value
+			}
+		}
+	}
+
+	return result
+}
+
+// parameterStyle - style и explode с значениями по умолчанию: Swagger 2.0 задает их через collectionFormat.
+func parameterStyle(parameter *openapi3.Parameter) string {
+	style := parameter.Style
+	if style == "" {
+		switch parameter.In {
+		case openapi3.ParameterInQuery, openapi3.ParameterInCookie:
+			style = openapi3.SerializationForm
+		default:
+			style = openapi3.SerializationSimple
+		}
+	}
+
+	explode := style == openapi3.SerializationForm
+	if parameter.Explode != nil {
+		explode = *parameter.Explode
+	}
+
+	return fmt.Sprintf("%s explode=%v", style, explode)
+}
+
+func (c *comparison) compareRequestBody(where string, a *openapi3.RequestBodyRef, b *openapi3.RequestBodyRef) {
+	var bodyA, bodyB *openapi3.RequestBody
+
+	if a != nil {
+		bodyA = a.Value
+	}
+
+	if b != nil {
+		bodyB = b.Value
+	}
+
+	switch {
+	case bodyA == nil && bodyB == nil:
+		return
+	case bodyA == nil:
+		c.only(where, false)
+
+		return
+	case bodyB == nil:
+		c.only(where, true)
+
+		return
+	}
+
+	c.value(where, "required", bodyA.Required, bodyB.Required)
+	c.compareContent(where, bodyA.Content, bodyB.Content, false)
+}
+
+func (c *comparison) compareResponses(where string, a *openapi3.Responses, b *openapi3.Responses) {
+	responsesA, responsesB := a.Map(), b.Map()
+
+	for _, status := range union(responsesA, responsesB) {
+		responseWhere := where + ": response " + status
+
+		refA, refB := responsesA[status], responsesB[status]
+
+		switch {
+		case refA == nil:
+			c.only(responseWhere, false)
+		case refB == nil:
+			c.only(responseWhere, true)
+		default:
+			c.compareResponse(responseWhere, refA.Value, refB.Value)
+		}
+	}
+}
+
+func (c *comparison) compareResponse(where string, a *openapi3.Response, b *openapi3.Response) {
+	for _, name := range union(a.Headers, b.Headers) {
+		headerWhere := where + ": header " + name
+
+		headerA, headerB := a.Headers[name], b.Headers[name]
+
+		switch {
+		case headerA == nil:
+			c.only(headerWhere, false)
+		case headerB == nil:
+			c.only(headerWhere, true)
+		default:
+			if !c.swagger {
+				c.value(headerWhere, "required", headerA.Value.Required, headerB.Value.Required)
+			}
+
+			c.compareSchema(headerWhere, headerA.Value.Schema, headerB.Value.Schema)
+		}
+	}
+
+	c.compareContent(where, a.Content, b.Content, c.swagger)
+}
+
+// compareContent сравнивает типы тела и их схемы; с primaryOnly - только primaryMediaType.
+func (c *comparison) compareContent(where string, a openapi3.Content, b openapi3.Content, primaryOnly bool) {
+	if primaryOnly {
+		a, b = primary(a), primary(b)
+	}
+
+	c.value(where, "media types", sortedKeys(a), sortedKeys(b))
+
+	for _, mediaType := range sortedKeys(a) {
+		mediaB := b[mediaType]
+		if mediaB == nil {
+			continue
+		}
+
+		c.compareSchema(where+": "+mediaType, a[mediaType].Schema, mediaB.Schema)
+	}
+}
+
+func primary(content openapi3.Content) openapi3.Content {
+	result := openapi3.Content{}
+
+	if media, ok := content[primaryMediaType]; ok {
+		result[primaryMediaType] = media
+	}
+
+	return result
+}
+
+func (c *comparison) compareSchemas(a openapi3.Schemas, b openapi3.Schemas) {
+	for _, name := range union(a, b) {
+		where := "schema " + name
+
+		switch {
+		case a[name] == nil:
+			c.only(where, false)
+		case b[name] == nil:
+			c.only(where, true)
+		default:
+			c.compareSchema(where, a[name], b[name])
+		}
+	}
+}
+
+// compareSchema сравнивает схемы по структуре. Ссылки на компоненты сравниваются по имени: сами компоненты
+// сравнивает compareSchemas.
+func (c *comparison) compareSchema(where string, a *openapi3.SchemaRef, b *openapi3.SchemaRef) {
+	if a == nil || b == nil {
+		if (a == nil) != (b == nil) {
+			c.only(where+": schema", a != nil)
+		}
+
+		return
+	}
+
+	if a.Ref != "" || b.Ref != "" {
+		c.value(where, "$ref", refName(a.Ref), refName(b.Ref))
+
+		return
+	}
+
+	schemaA, schemaB := a.Value, b.Value
+
+	c.value(where, "type", schemaA.Type.Slice(), schemaB.Type.Slice())
+	c.value(where, "format", schemaA.Format, schemaB.Format)
+	c.value(where, "nullable", schemaA.Nullable, schemaB.Nullable)
+	c.value(where, "enum", schemaA.Enum, schemaB.Enum)
+	c.value(where, "default", schemaA.Default, schemaB.Default)
+	c.value(where, "required", sorted(schemaA.Required), sorted(schemaB.Required))
+	c.value(where, "minimum", schemaA.Min, schemaB.Min)
+	c.value(where, "maximum", schemaA.Max, schemaB.Max)
+	c.value(where, "exclusiveMinimum", schemaA.ExclusiveMin, schemaB.ExclusiveMin)
+	c.value(where, "exclusiveMaximum", schemaA.ExclusiveMax, schemaB.ExclusiveMax)
+	c.value(where, "minLength", schemaA.MinLength, schemaB.MinLength)
+	c.value(where, "maxLength", schemaA.MaxLength, schemaB.MaxLength)
+	c.value(where, "pattern", schemaA.Pattern, schemaB.Pattern)
+	c.value(where, "minItems", schemaA.MinItems, schemaB.MinItems)
+	c.value(where, "maxItems", schemaA.MaxItems, schemaB.MaxItems)
+	c.value(where, "uniqueItems", schemaA.UniqueItems, schemaB.UniqueItems)
+	c.value(where, "readOnly", schemaA.ReadOnly, schemaB.ReadOnly)
+
+	for _, name := range union(schemaA.Properties, schemaB.Properties) {
+		propertyWhere := where + ": property " + name
+
+		switch {
+		case schemaA.Properties[name] == nil:
+			c.only(propertyWhere, false)
+		case schemaB.Properties[name] == nil:
+			c.only(propertyWhere, true)
+		default:
+			c.compareSchema(propertyWhere, schemaA.Properties[name], schemaB.Properties[name])
+		}
+	}
+
+	c.compareSchema(where+": items", schemaA.Items, schemaB.Items)
+	c.compareSchema(where+": additionalProperties", schemaA.AdditionalProperties.Schema, schemaB.AdditionalProperties.Schema)
+	c.compareSchemaList(where+": allOf", schemaA.AllOf, schemaB.AllOf)
+	c.compareSchemaList(where+": oneOf", schemaA.OneOf, schemaB.OneOf)
+	c.compareSchemaList(where+": anyOf", schemaA.AnyOf, schemaB.AnyOf)
+}
+
+func (c *comparison) compareSchemaList(where string, a openapi3.SchemaRefs, b openapi3.SchemaRefs) {
+	if len(a) != len(b) {
+		c.add(where, "%d schemas != %d", len(a), len(b))
+
+		return
+	}
+
+	for i := range a {
+		c.compareSchema(fmt.Sprintf("%s[%d]", where, i), a[i], b[i])
+	}
+}
+
+// union - отсортированные ключи обоих словарей
+func union[V any](a map[string]V, b map[string]V) []string {
+	keys := sortedKeys(a)
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sorted(values []string) []string {
+	result := slices.Clone(values)
+	if result == nil {
+		result = []string{}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// refName - имя компонента из ссылки: у Swagger 2.0 и OpenAPI 3 разные префиксы
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// show - значение для сообщения: указатели разыменовываются, отсутствие значения - "none"
+func show(value any) string {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() || (v.Kind() == reflect.Pointer && v.IsNil()) {
+		return "none"
+	}
+
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	return fmt.Sprintf("%v", v.Interface())
+}
@@ -0,0 +1,348 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const openAPISpec = `openapi: 3.0.3
+info:
+    title: Users API
+    version: 1.0.0
+paths:
+    /users:
+        get:
+            operationId: ListUsers
+            parameters:
+                -   name: sort
+                    in: query
+                    style: form
+                    explode: false
+                    schema:
+                        type: array
+                        items:
+                            type: string
+            responses:
+                "200":
+                    description: OK
+                    headers:
+                        X-Total:
+                            required: true
+                            schema:
+                                type: integer
+                    content:
+                        application/json:
+                            schema:
+                                type: array
+                                items:
+                                    $ref: "#/components/schemas/User"
+        post:
+            operationId: CreateUser
+            requestBody:
+                required: true
+                content:
+                    application/json:
+                        schema:
+                            $ref: "#/components/schemas/User"
+            responses:
+                "201":
+                    description: Created
+                    content:
+                        application/json:
+                            schema:
+                                $ref: "#/components/schemas/User"
+                "400":
+                    description: Bad Request
+                    content:
+                        application/json:
+                            schema:
+                                $ref: "#/components/schemas/Error"
+                        application/problem+json:
+                            schema:
+                                $ref: "#/components/schemas/Problem"
+components:
+    schemas:
+        User:
+            type: object
+            required:
+                - name
+            properties:
+                id:
+                    type: integer
+                name:
+                    type: string
+                    minLength: 1
+        Error:
+            type: object
+            properties:
+                code:
+                    type: integer
+        Problem:
+            type: object
+            properties:
+                status:
+                    type: integer
+`
+
+// swaggerSpec - openAPISpec в Swagger 2.0
+const swaggerSpec = `swagger: "2.0"
+info:
+    title: Users API
+    version: 1.0.0
+produces:
+    - application/json
+    - application/problem+json
+paths:
+    /users:
+        get:
+            operationId: ListUsers
+            parameters:
+                - name: sort
+                  in: query
+                  type: array
+                  items:
+                      type: string
+            responses:
+                "200":
+                    description: OK
+                    headers:
+                        X-Total:
+                            type: integer
+                    schema:
+                        type: array
+                        items:
+                            $ref: "#/definitions/User"
+        post:
+            operationId: CreateUser
+            parameters:
+                - name: body
+                  in: body
+                  required: true
+                  schema:
+                      $ref: "#/definitions/User"
+            responses:
+                "201":
+                    description: Created
+                    schema:
+                        $ref: "#/definitions/User"
+                "400":
+                    description: Bad Request
+                    schema:
+                        $ref: "#/definitions/Error"
+definitions:
+    User:
+        type: object
+        required:
+            - name
+        properties:
+            id:
+                type: integer
+            name:
+                type: string
+                minLength: 1
+    Error:
+        type: object
+        properties:
+            code:
+                type: integer
+    Problem:
+        type: object
+        properties:
+            status:
+                type: integer
+`
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		// a, b - спецификации; b - a с заменой replace[0] на replace[1], если replace задан
+		a       string
+		b       string
+		replace [2]string
+		want    []string
+	}{
+		{name: "same spec", a: openAPISpec, b: openAPISpec},
+		{
+			name:    "operationId",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"operationId: CreateUser", "operationId: AddUser"},
+			want:    []string{"POST /users: operationId CreateUser != AddUser"},
+		},
+		{
+			name:    "parameter style",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"explode: false", "explode: true"},
+			want:    []string{"GET /users: parameter query sort: style form explode=false != form explode=true"},
+		},
+		{
+			name:    "missing operation",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"        post:", "        put:"},
+			want: []string{
+				"POST /users: only in a.yaml",
+				"PUT /users: only in b.yaml",
+			},
+		},
+		{
+			name:    "missing response",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{`"201":`, `"200":`},
+			want: []string{
+				"POST /users: response 200: only in b.yaml",
+				"POST /users: response 201: only in a.yaml",
+			},
+		},
+		{
+			name:    "response header required",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"required: true\n                            schema:", "required: false\n                            schema:"},
+			want:    []string{"GET /users: response 200: header X-Total: required true != false"},
+		},
+		{
+			name:    "request body required",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"requestBody:\n                required: true", "requestBody:\n                required: false"},
+			want:    []string{"POST /users: request body: required true != false"},
+		},
+		{
+			name:    "required fields",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"                - name\n", "                - id\n"},
+			want:    []string{"schema User: required [name] != [id]"},
+		},
+		{
+			name:    "property type",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{"name:\n                    type: string", "name:\n                    type: integer"},
+			want:    []string{"schema User: property name: type [string] != [integer]"},
+		},
+		{
+			name:    "schema reference",
+			a:       openAPISpec,
+			b:       openAPISpec,
+			replace: [2]string{`$ref: "#/components/schemas/Problem"`, `$ref: "#/components/schemas/Error"`},
+			want:    []string{"POST /users: response 400: application/problem+json: $ref Problem != Error"},
+		},
+		{name: "swagger equivalent", a: openAPISpec, b: swaggerSpec},
+		{
+			name:    "swagger collection format",
+			a:       openAPISpec,
+			b:       swaggerSpec,
+			replace: [2]string{"                  type: array\n", "                  type: array\n                  collectionFormat: multi\n"},
+			want:    []string{"GET /users: parameter query sort: style form explode=false != form explode=true"},
+		},
+		{
+			name:    "swagger missing property",
+			a:       openAPISpec,
+			b:       swaggerSpec,
+			replace: [2]string{"            id:\n                type: integer\n", ""},
+			want:    []string{"schema User: property id: only in a.yaml"},
+		},
+		{
+			name: "swagger license",
+			a:    strings.Replace(openAPISpec, "version: 1.0.0", "version: 1.0.0\n    license:\n        name: Internal", 1),
+			b:    swaggerSpec,
+			want: []string{"info: license Internal != "},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := load([]byte(tt.a))
+			if err != nil {
+				t.Fatalf("load() error = %v", err)
+			}
+
+			b := tt.b
+			if tt.replace[0] != "" {
+				if !strings.Contains(b, tt.replace[0]) {
+					t.Fatalf("spec does not contain %q", tt.replace[0])
+				}
+
+				b = strings.Replace(b, tt.replace[0], tt.replace[1], 1)
+			}
+
+			specB, err := load([]byte(b))
+			if err != nil {
+				t.Fatalf("load() error = %v", err)
+			}
+
+			a.path, specB.path = "a.yaml", "b.yaml"
+
+			got := compare(a, specB)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("compare() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	allowances, err := parseAllowed([]byte("# comment\n\n^schema User: \n: response 406: only in .*swagger\\.yaml$\n"), "allowed.txt")
+	if err != nil {
+		t.Fatalf("parseAllowed() error = %v", err)
+	}
+
+	tests := []struct {
+		diff string
+		want bool
+	}{
+		{diff: "schema User: property id: only in a.yaml", want: true},
+		{diff: "GET /users: response 406: only in ../swagger.yaml", want: true},
+		{diff: "GET /users: response 406: only in ../openapi.yaml"},
+		{diff: "GET /users: operationId ListUsers != GetUsers"},
+	}
+
+	for _, tt := range tests {
+		if got := allowed(allowances, tt.diff); got != tt.want {
+			t.Errorf("allowed(%q) = %v, want %v", tt.diff, got, tt.want)
+		}
+	}
+
+	_, err = parseAllowed([]byte("("), "allowed.txt")
+	if err == nil {
+		t.Fatal("parseAllowed() error = nil, want error for invalid regular expression")
+	}
+}
+
+// Спецификации в репозитории должны совпадать, кроме известных расхождений
+func TestSpecsInSync(t *testing.T) {
+	allowances, err := loadAllowed("allowed.txt")
+	if err != nil {
+		t.Fatalf("loadAllowed() error = %v", err)
+	}
+
+	var specs []*spec
+
+	for _, path := range []string{"../../../oapi-codegen/openapi.yaml", "../../../ogen-go/openapi.yaml", "../../../go-swagger/swagger.yaml"} {
+		spec, err := loadFile(path)
+		if err != nil {
+			t.Fatalf("loadFile() error = %v", err)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	for _, other := range specs[1:] {
+		for _, diff := range compare(specs[0], other) {
+			if !allowed(allowances, diff) {
+				t.Errorf("%s vs %s: %s", specs[0].path, other.path, diff)
+			}
+		}
+	}
+
+	for _, allowance := range allowances {
+		if !allowance.used {
+			t.Errorf("allowed.txt: %q matches no difference, remove it", allowance.pattern)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+
+	"tools/specbundle"
+)
+
+// spec - спецификация, приведенная к OpenAPI 3. swagger - исходная версия 2.0: в ней нельзя выразить часть
+// того, что есть в 3.x (см. compare).
+type spec struct {
+	path    string
+	doc     *openapi3.T
+	swagger bool
+}
+
+func loadFile(path string) (*spec, error) {
+	data, err := specbundle.File(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := load(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	result.path = path
+
+	return result, nil
+}
+
+// load разбирает спецификацию OpenAPI 3.x или Swagger 2.0 в YAML или JSON. Swagger 2.0 переводится в OpenAPI 3
+// так же, как в валидаторе go-swagger сервера, и обе версии загружаются одним загрузчиком, чтобы ссылки
+// разрешались одинаково.
+func load(data []byte) (*spec, error) {
+	var raw map[string]any
+
+	err := yaml.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+
+	_, swagger := raw["swagger"]
+
+	if swagger {
+		data, err = fromSwagger(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("load spec: %w", err)
+	}
+
+	return &spec{doc: doc, swagger: swagger}, nil
+}
+
+func fromSwagger(raw map[string]any) ([]byte, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("encode swagger spec: %w", err)
+	}
+
+	var doc openapi2.T
+
+	err = json.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("decode swagger spec: %w", err)
+	}
+
+	// без consumes go-swagger принимает application/json
+	if len(doc.Consumes) == 0 {
+		doc.Consumes = []string{"application/json"}
+	}
+
+	// openapi2conv берет типы ответов только из produces операции
+	for _, pathItem := range doc.Paths {
+		for _, operation := range pathItem.Operations() {
+			if len(operation.Produces) == 0 {
+				operation.Produces = doc.Produces
+			}
+		}
+	}
+
+	converted, err := openapi2conv.ToV3(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("convert swagger spec: %w", err)
+	}
+
+	convertCollectionFormats(&doc, converted)
+
+	return json.Marshal(converted)
+}
+
+// convertCollectionFormats переводит collectionFormat параметров-массивов в style и explode: openapi2conv его
+// отбрасывает, и массив в query без explode: false выглядел бы как повторяющийся параметр.
+func convertCollectionFormats(doc *openapi2.T, converted *openapi3.T) {
+	for path, pathItem := range doc.Paths {
+		convertedItem := converted.Paths.Value(path)
+
+		setStyles(pathItem.Parameters, convertedItem.Parameters)
+
+		for method, operation := range pathItem.Operations() {
+			setStyles(operation.Parameters, convertedItem.GetOperation(method).Parameters)
+		}
+	}
+}
+
+func setStyles(parameters openapi2.Parameters, converted openapi3.Parameters) {
+	for _, parameter := range parameters {
+		if parameter.Type == nil || !parameter.Type.Is("array") {
+			continue
+		}
+
+		style, explode, ok := collectionStyle(parameter.In, parameter.CollectionFormat)
+		if !ok {
+			continue
+		}
+
+		target := converted.GetByInAndName(parameter.In, parameter.Name)
+		if target == nil {
+			continue
+		}
+
+		target.Style = style
+		target.Explode = &explode
+	}
+}
+
+// collectionStyle - style и explode OpenAPI 3 для collectionFormat Swagger 2.0 (по умолчанию csv).
+// ok = false для tsv: у него нет аналога.
+func collectionStyle(in string, format string) (style string, explode bool, ok bool) {
+	switch format {
+	case "", "csv":
+		if in == openapi3.ParameterInQuery || in == openapi3.ParameterInCookie {
+			return openapi3.SerializationForm, false, true
+		}
+
+		return openapi3.SerializationSimple, false, true
+	case "multi":
+		return openapi3.SerializationForm, true, true
+	case "ssv":
+		return openapi3.SerializationSpaceDelimited, false, true
+	case "pipes":
+		return openapi3.SerializationPipeDelimited, false, true
+	default:
+		return "", false, false
+	}
+}
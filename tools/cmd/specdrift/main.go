@@ -0,0 +1,75 @@
+// Команда specdrift сравнивает спецификации одного API и сообщает о расхождениях
+// в путях, operationId, параметрах, телах запросов, ответах и схемах. Swagger 2.0 перед сравнением приводится
+// к OpenAPI 3.
+//
+//	go run ./cmd/specdrift [-allow allowed.txt] spec.yaml spec.yaml...
+//
+// Каждая спецификация сравнивается с первой. Команда завершается с ошибкой, если есть расхождения, кроме
+// известных из -allow, или если известное расхождение больше не встречается.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	allowPath := flag.String("allow", "", "file with known differences, one regular expression per line")
+	flag.Parse()
+
+	if flag.NArg() < 2 {
+		fmt.Fprintln(os.Stderr, "usage: specdrift [-allow allowed.txt] spec.yaml spec.yaml...")
+		os.Exit(2)
+	}
+
+	var allowances []*allowance
+
+	if *allowPath != "" {
+		var err error
+
+		allowances, err = loadAllowed(*allowPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	specs := make([]*spec, 0, flag.NArg())
+
+	for _, path := range flag.Args() {
+		spec, err := loadFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	drift := false
+
+	for _, other := range specs[1:] {
+		for _, diff := range compare(specs[0], other) {
+			if allowed(allowances, diff) {
+				continue
+			}
+
+			fmt.Printf("%s vs %s: %s\n", specs[0].path, other.path, diff)
+
+			drift = true
+		}
+	}
+
+	for _, allowance := range allowances {
+		if !allowance.used {
+			fmt.Printf("%s: %q matches no difference, remove it\n", *allowPath, allowance.pattern)
+
+			drift = true
+		}
+	}
+
+	if drift {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+
+	"tools/specbundle"
+)
+
+// severity - уровень правила. Нарушения правил с уровнем error завершают команду с ошибкой, off выключает правило.
+type severity string
+
+const (
+	severityError   severity = "error"
+	severityWarning severity = "warning"
+	severityOff     severity = "off"
+)
+
+// config - файл -config: уровни правил (не указанные правила остаются на уровне error) и известные нарушения,
+// которые не сообщаются.
+type config struct {
+	Rules  map[string]severity `yaml:"rules"`
+	Ignore []*ignore           `yaml:"ignore"`
+}
+
+// ignore - известное нарушение правила Rule в месте Pointer. used - нарушение встретилось: ignore, который больше
+// ничего не скрывает, нужно удалить.
+type ignore struct {
+	Rule    string `yaml:"rule"`
+	Pointer string `yaml:"pointer"`
+	used    bool
+}
+
+// finding - нарушение правила. Line - строка в исходном файле, 0, если место пришло из другого файла
+// спецификации.
+type finding struct {
+	File     string   `json:"file"`
+	Line     int      `json:"line,omitempty"`
+	Rule     string   `json:"rule"`
+	Severity severity `json:"severity"`
+	Pointer  string   `json:"pointer"`
+	Message  string   `json:"message"`
+}
+
+func loadConfig(path string) (config, error) {
+	var cfg config
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(&cfg)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return cfg, fmt.Errorf("%s: %w", path, err)
+	}
+
+	err = cfg.validate()
+	if err != nil {
+		return cfg, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// validate не пропускает опечатки: правило с неизвестным именем молча не выключилось бы.
+func (c config) validate() error {
+	for _, name := range sortedKeys(c.Rules) {
+		if !knownRule(name) {
+			return fmt.Errorf("unknown rule %q", name)
+		}
+
+		switch c.Rules[name] {
+		case severityError, severityWarning, severityOff:
+		default:
+			return fmt.Errorf("rule %s: unknown severity %q, want error, warning or off", name, c.Rules[name])
+		}
+	}
+
+	for _, ignore := range c.Ignore {
+		if !knownRule(ignore.Rule) {
+			return fmt.Errorf("ignore %s: unknown rule %q", ignore.Pointer, ignore.Rule)
+		}
+	}
+
+	return nil
+}
+
+func (c config) severity(rule string) severity {
+	if level, ok := c.Rules[rule]; ok {
+		return level
+	}
+
+	return severityError
+}
+
+func (c config) ignored(rule string, pointer string) bool {
+	for _, ignore := range c.Ignore {
+		if ignore.Rule == rule && ignore.Pointer == pointer {
+			ignore.used = true
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func knownRule(name string) bool {
+	for _, rule := range rules {
+		if rule.name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lintFile проверяет спецификацию из файла path. Спецификация, разбитая на файлы, сначала собирается в один
+// документ (см. specbundle.File).
+func lintFile(path string, cfg config) ([]finding, error) {
+	data, err := specbundle.File(path)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	findings, err := lint(data, source, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for i := range findings {
+		findings[i].File = path
+	}
+
+	return findings, nil
+}
+
+// lint проверяет собранную спецификацию data; строки нарушений ищутся в исходном файле source.
+func lint(data []byte, source []byte, cfg config) ([]finding, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("load spec: %w", err)
+	}
+
+	var root yaml.Node
+
+	err = yaml.Unmarshal(source, &root)
+	if err != nil {
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+
+	var findings []finding
+
+	for _, rule := range rules {
+		level := cfg.severity(rule.name)
+		if level == severityOff {
+			continue
+		}
+
+		rule.check(doc, func(pointer string, message string) {
+			if cfg.ignored(rule.name, pointer) {
+				return
+			}
+
+			findings = append(findings, finding{
+				Line:     line(&root, pointer),
+				Rule:     rule.name,
+				Severity: level,
+				Pointer:  pointer,
+				Message:  message,
+			})
+		})
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Line < findings[j].Line
+	})
+
+	return findings, nil
+}
+
+// line - строка узла по JSON pointer, 0, если узла нет в файле.
+func line(root *yaml.Node, pointer string) int {
+	if len(root.Content) == 0 {
+		return 0
+	}
+
+	node := root.Content[0]
+
+	for _, segment := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		segment = strings.NewReplacer("~1", "/", "~0", "~").Replace(segment)
+		node = child(node, segment)
+
+		if node == nil {
+			return 0
+		}
+	}
+
+	return node.Line
+}
+
+func child(node *yaml.Node, segment string) *yaml.Node {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				// у значения-отображения строка первого ключа, у ключа - строка самого места
+				if node.Content[i+1].Kind == yaml.MappingNode {
+					return keyed(node.Content[i], node.Content[i+1])
+				}
+
+				return node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		index, err := strconv.Atoi(segment)
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return node.Content[index]
+		}
+	}
+
+	return nil
+}
+
+// keyed - копия отображения value со строкой ключа key
+func keyed(key *yaml.Node, value *yaml.Node) *yaml.Node {
+	result := *value
+	result.Line = key.Line
+
+	return &result
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// clean - спецификация без нарушений
+const clean = `openapi: 3.0.3
+info:
+    title: Users API
+    version: 1.0.0
+paths:
+    /users/{id}:
+        parameters:
+            -   name: id
+                in: path
+                required: true
+                schema:
+                    type: integer
+                    minimum: 1
+        get:
+            operationId: GetUser
+            summary: Get user
+            responses:
+                "200":
+                    description: OK
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/User'
+                "404":
+                    $ref: '#/components/responses/NotFound'
+    /users:
+        post:
+            operationId: CreateUser
+            summary: Create user
+            requestBody:
+                content:
+                    application/json:
+                        schema:
+                            type: object
+                            additionalProperties: false
+                            properties:
+                                name:
+                                    type: string
+            responses:
+                "201":
+                    description: Created
+                    headers:
+                        Location:
+                            schema:
+                                type: string
+                "500":
+                    description: Internal Server Error
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/ErrorResponse'
+components:
+    responses:
+        NotFound:
+            description: Not Found
+            content:
+                application/json:
+                    schema:
+                        $ref: '#/components/schemas/ErrorResponse'
+    schemas:
+        User:
+            type: object
+            additionalProperties: false
+            properties:
+                id:
+                    type: integer
+        ErrorResponse:
+            type: object
+            additionalProperties: false
+            properties:
+                code:
+                    type: integer
+`
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace []string
+		want    []finding
+	}{
+		{
+			name: "clean",
+		},
+		{
+			name:    "operation-id",
+			replace: []string{"            operationId: GetUser\n", ""},
+			want: []finding{{
+				Line: 14, Rule: "operation-id", Pointer: "/paths/~1users~1{id}/get",
+				Message: "GET /users/{id}: operationId is missing",
+			}},
+		},
+		{
+			name:    "operation-summary",
+			replace: []string{"            summary: Create user\n", ""},
+			want: []finding{{
+				Line: 27, Rule: "operation-summary", Pointer: "/paths/~1users/post",
+				Message: "POST /users: summary is missing",
+			}},
+		},
+		{
+			name: "error-response-schema",
+			replace: []string{
+				"                            schema:\n                                $ref: '#/components/schemas/ErrorResponse'\n",
+				"                            schema:\n                                type: string\n",
+			},
+			want: []finding{{
+				Line: 46, Rule: "error-response-schema", Pointer: "/paths/~1users/post/responses/500",
+				Message: "POST /users: response 500 does not reference ErrorResponse",
+			}},
+		},
+		{
+			name: "error-response-schema through response ref",
+			replace: []string{
+				"                    schema:\n                        $ref: '#/components/schemas/ErrorResponse'\n",
+				"                    schema:\n                        $ref: '#/components/schemas/User'\n",
+			},
+			want: []finding{{
+				Line: 24, Rule: "error-response-schema", Pointer: "/paths/~1users~1{id}/get/responses/404",
+				Message: "GET /users/{id}: response 404 does not reference ErrorResponse",
+			}},
+		},
+		{
+			name:    "path-param-minimum from path item",
+			replace: []string{"                    minimum: 1\n", ""},
+			want: []finding{{
+				Line: 8, Rule: "path-param-minimum", Pointer: "/paths/~1users~1{id}/parameters/0",
+				Message: "GET /users/{id}: path parameter id has no minimum",
+			}},
+		},
+		{
+			name:    "path-param-minimum skips strings",
+			replace: []string{"                    type: integer\n                    minimum: 1\n", "                    type: string\n"},
+		},
+		{
+			name: "additional-properties skips response schemas",
+			replace: []string{
+				"            additionalProperties: false\n            properties:\n                id:",
+				"            properties:\n                id:",
+			},
+		},
+		{
+			name: "additional-properties through request body ref",
+			replace: []string{
+				"                            type: object\n                            additionalProperties: false\n                            properties:\n                                name:\n                                    type: string\n",
+				"                            $ref: '#/components/schemas/User'\n",
+				"            additionalProperties: false\n            properties:\n                id:",
+				"            properties:\n                id:",
+			},
+			want: []finding{{
+				Line: 57, Rule: "additional-properties", Pointer: "/components/schemas/User",
+				Message: "schema User: additionalProperties: false is missing",
+			}},
+		},
+		{
+			name:    "additional-properties inline",
+			replace: []string{"                            additionalProperties: false\n", ""},
+			want: []finding{{
+				Line: 33, Rule: "additional-properties", Pointer: "/paths/~1users/post/requestBody/content/application~1json/schema",
+				Message: "POST /users: request body application/json: additionalProperties: false is missing",
+			}},
+		},
+		{
+			name:    "additional-properties allows explicit schema",
+			replace: []string{"                            additionalProperties: false\n", "                            additionalProperties:\n                                type: string\n"},
+		},
+		{
+			name:    "created-location",
+			replace: []string{"                        Location:\n", "                        X-Request-Id:\n"},
+			want: []finding{{
+				Line: 40, Rule: "created-location", Pointer: "/paths/~1users/post/responses/201",
+				Message: "POST /users: response 201 has no Location header",
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := []byte(strings.NewReplacer(tt.replace...).Replace(clean))
+
+			got, err := lint(spec, spec, config{})
+			if err != nil {
+				t.Fatalf("lint() error = %v", err)
+			}
+
+			for i := range tt.want {
+				tt.want[i].Severity = severityError
+			}
+
+			if !equal(got, tt.want) {
+				t.Fatalf("lint() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLint_Config(t *testing.T) {
+	spec := []byte(strings.NewReplacer(
+		"            operationId: GetUser\n", "",
+		"            summary: Create user\n", "",
+		"                        Location:\n", "                        X-Request-Id:\n",
+	).Replace(clean))
+
+	cfg := config{
+		Rules: map[string]severity{
+			"operation-id":     severityOff,
+			"created-location": severityWarning,
+		},
+		Ignore: []*ignore{
+			{Rule: "operation-summary", Pointer: "/paths/~1users/post"},
+			{Rule: "operation-summary", Pointer: "/paths/~1users~1{id}/get"},
+		},
+	}
+
+	got, err := lint(spec, spec, cfg)
+	if err != nil {
+		t.Fatalf("lint() error = %v", err)
+	}
+
+	want := []finding{{
+		Line: 38, Rule: "created-location", Severity: severityWarning, Pointer: "/paths/~1users/post/responses/201",
+		Message: "POST /users: response 201 has no Location header",
+	}}
+
+	if !equal(got, want) {
+		t.Fatalf("lint() = %+v, want %+v", got, want)
+	}
+
+	if !cfg.Ignore[0].used || cfg.Ignore[1].used {
+		t.Fatalf("ignore used = %v, %v, want true, false", cfg.Ignore[0].used, cfg.Ignore[1].used)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:   "empty",
+			config: "",
+		},
+		{
+			name:   "valid",
+			config: "rules:\n    created-location: warning\nignore:\n    -   rule: operation-id\n        pointer: /paths/~1users/post\n",
+		},
+		{
+			name:    "unknown rule",
+			config:  "rules:\n    operation-ids: off\n",
+			wantErr: `unknown rule "operation-ids"`,
+		},
+		{
+			name:    "unknown severity",
+			config:  "rules:\n    operation-id: info\n",
+			wantErr: `unknown severity "info"`,
+		},
+		{
+			name:    "unknown ignore rule",
+			config:  "ignore:\n    -   rule: summary\n        pointer: /paths\n",
+			wantErr: `unknown rule "summary"`,
+		},
+		{
+			name:    "unknown field",
+			config:  "rule:\n    operation-id: off\n",
+			wantErr: "field rule not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "speclint.yaml")
+
+			err := os.WriteFile(path, []byte(tt.config), 0o644)
+			if err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+
+			_, err = loadConfig(path)
+
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("loadConfig() error = %v", err)
+			}
+
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("loadConfig() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	findings := []finding{{
+		File: "openapi.yaml", Line: 14, Rule: "operation-id", Severity: severityError, Pointer: "/paths/~1users/get",
+		Message: "GET /users: operationId is missing",
+	}}
+
+	var text bytes.Buffer
+
+	err := write(&text, "text", findings)
+	if err != nil {
+		t.Fatalf("write() error = %v", err)
+	}
+
+	if want := "openapi.yaml:14: error: GET /users: operationId is missing (operation-id)\n"; text.String() != want {
+		t.Fatalf("write(text) = %q, want %q", text.String(), want)
+	}
+
+	var data bytes.Buffer
+
+	err = write(&data, "json", findings)
+	if err != nil {
+		t.Fatalf("write() error = %v", err)
+	}
+
+	var got []finding
+
+	err = json.Unmarshal(data.Bytes(), &got)
+	if err != nil {
+		t.Fatalf("write(json) = %s, error = %v", data.String(), err)
+	}
+
+	if !equal(got, findings) {
+		t.Fatalf("write(json) = %+v, want %+v", got, findings)
+	}
+}
+
+// Спецификации проекта проходят проверку с конфигурацией из make speclint
+func TestRepoSpecs(t *testing.T) {
+	cfg, err := loadConfig("speclint.yaml")
+	if err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+
+	for _, path := range []string{"../../../oapi-codegen/openapi.yaml", "../../../ogen-go/openapi.yaml"} {
+		findings, err := lintFile(path, cfg)
+		if err != nil {
+			t.Fatalf("lintFile(%s) error = %v", path, err)
+		}
+
+		for _, finding := range findings {
+			if finding.Severity == severityError {
+				t.Errorf("%s:%d: %s (%s)", path, finding.Line, finding.Message, finding.Rule)
+			}
+		}
+	}
+
+	for _, ignore := range cfg.Ignore {
+		if !ignore.used {
+			t.Errorf("ignore %s %s matches no finding", ignore.Rule, ignore.Pointer)
+		}
+	}
+}
+
+func equal(got []finding, want []finding) bool {
+	if len(got) != len(want) {
+		return false
+	}
+
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,107 @@
+// Команда speclint проверяет спецификации по правилам проекта: у операций есть operationId и summary, ответы
+// 4xx и 5xx ссылаются на ErrorResponse, у числовых параметров пути есть minimum, у схем тел запросов -
+// additionalProperties: false (ответы остаются открытыми, чтобы клиенты не ломались на новых полях), у ответов
+// 201 - заголовок Location.
+//
+//	go run ./cmd/speclint [-config speclint.yaml] [-format text|json] [-rules] spec.yaml...
+//
+// В -config задаются уровни правил (error, warning, off; по умолчанию все правила - error) и известные
+// нарушения (ignore: правило и JSON pointer места). -format json выводит нарушения массивом JSON для CI.
+// Команда завершается с ошибкой, если есть нарушения уровня error или ignore, который больше ничего не скрывает.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	configPath := flag.String("config", "", "file with rule severities")
+	format := flag.String("format", "text", "output format: text or json")
+	list := flag.Bool("rules", false, "list rules and exit")
+	flag.Parse()
+
+	if *list {
+		for _, rule := range rules {
+			fmt.Printf("%-22s %s\n", rule.name, rule.description)
+		}
+
+		return
+	}
+
+	if flag.NArg() == 0 || (*format != "text" && *format != "json") {
+		fmt.Fprintln(os.Stderr, "usage: speclint [-config speclint.yaml] [-format text|json] [-rules] spec.yaml...")
+		os.Exit(2)
+	}
+
+	var cfg config
+
+	if *configPath != "" {
+		var err error
+
+		cfg, err = loadConfig(*configPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	findings := []finding{}
+
+	for _, path := range flag.Args() {
+		found, err := lintFile(path, cfg)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		findings = append(findings, found...)
+	}
+
+	err := write(os.Stdout, *format, findings)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	failed := false
+
+	for _, ignore := range cfg.Ignore {
+		if !ignore.used {
+			fmt.Fprintf(os.Stderr, "%s: ignore %s %s matches no finding, remove it\n", *configPath, ignore.Rule, ignore.Pointer)
+
+			failed = true
+		}
+	}
+
+	for _, finding := range findings {
+		if finding.Severity == severityError {
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func write(w io.Writer, format string, findings []finding) error {
+	if format == "json" {
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(findings)
+	}
+
+	for _, finding := range findings {
+		_, err := fmt.Fprintf(w, "%s:%d: %s: %s (%s)\n", finding.File, finding.Line, finding.Severity, finding.Message, finding.Rule)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
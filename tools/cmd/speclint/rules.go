@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// errorSchema - схема тела ошибок, на которую должны ссылаться ответы 4xx и 5xx
+const errorSchema = "#/components/schemas/ErrorResponse"
+
+// rule - правило линтера. check сообщает о нарушениях через report: pointer - JSON pointer места в спецификации.
+type rule struct {
+	name        string
+	description string
+	check       func(doc *openapi3.T, report func(pointer string, message string))
+}
+
+var rules = []rule{
+	{
+		name:        "operation-id",
+		description: "every operation has an operationId",
+		check: eachOperation(func(op operation, report func(string, string)) {
+			if op.OperationID == "" {
+				report(op.pointer, op.name+": operationId is missing")
+			}
+		}),
+	},
+	{
+		name:        "operation-summary",
+		description: "every operation has a summary",
+		check: eachOperation(func(op operation, report func(string, string)) {
+			if op.Summary == "" {
+				report(op.pointer, op.name+": summary is missing")
+			}
+		}),
+	},
+	{
+		name:        "error-response-schema",
+		description: "every 4xx and 5xx response references ErrorResponse",
+		check: eachOperation(func(op operation, report func(string, string)) {
+			responses := op.Responses.Map()
+
+			for _, status := range sortedKeys(responses) {
+				if !strings.HasPrefix(status, "4") && !strings.HasPrefix(status, "5") {
+					continue
+				}
+
+				if !referencesSchema(responses[status].Value, errorSchema) {
+					report(op.pointer+"/responses/"+escape(status), fmt.Sprintf("%s: response %s does not reference ErrorResponse", op.name, status))
+				}
+			}
+		}),
+	},
+	{
+		name:        "path-param-minimum",
+		description: "every numeric path parameter has a minimum",
+		check: eachOperation(func(op operation, report func(string, string)) {
+			for i, ref := range op.Parameters {
+				parameter := ref.Value
+				if parameter.In != openapi3.ParameterInPath || parameter.Schema == nil || parameter.Schema.Value == nil {
+					continue
+				}
+
+				schema := parameter.Schema.Value
+				if (schema.Type.Is("integer") || schema.Type.Is("number")) && schema.Min == nil {
+					report(op.parameterPointers[i], fmt.Sprintf("%s: path parameter %s has no minimum", op.name, parameter.Name))
+				}
+			}
+		}),
+	},
+	{
+		name:        "additional-properties",
+		description: "every request body object schema sets additionalProperties: false",
+		check: func(doc *openapi3.T, report func(string, string)) {
+			// схема из components, на которую ссылаются несколько тел, проверяется один раз
+			checked := make(map[*openapi3.Schema]bool)
+
+			eachOperation(func(op operation, report func(string, string)) {
+				if op.RequestBody == nil || op.RequestBody.Value == nil {
+					return
+				}
+
+				content := op.RequestBody.Value.Content
+
+				for _, mediaType := range sortedKeys(content) {
+					checkAdditionalProperties(op.pointer+"/requestBody/content/"+escape(mediaType)+"/schema",
+						op.name+": request body "+mediaType, content[mediaType].Schema, checked, report)
+				}
+			})(doc, report)
+		},
+	},
+	{
+		name:        "created-location",
+		description: "every 201 response has a Location header",
+		check: eachOperation(func(op operation, report func(string, string)) {
+			response := op.Responses.Value("201")
+			if response == nil || response.Value == nil {
+				return
+			}
+
+			for name := range response.Value.Headers {
+				if http.CanonicalHeaderKey(name) == "Location" {
+					return
+				}
+			}
+
+			report(op.pointer+"/responses/201", op.name+": response 201 has no Location header")
+		}),
+	},
+}
+
+// operation - операция спецификации с местом в ней. Parameters - параметры операции и path item, которые
+// операция не переопределяет; parameterPointers - их места.
+type operation struct {
+	*openapi3.Operation
+	// name - метод и путь, например GET /users
+	name              string
+	pointer           string
+	parameterPointers []string
+}
+
+// eachOperation превращает проверку одной операции в проверку спецификации.
+func eachOperation(check func(op operation, report func(string, string))) func(*openapi3.T, func(string, string)) {
+	return func(doc *openapi3.T, report func(string, string)) {
+		for _, path := range sortedKeys(doc.Paths.Map()) {
+			pathItem := doc.Paths.Value(path)
+			pathPointer := "/paths/" + escape(path)
+
+			for _, method := range sortedKeys(pathItem.Operations()) {
+				// копия: параметры path item не должны попасть в документ, его проверяют и другие правила
+				copied := *pathItem.GetOperation(method)
+				op := operation{
+					Operation: &copied,
+					name:      method + " " + path,
+					pointer:   pathPointer + "/" + strings.ToLower(method),
+				}
+
+				own := op.Parameters
+				op.Parameters = append(openapi3.Parameters{}, own...)
+
+				for i := range own {
+					op.parameterPointers = append(op.parameterPointers, fmt.Sprintf("%s/parameters/%d", op.pointer, i))
+				}
+
+				for i, ref := range pathItem.Parameters {
+					if own.GetByInAndName(ref.Value.In, ref.Value.Name) == nil {
+						op.Parameters = append(op.Parameters, ref)
+						op.parameterPointers = append(op.parameterPointers, fmt.Sprintf("%s/parameters/%d", pathPointer, i))
+					}
+				}
+
+				check(op, report)
+			}
+		}
+	}
+}
+
+func referencesSchema(response *openapi3.Response, ref string) bool {
+	if response == nil {
+		return false
+	}
+
+	for _, media := range response.Content {
+		if media.Schema != nil && media.Schema.Ref == ref {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkAdditionalProperties проверяет схему объекта и вложенные в нее схемы. Схема по ссылке на components
+// сообщается там, где она описана.
+func checkAdditionalProperties(pointer string, name string, ref *openapi3.SchemaRef, checked map[*openapi3.Schema]bool, report func(string, string)) {
+	if ref == nil || ref.Value == nil || checked[ref.Value] {
+		return
+	}
+
+	if schemaName, ok := strings.CutPrefix(ref.Ref, "#/components/schemas/"); ok {
+		pointer, name = "/components/schemas/"+escape(schemaName), "schema "+schemaName
+	}
+
+	schema := ref.Value
+	checked[schema] = true
+
+	if len(schema.Properties) != 0 && schema.AdditionalProperties.Has == nil && schema.AdditionalProperties.Schema == nil {
+		report(pointer, name+": additionalProperties: false is missing")
+	}
+
+	for _, property := range sortedKeys(schema.Properties) {
+		checkAdditionalProperties(pointer+"/properties/"+escape(property), name+": property "+property, schema.Properties[property], checked, report)
+	}
+
+	checkAdditionalProperties(pointer+"/items", name+": items", schema.Items, checked, report)
+
+	for i, item := range schema.AllOf {
+		checkAdditionalProperties(fmt.Sprintf("%s/allOf/%d", pointer, i), fmt.Sprintf("%s: allOf[%d]", name, i), item, checked, report)
+	}
+}
+
+// escape экранирует сегмент JSON pointer
+func escape(segment string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(segment)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// primaryMediaType - тип, схема которого становится схемой тела в Swagger 2.0, если типов несколько
+const primaryMediaType = "application/json"
+
+// convert переводит OpenAPI 3 в Swagger 2.0 и возвращает предупреждения о том, что в 2.0 не выражается
+// и потеряно при переводе. doc меняется: у тел запросов остается один тип.
+//
+// Основу делает openapi2conv.FromV3: requestBody становится параметром body, components/schemas - definitions,
+// первый из servers - host, basePath и schemes. Поверх него здесь задаются produces и consumes (FromV3 их
+// не переносит) и collectionFormat параметров-массивов.
+func convert(doc *openapi3.T) (*openapi2.T, []string, error) {
+	c := &converter{doc: doc}
+
+	c.checkServers()
+
+	for _, path := range doc.Paths.InMatchingOrder() {
+		pathItem := doc.Paths.Value(path)
+
+		for _, method := range sortedKeys(pathItem.Operations()) {
+			c.checkOperation(method+" "+path, pathItem.GetOperation(method))
+		}
+	}
+
+	for _, name := range sortedKeys(doc.Components.Schemas) {
+		c.checkSchema("schema "+name, doc.Components.Schemas[name])
+	}
+
+	result, err := openapi2conv.FromV3(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.setMediaTypes(result)
+	c.setCollectionFormats(result)
+	dropHeaderRequired(result)
+
+	sort.Strings(c.warnings)
+
+	return result, c.warnings, nil
+}
+
+type converter struct {
+	doc      *openapi3.T
+	warnings []string
+}
+
+func (c *converter) warn(where string, format string, args ...any) {
+	c.warnings = append(c.warnings, where+": "+fmt.Sprintf(format, args...))
+}
+
+func (c *converter) checkServers() {
+	for i, server := range c.doc.Servers {
+		where := fmt.Sprintf("servers[%d]", i)
+
+		if len(server.Variables) != 0 {
+			c.warn(where, "server variables are not supported, the URL is used as is")
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		first, _ := url.Parse(c.doc.Servers[0].URL)
+		other, err := url.Parse(server.URL)
+
+		if err == nil && first != nil && (other.Host != first.Host || other.Path != first.Path) {
+			c.warn(where, "only the host and base path of the first server are kept, %s is dropped", server.URL)
+		}
+	}
+}
+
+func (c *converter) checkOperation(where string, operation *openapi3.Operation) {
+	for _, ref := range operation.Parameters {
+		c.checkParameter(where+": parameter "+ref.Value.Name, ref.Value)
+	}
+
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		body := operation.RequestBody.Value
+
+		if len(body.Content) > 1 {
+			keep := primaryMediaType
+			if body.Content[primaryMediaType] == nil {
+				keep = sortedKeys(body.Content)[0]
+			}
+
+			dropped := slices.DeleteFunc(sortedKeys(body.Content), func(mediaType string) bool { return mediaType == keep })
+			c.warn(where+": request body", "one body type is supported, %s is kept, %s dropped", keep, strings.Join(dropped, ", "))
+
+			body.Content = openapi3.Content{keep: body.Content[keep]}
+		}
+
+		for _, mediaType := range sortedKeys(body.Content) {
+			c.checkSchema(where+": request body", body.Content[mediaType].Schema)
+		}
+	}
+
+	if len(operation.Callbacks) != 0 {
+		c.warn(where, "callbacks are not supported and dropped")
+	}
+
+	// одинаковые потери в нескольких ответах операции собираются в одно предупреждение
+	ownSchemas := make(map[string][]string)
+	requiredHeaders := make(map[string][]string)
+
+	responses := operation.Responses.Map()
+
+	for _, status := range sortedKeys(responses) {
+		response := responses[status].Value
+		if response == nil {
+			continue
+		}
+
+		responseWhere := where + ": response " + status
+
+		for _, name := range sortedKeys(response.Headers) {
+			if header := response.Headers[name].Value; header != nil && header.Required {
+				requiredHeaders[name] = append(requiredHeaders[name], status)
+			}
+		}
+
+		if len(response.Links) != 0 {
+			c.warn(responseWhere, "links are not supported and dropped")
+		}
+
+		primary := response.Content[primaryMediaType]
+		if primary == nil && len(response.Content) != 0 {
+			c.warn(responseWhere, "no %s body, the schema is dropped", primaryMediaType)
+		}
+
+		for _, mediaType := range sortedKeys(response.Content) {
+			media := response.Content[mediaType]
+
+			if primary != nil && mediaType != primaryMediaType && !sameSchema(media.Schema, primary.Schema) {
+				ownSchemas[mediaType] = append(ownSchemas[mediaType], status)
+			}
+
+			if mediaType == primaryMediaType {
+				c.checkSchema(responseWhere, media.Schema)
+			}
+		}
+	}
+
+	for _, mediaType := range sortedKeys(ownSchemas) {
+		c.warn(where, "responses %s: %s has its own schema, the %s one is used for every type",
+			strings.Join(ownSchemas[mediaType], ", "), mediaType, primaryMediaType)
+	}
+
+	for _, name := range sortedKeys(requiredHeaders) {
+		c.warn(where, "responses %s: header %s: required is not supported", strings.Join(requiredHeaders[name], ", "), name)
+	}
+}
+
+func (c *converter) checkParameter(where string, parameter *openapi3.Parameter) {
+	if parameter.In == openapi3.ParameterInCookie {
+		c.warn(where, "cookie parameters are not supported")
+	}
+
+	if len(parameter.Content) != 0 {
+		c.warn(where, "parameters with content are not supported, use schema")
+	}
+
+	if parameter.Schema != nil && isArray(parameter.Schema) {
+		_, ok := collectionFormat(parameter)
+		if !ok {
+			c.warn(where, "style %s with explode=%v has no collectionFormat", parameter.Style, explode(parameter))
+		}
+	}
+
+	c.checkSchema(where, parameter.Schema)
+}
+
+// checkSchema предупреждает о том, чего нет в схемах Swagger 2.0. Ссылки не обходятся: схемы из components
+// проверяются отдельно.
+func (c *converter) checkSchema(where string, ref *openapi3.SchemaRef) {
+	if ref == nil || ref.Ref != "" || ref.Value == nil {
+		return
+	}
+
+	schema := ref.Value
+
+	for name, list := range map[string]openapi3.SchemaRefs{"oneOf": schema.OneOf, "anyOf": schema.AnyOf} {
+		if len(list) != 0 {
+			c.warn(where, "%s is not supported and dropped", name)
+		}
+	}
+
+	if schema.Not != nil {
+		c.warn(where, "not is not supported and dropped")
+	}
+
+	if schema.WriteOnly {
+		c.warn(where, "writeOnly is not supported")
+	}
+
+	if len(schema.Type.Slice()) > 1 {
+		c.warn(where, "several types %v are not supported", schema.Type.Slice())
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		c.checkSchema(where+": property "+name, schema.Properties[name])
+	}
+
+	c.checkSchema(where+": items", schema.Items)
+	c.checkSchema(where+": additionalProperties", schema.AdditionalProperties.Schema)
+
+	for i, item := range schema.AllOf {
+		c.checkSchema(fmt.Sprintf("%s: allOf[%d]", where, i), item)
+	}
+}
+
+// setMediaTypes задает produces (типы ответов) и consumes (типы тела) операций. Если у всех операций они
+// одинаковые, они задаются один раз для всей спецификации.
+func (c *converter) setMediaTypes(result *openapi2.T) {
+	var operations []*openapi2.Operation
+
+	var produces, consumes [][]string
+
+	for _, path := range c.doc.Paths.InMatchingOrder() {
+		pathItem := c.doc.Paths.Value(path)
+
+		for _, method := range sortedKeys(pathItem.Operations()) {
+			operation := pathItem.GetOperation(method)
+			converted := result.Paths[path].GetOperation(method)
+
+			types := responseTypes(operation)
+			converted.Produces = types
+
+			operations = append(operations, converted)
+			produces = append(produces, types)
+
+			if len(converted.Consumes) != 0 {
+				consumes = append(consumes, converted.Consumes)
+			}
+		}
+	}
+
+	if allEqual(produces) {
+		result.Produces = produces[0]
+
+		for _, operation := range operations {
+			operation.Produces = nil
+		}
+	}
+
+	if len(consumes) != 0 && allEqual(consumes) {
+		result.Consumes = consumes[0]
+
+		for _, operation := range operations {
+			operation.Consumes = nil
+		}
+	}
+}
+
+// responseTypes - типы тел ответов операции: primaryMediaType первым, остальные по алфавиту.
+func responseTypes(operation *openapi3.Operation) []string {
+	var types []string
+
+	for _, response := range operation.Responses.Map() {
+		if response.Value == nil {
+			continue
+		}
+
+		for mediaType := range response.Value.Content {
+			if !slices.Contains(types, mediaType) {
+				types = append(types, mediaType)
+			}
+		}
+	}
+
+	slices.SortFunc(types, func(a string, b string) int {
+		switch {
+		case a == b:
+			return 0
+		case a == primaryMediaType:
+			return -1
+		case b == primaryMediaType:
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+
+	return types
+}
+
+// setCollectionFormats задает collectionFormat параметров-массивов по style и explode: FromV3 его не переносит,
+// а в 2.0 по умолчанию csv, тогда как в 3.x у query по умолчанию повторяющийся параметр.
+func (c *converter) setCollectionFormats(result *openapi2.T) {
+	for _, path := range c.doc.Paths.InMatchingOrder() {
+		pathItem := c.doc.Paths.Value(path)
+
+		for _, method := range sortedKeys(pathItem.Operations()) {
+			converted := result.Paths[path].GetOperation(method)
+
+			for _, ref := range pathItem.GetOperation(method).Parameters {
+				parameter := ref.Value
+				if parameter.Schema == nil || !isArray(parameter.Schema) {
+					continue
+				}
+
+				format, ok := collectionFormat(parameter)
+				if !ok {
+					continue
+				}
+
+				for _, target := range converted.Parameters {
+					if target.In == parameter.In && target.Name == parameter.Name {
+						target.CollectionFormat = format
+					}
+				}
+			}
+		}
+	}
+}
+
+// dropHeaderRequired убирает required у заголовков ответов: FromV3 переносит его, а в Swagger 2.0 это поле
+// запрещено и go-swagger отвергает спецификацию.
+func dropHeaderRequired(result *openapi2.T) {
+	for _, pathItem := range result.Paths {
+		for _, operation := range pathItem.Operations() {
+			for _, response := range operation.Responses {
+				for _, header := range response.Headers {
+					header.Required = false
+				}
+			}
+		}
+	}
+}
+
+// collectionFormat - collectionFormat Swagger 2.0 для style и explode параметра; ok = false, если аналога нет.
+func collectionFormat(parameter *openapi3.Parameter) (string, bool) {
+	style := parameter.Style
+	if style == "" {
+		style = openapi3.SerializationSimple
+		if parameter.In == openapi3.ParameterInQuery || parameter.In == openapi3.ParameterInCookie {
+			style = openapi3.SerializationForm
+		}
+	}
+
+	switch {
+	case style == openapi3.SerializationForm && explode(parameter):
+		return "multi", parameter.In == openapi3.ParameterInQuery
+	case style == openapi3.SerializationForm, style == openapi3.SerializationSimple && !explode(parameter):
+		return "csv", true
+	case style == openapi3.SerializationSpaceDelimited && !explode(parameter):
+		return "ssv", true
+	case style == openapi3.SerializationPipeDelimited && !explode(parameter):
+		return "pipes", true
+	default:
+		return "", false
+	}
+}
+
+// explode - explode параметра со значением по умолчанию: true только для style form.
+func explode(parameter *openapi3.Parameter) bool {
+	if parameter.Explode != nil {
+		return *parameter.Explode
+	}
+
+	return parameter.Style == "" && (parameter.In == openapi3.ParameterInQuery || parameter.In == openapi3.ParameterInCookie) ||
+		parameter.Style == openapi3.SerializationForm
+}
+
+func isArray(ref *openapi3.SchemaRef) bool {
+	return ref.Value != nil && ref.Value.Type.Is("array")
+}
+
+func sameSchema(a *openapi3.SchemaRef, b *openapi3.SchemaRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if a.Ref != "" || b.Ref != "" {
+		return a.Ref == b.Ref
+	}
+
+	return a.Value == b.Value
+}
+
+func allEqual(lists [][]string) bool {
+	for _, list := range lists[1:] {
+		if !slices.Equal(list, lists[0]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
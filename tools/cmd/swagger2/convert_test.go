@@ -0,0 +1,275 @@
+package main
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"tools/specbundle"
+	"tools/specoverlay"
+)
+
+const openAPISpec = `openapi: 3.0.3
+info:
+    title: Users API
+    version: 1.0.0
+servers:
+    - url: https://api.example.com/v1
+paths:
+    /users:
+        get:
+            operationId: ListUsers
+            parameters:
+                - name: sort
+                  in: query
+                  style: form
+                  explode: false
+                  schema:
+                      type: array
+                      items:
+                          type: string
+            responses:
+                "200":
+                    description: OK
+                    headers:
+                        ETag:
+                            schema:
+                                type: string
+                    content:
+                        application/json:
+                            schema:
+                                type: array
+                                items:
+                                    $ref: '#/components/schemas/User'
+        post:
+            operationId: CreateUser
+            requestBody:
+                required: true
+                content:
+                    application/json:
+                        schema:
+                            $ref: '#/components/schemas/User'
+            responses:
+                "201":
+                    description: Created
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/User'
+components:
+    schemas:
+        User:
+            type: object
+            required:
+                - name
+            properties:
+                name:
+                    type: string
+`
+
+const swaggerWant = `# Code generated by swagger2 from openapi.yaml. DO NOT EDIT.
+swagger: "2.0"
+info:
+    title: Users API
+    version: 1.0.0
+host: api.example.com
+basePath: /v1
+schemes:
+    - https
+consumes:
+    - application/json
+produces:
+    - application/json
+paths:
+    /users:
+        get:
+            operationId: ListUsers
+            parameters:
+                - name: sort
+                  in: query
+                  type: array
+                  collectionFormat: csv
+                  items:
+                    type: string
+            responses:
+                "200":
+                    description: OK
+                    headers:
+                        ETag:
+                            type: string
+                    schema:
+                        type: array
+                        items:
+                            $ref: '#/definitions/User'
+        post:
+            operationId: CreateUser
+            parameters:
+                - name: body
+                  in: body
+                  required: true
+                  schema:
+                    $ref: '#/definitions/User'
+            responses:
+                "201":
+                    description: Created
+                    schema:
+                        $ref: '#/definitions/User'
+definitions:
+    User:
+        required:
+            - name
+        type: object
+        properties:
+            name:
+                type: string
+`
+
+func TestGenerate(t *testing.T) {
+	got, warnings, err := generate([]byte(openAPISpec), "openapi.yaml")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	if string(got) != swaggerWant {
+		t.Fatalf("generate() =\n%s\nwant\n%s", got, swaggerWant)
+	}
+
+	if len(warnings) != 0 {
+		t.Fatalf("generate() warnings = %q, want none", warnings)
+	}
+}
+
+func TestGenerate_Warnings(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		want    []string
+		notWant string
+	}{
+		{
+			name: "oneOf",
+			old:  "                name:\n                    type: string\n",
+			new:  "                name:\n                    oneOf:\n                        - type: string\n                        - type: integer\n",
+			want: []string{"schema User: property name: oneOf is not supported and dropped"},
+		},
+		{
+			name: "several request body types",
+			old:  "                content:\n                    application/json:\n                        schema:\n                            $ref: '#/components/schemas/User'\n",
+			new: "                content:\n                    application/json:\n                        schema:\n                            $ref: '#/components/schemas/User'\n" +
+				"                    application/xml:\n                        schema:\n                            $ref: '#/components/schemas/User'\n",
+			want:    []string{"POST /users: request body: one body type is supported, application/json is kept, application/xml dropped"},
+			notWant: "application/xml",
+		},
+		{
+			name: "own problem schema",
+			old:  "                    description: Created\n                    content:\n",
+			new: "                    description: Created\n                    content:\n" +
+				"                        application/problem+json:\n                            schema:\n                                type: object\n",
+			want: []string{"POST /users: responses 201: application/problem+json has its own schema, the application/json one is used for every type"},
+		},
+		{
+			name: "required header",
+			old:  "                        ETag:\n",
+			new:  "                        ETag:\n                            required: true\n",
+			want: []string{"GET /users: responses 200: header ETag: required is not supported"},
+		},
+		{
+			name: "deep object",
+			old:  "                  style: form\n                  explode: false\n",
+			new:  "                  style: deepObject\n                  explode: true\n",
+			want: []string{"GET /users: parameter sort: style deepObject with explode=true has no collectionFormat"},
+		},
+		{
+			name: "cookie parameter",
+			old:  "                  in: query\n",
+			new:  "                  in: cookie\n",
+			want: []string{"GET /users: parameter sort: cookie parameters are not supported"},
+		},
+		{
+			name: "several servers",
+			old:  "    - url: https://api.example.com/v1\n",
+			new:  "    - url: https://api.example.com/v1\n    - url: https://staging.example.com/v1\n",
+			want: []string{"servers[1]: only the host and base path of the first server are kept, https://staging.example.com/v1 is dropped"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(openAPISpec, tt.old) {
+				t.Fatalf("openAPISpec does not contain %q", tt.old)
+			}
+
+			got, warnings, err := generate([]byte(strings.Replace(openAPISpec, tt.old, tt.new, 1)), "openapi.yaml")
+			if err != nil {
+				t.Fatalf("generate() error = %v", err)
+			}
+
+			if !slices.Equal(warnings, tt.want) {
+				t.Fatalf("generate() warnings =\n%s\nwant\n%s", strings.Join(warnings, "\n"), strings.Join(tt.want, "\n"))
+			}
+
+			if tt.notWant != "" && strings.Contains(string(got), tt.notWant) {
+				t.Fatalf("generate() =\n%s\nwant no %s", got, tt.notWant)
+			}
+		})
+	}
+}
+
+func TestCollectionFormat(t *testing.T) {
+	explode := func(v bool) *bool { return &v }
+
+	tests := []struct {
+		parameter openapi3.Parameter
+		want      string
+		wantOK    bool
+	}{
+		{parameter: openapi3.Parameter{In: "query"}, want: "multi", wantOK: true},
+		{parameter: openapi3.Parameter{In: "query", Explode: explode(false)}, want: "csv", wantOK: true},
+		{parameter: openapi3.Parameter{In: "header"}, want: "csv", wantOK: true},
+		{parameter: openapi3.Parameter{In: "path", Style: "simple"}, want: "csv", wantOK: true},
+		{parameter: openapi3.Parameter{In: "query", Style: "spaceDelimited", Explode: explode(false)}, want: "ssv", wantOK: true},
+		{parameter: openapi3.Parameter{In: "query", Style: "pipeDelimited", Explode: explode(false)}, want: "pipes", wantOK: true},
+		{parameter: openapi3.Parameter{In: "query", Style: "pipeDelimited", Explode: explode(true)}},
+		{parameter: openapi3.Parameter{In: "path", Explode: explode(true)}},
+		{parameter: openapi3.Parameter{In: "path", Style: "label"}},
+	}
+
+	for _, tt := range tests {
+		got, ok := collectionFormat(&tt.parameter)
+		if ok != tt.wantOK || ok && got != tt.want {
+			t.Errorf("collectionFormat(%s %s, explode %v) = %q, %v, want %q, %v",
+				tt.parameter.In, tt.parameter.Style, tt.parameter.Explode != nil && *tt.parameter.Explode, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// swagger.yaml go-swagger генерируется из openapi.yaml и не должен правиться вручную
+func TestSwaggerUpToDate(t *testing.T) {
+	spec, err := specbundle.File("../../../oapi-codegen/openapi.yaml")
+	if err != nil {
+		t.Fatalf("File() error = %v", err)
+	}
+
+	spec, err = specoverlay.Apply(spec, "../../../go-swagger/overlay.yaml")
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	want, _, err := generate(spec, "openapi.yaml")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	got, err := os.ReadFile("../../../go-swagger/swagger.yaml")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	if string(got) != string(want) {
+		t.Fatal("go-swagger/swagger.yaml is out of date, run make swagger")
+	}
+}
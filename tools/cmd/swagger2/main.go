@@ -0,0 +1,107 @@
+// Команда swagger2 переводит спецификацию OpenAPI 3.x в Swagger 2.0 для go-swagger: requestBody становится
+// параметром body, components/schemas - definitions, servers - host, basePath и schemes. Спецификация,
+// разбитая на файлы, сначала собирается в один документ (см. specbundle.File), затем к ней применяются
+// -overlay с расширениями только для go-swagger (см. specoverlay.Apply).
+//
+//	go run ./cmd/swagger2 [-o swagger.yaml] [-overlay overlay.yaml]... openapi.yaml
+//
+// Результат пишется в -o или в stdout. О том, что в 2.0 не выражается (oneOf, anyOf, несколько типов тела
+// запроса, свои схемы у разных типов ответа и т.п.), команда предупреждает в stderr; с -strict предупреждения
+// считаются ошибкой.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"tools/specbundle"
+	"tools/specoverlay"
+)
+
+func main() {
+	output := flag.String("o", "", "output file, stdout if empty")
+	strict := flag.Bool("strict", false, "fail if anything cannot be expressed in Swagger 2.0")
+
+	var overlays []string
+
+	flag.Func("overlay", "OpenAPI Overlay 1.0 file applied before the conversion, can be repeated", func(path string) error {
+		overlays = append(overlays, path)
+
+		return nil
+	})
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: swagger2 [-o swagger.yaml] [-overlay overlay.yaml]... [-strict] openapi.yaml")
+		os.Exit(2)
+	}
+
+	path := flag.Arg(0)
+
+	data, err := specbundle.File(path)
+	if err == nil {
+		data, err = specoverlay.Apply(data, overlays...)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	result, warnings, err := generate(data, filepath.Base(path))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+		os.Exit(1)
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "%s: warning: %s\n", path, warning)
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(result)
+	} else {
+		err = os.WriteFile(*output, result, 0o644)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *strict && len(warnings) != 0 {
+		os.Exit(1)
+	}
+}
+
+// generate переводит спецификацию OpenAPI 3.x в Swagger 2.0 в YAML; source - имя исходного файла для заголовка.
+func generate(data []byte, source string) ([]byte, []string, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load spec: %w", err)
+	}
+
+	err = doc.Validate(openapi3.NewLoader().Context)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	result, warnings, err := convert(doc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert: %w", err)
+	}
+
+	header := fmt.Sprintf("# Code generated by swagger2 from %s. DO NOT EDIT.\n", source)
+
+	output, err := marshal(result, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return output, warnings, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"gopkg.in/yaml.v3"
+)
+
+// keyOrder - порядок полей объектов спецификации в выводе, остальные поля идут за ними по алфавиту
+var keyOrder = []string{
+	"swagger", "info", "host", "basePath", "schemes", "consumes", "produces", "security", "paths", "definitions",
+	"title", "summary", "operationId", "name", "in", "required", "type", "format", "description", "version",
+}
+
+// namedKeys - поля, ключи которых задает автор спецификации (пути, имена схем, свойств, статусы); они сортируются
+var namedKeys = []string{"paths", "definitions", "properties", "responses", "headers", "parameters", "securityDefinitions"}
+
+// marshal выводит спецификацию в YAML с отступом 4, как остальные спецификации репозитория, и стабильным
+// порядком полей, чтобы повторная генерация не давала диффа.
+func marshal(doc *openapi2.T, header string) ([]byte, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+
+	err = yaml.Unmarshal(data, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	normalize(&node, false)
+
+	var buf bytes.Buffer
+
+	buf.WriteString(header)
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+
+	err = encoder.Encode(&node)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// normalize убирает JSON-стиль (flow и кавычки: где они нужны, их расставит кодировщик) и упорядочивает поля;
+// named - ключи узла задает автор.
+func normalize(node *yaml.Node, named bool) {
+	node.Style = 0
+
+	if node.Kind != yaml.MappingNode {
+		for _, child := range node.Content {
+			normalize(child, false)
+		}
+
+		return
+	}
+
+	type pair struct{ key, value *yaml.Node }
+
+	pairs := make([]pair, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs = append(pairs, pair{node.Content[i], node.Content[i+1]})
+	}
+
+	sort.SliceStable(pairs, func(i int, j int) bool {
+		a, b := pairs[i].key.Value, pairs[j].key.Value
+		if named {
+			return a < b
+		}
+
+		return keyLess(a, b)
+	})
+
+	node.Content = node.Content[:0]
+
+	for _, p := range pairs {
+		normalize(p.key, false)
+		normalize(p.value, !named && slices.Contains(namedKeys, p.key.Value))
+
+		node.Content = append(node.Content, p.key, p.value)
+	}
+}
+
+func keyLess(a string, b string) bool {
+	i, j := slices.Index(keyOrder, a), slices.Index(keyOrder, b)
+
+	switch {
+	case i >= 0 && j >= 0:
+		return i < j
+	case i >= 0:
+		return true
+	case j >= 0:
+		return false
+	default:
+		return a < b
+	}
+}
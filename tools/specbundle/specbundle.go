@@ -0,0 +1,533 @@
+package specbundle
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Виды узлов спецификации, по которым bundler понимает, чем станет внешняя ссылка: компонентом своего вида
+// (schemas, responses, ...) или, если компонента такого вида нет (path item), содержимым файла на месте ссылки.
+const (
+	kindDocument   = "document"
+	kindComponents = "components"
+	kindPathItem   = "pathItem"
+	kindOperation  = "operation"
+	kindMediaType  = "mediaType"
+
+	// префиксы вида для коллекций: map - значения словаря, list - элементы списка, defs - определения
+	// компонентов корневого файла (их имена уже заданы)
+	mapOf  = "map:"
+	listOf = "list:"
+	defsOf = "defs:"
+)
+
+// componentKinds - виды компонентов в порядке components OpenAPI 3
+var componentKinds = []string{"schemas", "responses", "parameters", "examples", "requestBodies", "headers", "links", "callbacks"}
+
+// children - вид дочернего узла по виду родителя и ключу; "*" - любой ключ
+var children = map[string]map[string]string{
+	kindDocument: {"paths": mapOf + kindPathItem, "webhooks": mapOf + kindPathItem, "components": kindComponents},
+	kindComponents: {
+		"schemas": defsOf + "schemas", "responses": defsOf + "responses", "parameters": defsOf + "parameters",
+		"examples": defsOf + "examples", "requestBodies": defsOf + "requestBodies", "headers": defsOf + "headers",
+		"links": defsOf + "links", "callbacks": defsOf + "callbacks",
+	},
+	kindPathItem: {
+		"get": kindOperation, "put": kindOperation, "post": kindOperation, "delete": kindOperation,
+		"options": kindOperation, "head": kindOperation, "patch": kindOperation, "trace": kindOperation,
+		"parameters": listOf + "parameters",
+	},
+	kindOperation: {
+		"parameters": listOf + "parameters", "requestBody": "requestBodies",
+		"responses": mapOf + "responses", "callbacks": mapOf + "callbacks",
+	},
+	kindMediaType:   {"schema": "schemas", "examples": mapOf + "examples"},
+	"callbacks":     {"*": kindPathItem},
+	"requestBodies": {"content": mapOf + kindMediaType},
+	"responses":     {"headers": mapOf + "headers", "content": mapOf + kindMediaType, "links": mapOf + "links"},
+	"parameters":    {"schema": "schemas", "content": mapOf + kindMediaType, "examples": mapOf + "examples"},
+	"headers":       {"schema": "schemas", "content": mapOf + kindMediaType, "examples": mapOf + "examples"},
+	"schemas": {
+		"properties": mapOf + "schemas", "additionalProperties": "schemas", "items": "schemas", "not": "schemas",
+		"allOf": listOf + "schemas", "oneOf": listOf + "schemas", "anyOf": listOf + "schemas",
+	},
+}
+
+// invalidNameChars - символы, недопустимые в имени компонента
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// File собирает спецификацию OpenAPI 3 из файла path и файлов, на которые она ссылается через $ref
+// (например schemas/User.yaml или paths/users.yaml#/get), в один документ без внешних ссылок.
+//
+// Схемы, ответы, параметры и другие компоненты из других файлов переносятся в components корневого документа
+// под именем из последнего сегмента ссылки (User для schemas/User.yaml и common.yaml#/components/schemas/User),
+// ссылки на них заменяются на локальные. При совпадении имен первым имя получает компонент корневого файла,
+// затем компоненты в порядке появления ссылок в документе, следующим добавляется номер: User2, User3.
+// Path item подставляется на место ссылки.
+func File(path string) ([]byte, error) {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bundler{
+		root:  root,
+		docs:  make(map[string]*yaml.Node),
+		names: make(map[string]string),
+		taken: make(map[string]map[string]bool),
+		added: make(map[string][]component),
+	}
+
+	doc, err := b.load(root)
+	if err != nil {
+		return nil, err
+	}
+
+	b.reserve(doc)
+
+	err = b.walk(doc, root, kindDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	b.addComponents(doc)
+
+	if ref := externalRef(doc); ref != "" {
+		return nil, fmt.Errorf("%s: $ref %q is in a place the bundler does not know", path, ref)
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+
+	err = encoder.Encode(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{doc}})
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type bundler struct {
+	// root - абсолютный путь корневого файла
+	root string
+	// docs - разобранные файлы по абсолютному пути
+	docs map[string]*yaml.Node
+	// names - имя компонента по виду и цели ссылки (см. targetKey)
+	names map[string]string
+	// taken - занятые имена компонентов по виду
+	taken map[string]map[string]bool
+	// added - компоненты из других файлов по виду в порядке появления
+	added map[string][]component
+}
+
+type component struct {
+	name  string
+	value *yaml.Node
+}
+
+func (b *bundler) load(path string) (*yaml.Node, error) {
+	if doc, ok := b.docs[path]; ok {
+		return doc, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc yaml.Node
+
+	err = yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("%s: empty document", path)
+	}
+
+	b.docs[path] = doc.Content[0]
+
+	return doc.Content[0], nil
+}
+
+// reserve занимает имена компонентов корневого файла. Если компонент задан ссылкой на другой файл, другие
+// ссылки на тот же файл ведут на этот компонент.
+func (b *bundler) reserve(doc *yaml.Node) {
+	components := mappingValue(doc, "components")
+
+	for _, kind := range componentKinds {
+		definitions := mappingValue(components, kind)
+		if definitions == nil {
+			continue
+		}
+
+		for i := 0; i+1 < len(definitions.Content); i += 2 {
+			name, value := definitions.Content[i].Value, definitions.Content[i+1]
+
+			b.take(kind, name)
+
+			if ref := refValue(value); ref != "" {
+				if target, pointer, err := b.resolve(b.root, ref); err == nil && target != b.root {
+					b.names[targetKey(kind, target, pointer)] = name
+				}
+			}
+		}
+	}
+}
+
+// walk заменяет внешние ссылки в node из файла file; kind - вид узла.
+func (b *bundler) walk(node *yaml.Node, file string, kind string) error {
+	switch {
+	case strings.HasPrefix(kind, mapOf), strings.HasPrefix(kind, defsOf):
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		definitions := strings.HasPrefix(kind, defsOf) && file == b.root
+		itemKind := strings.TrimPrefix(strings.TrimPrefix(kind, mapOf), defsOf)
+
+		for i := 1; i < len(node.Content); i += 2 {
+			var err error
+
+			// определение компонента ссылкой на другой файл заменяется содержимым файла под тем же именем
+			if definitions && b.isExternal(node.Content[i]) {
+				err = b.inline(node.Content[i], file, itemKind)
+			} else {
+				err = b.walk(node.Content[i], file, itemKind)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case strings.HasPrefix(kind, listOf):
+		for _, item := range node.Content {
+			err := b.walk(item, file, strings.TrimPrefix(kind, listOf))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	if ref := refValue(node); ref != "" {
+		return b.ref(node, ref, file, kind)
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		childKind, ok := children[kind][node.Content[i].Value]
+		if !ok {
+			childKind, ok = children[kind]["*"]
+		}
+
+		if ok {
+			err := b.walk(node.Content[i+1], file, childKind)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ref заменяет ссылку ref узла node на локальную ссылку на компонент или, для path item, на содержимое цели.
+func (b *bundler) ref(node *yaml.Node, ref string, file string, kind string) error {
+	target, pointer, err := b.resolve(file, ref)
+	if err != nil {
+		return err
+	}
+
+	if target == b.root {
+		setRef(node, "#"+pointer)
+
+		return nil
+	}
+
+	if !slices.Contains(componentKinds, kind) {
+		return b.inline(node, file, kind)
+	}
+
+	name, err := b.component(kind, target, pointer)
+	if err != nil {
+		return err
+	}
+
+	setRef(node, "#/components/"+kind+"/"+name)
+
+	return nil
+}
+
+// inline заменяет узел-ссылку на другой файл копией цели ссылки.
+func (b *bundler) inline(node *yaml.Node, file string, kind string) error {
+	ref := refValue(node)
+	if ref == "" {
+		return nil
+	}
+
+	target, pointer, err := b.resolve(file, ref)
+	if err != nil {
+		return err
+	}
+
+	if target == b.root {
+		setRef(node, "#"+pointer)
+
+		return nil
+	}
+
+	value, err := b.lookup(target, pointer)
+	if err != nil {
+		return err
+	}
+
+	*node = *deepCopy(value)
+
+	// цель сама может быть ссылкой
+	if refValue(node) != "" {
+		return b.inline(node, target, kind)
+	}
+
+	// ссылки внутри цели разрешаются относительно ее файла
+	return b.walk(node, target, kind)
+}
+
+// component возвращает имя компонента вида kind для цели ссылки, при первой ссылке добавляя его в added.
+func (b *bundler) component(kind string, target string, pointer string) (string, error) {
+	key := targetKey(kind, target, pointer)
+	if name, ok := b.names[key]; ok {
+		return name, nil
+	}
+
+	value, err := b.lookup(target, pointer)
+	if err != nil {
+		return "", err
+	}
+
+	name := b.unique(kind, componentName(target, pointer))
+	// имя занимается до обхода: цель может ссылаться на себя
+	b.names[key] = name
+
+	value = deepCopy(value)
+	err = b.walk(value, target, kind)
+	if err != nil {
+		return "", err
+	}
+
+	b.added[kind] = append(b.added[kind], component{name: name, value: value})
+
+	return name, nil
+}
+
+// resolve возвращает абсолютный путь файла и JSON pointer цели ссылки ref из файла file.
+func (b *bundler) resolve(file string, ref string) (string, string, error) {
+	location, fragment, _ := strings.Cut(ref, "#")
+
+	pointer, err := url.PathUnescape(fragment)
+	if err != nil {
+		return "", "", fmt.Errorf("%s: $ref %q: %w", file, ref, err)
+	}
+
+	if location == "" {
+		return file, pointer, nil
+	}
+
+	if u, err := url.Parse(location); err != nil || u.Scheme != "" || filepath.IsAbs(location) {
+		return "", "", fmt.Errorf("%s: $ref %q: only relative file references are supported", file, ref)
+	}
+
+	return filepath.Join(filepath.Dir(file), filepath.FromSlash(location)), pointer, nil
+}
+
+// lookup возвращает узел по JSON pointer в файле path.
+func (b *bundler) lookup(path string, pointer string) (*yaml.Node, error) {
+	node, err := b.load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if pointer == "" || pointer == "/" {
+		return node, nil
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		switch node.Kind {
+		case yaml.MappingNode:
+			node = mappingValue(node, token)
+		case yaml.SequenceNode:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				node = nil
+			} else {
+				node = node.Content[i]
+			}
+		default:
+			node = nil
+		}
+
+		if node == nil {
+			return nil, fmt.Errorf("%s: %s not found", path, pointer)
+		}
+	}
+
+	return node, nil
+}
+
+func (b *bundler) take(kind string, name string) {
+	if b.taken[kind] == nil {
+		b.taken[kind] = make(map[string]bool)
+	}
+
+	b.taken[kind][name] = true
+}
+
+// unique возвращает свободное имя компонента: name или name с номером, начиная с 2.
+func (b *bundler) unique(kind string, name string) string {
+	candidate := name
+
+	for i := 2; b.taken[kind][candidate]; i++ {
+		candidate = name + strconv.Itoa(i)
+	}
+
+	b.take(kind, candidate)
+
+	return candidate
+}
+
+// addComponents добавляет компоненты из других файлов в components корневого документа после своих.
+func (b *bundler) addComponents(doc *yaml.Node) {
+	components := mappingValue(doc, "components")
+
+	for _, kind := range componentKinds {
+		if len(b.added[kind]) == 0 {
+			continue
+		}
+
+		if components == nil {
+			components = &yaml.Node{Kind: yaml.MappingNode}
+			doc.Content = append(doc.Content, scalar("components"), components)
+		}
+
+		definitions := mappingValue(components, kind)
+		if definitions == nil {
+			definitions = &yaml.Node{Kind: yaml.MappingNode}
+			components.Content = append(components.Content, scalar(kind), definitions)
+		}
+
+		for _, c := range b.added[kind] {
+			definitions.Content = append(definitions.Content, scalar(c.name), c.value)
+		}
+	}
+}
+
+// targetKey - ключ цели ссылки в names: одна цель может быть компонентом разных видов
+func targetKey(kind string, file string, pointer string) string {
+	return kind + " " + file + "#" + pointer
+}
+
+// componentName - имя компонента по последнему сегменту ссылки: имени в pointer или имени файла.
+func componentName(file string, pointer string) string {
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+
+	if i := strings.LastIndex(pointer, "/"); i >= 0 && i+1 < len(pointer) {
+		name = strings.NewReplacer("~1", "/", "~0", "~").Replace(pointer[i+1:])
+	}
+
+	return invalidNameChars.ReplaceAllString(name, "_")
+}
+
+// externalRef возвращает первую оставшуюся ссылку на другой файл или пустую строку.
+func externalRef(node *yaml.Node) string {
+	if ref := refValue(node); ref != "" && !strings.HasPrefix(ref, "#") {
+		return ref
+	}
+
+	for _, child := range node.Content {
+		if ref := externalRef(child); ref != "" {
+			return ref
+		}
+	}
+
+	return ""
+}
+
+func (b *bundler) isExternal(node *yaml.Node) bool {
+	ref := refValue(node)
+	if ref == "" {
+		return false
+	}
+
+	target, _, err := b.resolve(b.root, ref)
+
+	return err != nil || target != b.root
+}
+
+func refValue(node *yaml.Node) string {
+	value := mappingValue(node, "$ref")
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+
+	return value.Value
+}
+
+func setRef(node *yaml.Node, ref string) {
+	value := mappingValue(node, "$ref")
+	value.Value = ref
+	value.Style = 0
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func scalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func deepCopy(node *yaml.Node) *yaml.Node {
+	result := *node
+	result.Content = make([]*yaml.Node, len(node.Content))
+
+	for i, child := range node.Content {
+		result.Content[i] = deepCopy(child)
+	}
+
+	return &result
+}
@@ -0,0 +1,238 @@
+package specbundle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// testFiles - спецификация, разбитая на файлы; Error из schemas/common.yaml совпадает по имени с Error корневого файла
+var testFiles = map[string]string{
+	"openapi.yaml": `openapi: 3.0.3
+info:
+    title: Users API
+    version: 1.0.0
+paths:
+    /users/{id}:
+        $ref: paths/user.yaml
+components:
+    schemas:
+        User:
+            $ref: schemas/User.yaml
+        Error:
+            type: object
+            properties:
+                code:
+                    type: integer
+`,
+	"paths/user.yaml": `get:
+    operationId: GetUser
+    parameters:
+        - $ref: ../parameters/id.yaml
+    responses:
+        "200":
+            description: OK
+            content:
+                application/json:
+                    schema:
+                        $ref: ../schemas/User.yaml
+        "404":
+            $ref: ../responses/NotFound.yaml
+`,
+	"parameters/id.yaml": `name: id
+in: path
+required: true
+schema:
+    type: integer
+`,
+	"responses/NotFound.yaml": `description: Not Found
+content:
+    application/json:
+        schema:
+            $ref: ../openapi.yaml#/components/schemas/Error
+`,
+	"schemas/User.yaml": `type: object
+properties:
+    id:
+        type: integer
+    manager:
+        $ref: User.yaml
+    last_error:
+        $ref: common.yaml#/Error
+`,
+	"schemas/common.yaml": `Error:
+    type: object
+    properties:
+        message:
+            type: string
+`,
+}
+
+const bundledWant = `openapi: 3.0.3
+info:
+    title: Users API
+    version: 1.0.0
+paths:
+    /users/{id}:
+        get:
+            operationId: GetUser
+            parameters:
+                - $ref: '#/components/parameters/id'
+            responses:
+                "200":
+                    description: OK
+                    content:
+                        application/json:
+                            schema:
+                                $ref: '#/components/schemas/User'
+                "404":
+                    $ref: '#/components/responses/NotFound'
+components:
+    schemas:
+        User:
+            type: object
+            properties:
+                id:
+                    type: integer
+                manager:
+                    $ref: '#/components/schemas/User'
+                last_error:
+                    $ref: '#/components/schemas/Error2'
+        Error:
+            type: object
+            properties:
+                code:
+                    type: integer
+        Error2:
+            type: object
+            properties:
+                message:
+                    type: string
+    responses:
+        NotFound:
+            description: Not Found
+            content:
+                application/json:
+                    schema:
+                        $ref: '#/components/schemas/Error'
+    parameters:
+        id:
+            name: id
+            in: path
+            required: true
+            schema:
+                type: integer
+`
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		err := os.MkdirAll(filepath.Dir(path), 0o755)
+		if err != nil {
+			t.Fatalf("MkdirAll() error = %v", err)
+		}
+
+		err = os.WriteFile(path, []byte(content), 0o644)
+		if err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	return dir
+}
+
+func TestFile(t *testing.T) {
+	dir := writeFiles(t, testFiles)
+
+	got, err := File(filepath.Join(dir, "openapi.yaml"))
+	if err != nil {
+		t.Fatalf("File() error = %v", err)
+	}
+
+	if string(got) != bundledWant {
+		t.Fatalf("File() =\n%s\nwant\n%s", got, bundledWant)
+	}
+
+	doc, err := openapi3.NewLoader().LoadFromData(got)
+	if err != nil {
+		t.Fatalf("LoadFromData() error = %v", err)
+	}
+
+	err = doc.Validate(openapi3.NewLoader().Context)
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+}
+
+func TestFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "missing file",
+			file:    "schemas/User.yaml",
+			content: "$ref: Missing.yaml\n",
+			wantErr: "Missing.yaml",
+		},
+		{
+			name:    "missing pointer",
+			file:    "schemas/User.yaml",
+			content: "$ref: common.yaml#/Missing\n",
+			wantErr: "/Missing not found",
+		},
+		{
+			name:    "remote reference",
+			file:    "parameters/id.yaml",
+			content: "$ref: https://example.com/id.yaml\n",
+			wantErr: "only relative file references are supported",
+		},
+		{
+			name:    "unknown place",
+			file:    "paths/user.yaml",
+			content: "get:\n    x-extra:\n        $ref: ../schemas/User.yaml\n    responses: {}\n",
+			wantErr: "is in a place the bundler does not know",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make(map[string]string, len(testFiles))
+			for name, content := range testFiles {
+				files[name] = content
+			}
+
+			files[tt.file] = tt.content
+
+			_, err := File(filepath.Join(writeFiles(t, files), "openapi.yaml"))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("File() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// Спецификации репозитория генераторы получают собранными (см. Makefile)
+func TestFile_RepoSpecs(t *testing.T) {
+	for _, path := range []string{"../../oapi-codegen/openapi.yaml", "../../ogen-go/openapi.yaml"} {
+		got, err := File(path)
+		if err != nil {
+			t.Fatalf("File(%s) error = %v", path, err)
+		}
+
+		_, err = openapi3.NewLoader().LoadFromData(got)
+		if err != nil {
+			t.Fatalf("%s: LoadFromData() error = %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package specoverlay
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/speakeasy-api/openapi-overlay/pkg/overlay"
+	"gopkg.in/yaml.v3"
+)
+
+// rfc9535 - JSONPath из RFC 9535, который требует Overlay 1.0. Без него библиотека разбирает target
+// устаревшей реализацией.
+const rfc9535 = "rfc9535"
+
+// Apply применяет к спецификации spec файлы OpenAPI Overlay 1.0 по порядку (update сливается с целью, remove
+// удаляет ее) и возвращает результат в YAML.
+//
+// Target каждого действия должен находиться в спецификации: overlay, который после переименования в общей
+// спецификации больше ничего не меняет, - ошибка, а не молча потерянное расширение.
+func Apply(spec []byte, paths ...string) ([]byte, error) {
+	var doc yaml.Node
+
+	err := yaml.Unmarshal(spec, &doc)
+	if err != nil {
+		return nil, fmt.Errorf("parse spec: %w", err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("parse spec: empty document")
+	}
+
+	for _, path := range paths {
+		err = applyFile(&doc, path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(4)
+
+	err = encoder.Encode(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func applyFile(doc *yaml.Node, path string) error {
+	o, err := overlay.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	err = o.Validate()
+	if err != nil {
+		return err
+	}
+
+	o.JSONPathVersion = rfc9535
+
+	// действия применяются по одному: target следующего может появиться после предыдущего
+	for i, action := range o.Actions {
+		target, err := o.NewPath(action.Target, nil)
+		if err != nil {
+			return fmt.Errorf("action %d: target %q: %w", i, action.Target, err)
+		}
+
+		if len(target.Query(doc.Content[0])) == 0 {
+			return fmt.Errorf("action %d: target %q matches nothing", i, action.Target)
+		}
+
+		single := *o
+		single.Actions = []overlay.Action{action}
+
+		err = single.ApplyTo(doc.Content[0])
+		if err != nil {
+			return fmt.Errorf("action %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
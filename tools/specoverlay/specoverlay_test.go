@@ -0,0 +1,122 @@
+package specoverlay
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const spec = `openapi: 3.0.3
+info:
+    title: Users API
+    version: 1.0.0
+paths:
+    /users/{id}:
+        get:
+            operationId: GetUser
+            x-internal: true
+components:
+    schemas:
+        User:
+            type: object
+            properties:
+                deleted_at:
+                    type: string
+                    format: date-time
+`
+
+func writeOverlay(t *testing.T, actions string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "overlay.yaml")
+	content := "overlay: 1.0.0\ninfo:\n    title: test\n    version: 1.0.0\nactions:\n" + actions
+
+	err := os.WriteFile(path, []byte(content), 0o644)
+	if err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	return path
+}
+
+func TestApply(t *testing.T) {
+	update := writeOverlay(t, `    - target: $.components.schemas.User.properties.deleted_at
+      update:
+          x-nullable: true
+    - target: $.paths['/users/{id}'].get
+      update:
+          x-go-name: GetUserByID
+`)
+	remove := writeOverlay(t, `    - target: $.paths['/users/{id}'].get['x-internal']
+      remove: true
+`)
+
+	got, err := Apply([]byte(spec), update, remove)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	want := strings.NewReplacer(
+		"            x-internal: true\n", "            x-go-name: GetUserByID\n",
+		"                    format: date-time\n", "                    format: date-time\n                    x-nullable: true\n",
+	).Replace(spec)
+
+	if string(got) != want {
+		t.Fatalf("Apply() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// Следующее действие видит результат предыдущего
+func TestApply_Order(t *testing.T) {
+	path := writeOverlay(t, `    - target: $.components.schemas.User.properties
+      update:
+          name:
+              type: string
+    - target: $.components.schemas.User.properties.name
+      update:
+          x-nullable: true
+`)
+
+	got, err := Apply([]byte(spec), path)
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+
+	if !strings.Contains(string(got), "name:\n                    type: string\n                    x-nullable: true\n") {
+		t.Fatalf("Apply() =\n%s\nwant name with x-nullable", got)
+	}
+}
+
+func TestApply_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		overlay string
+		wantErr string
+	}{
+		{
+			name:    "target matches nothing",
+			overlay: "    - target: $.components.schemas.Missing\n      update:\n          x-nullable: true\n",
+			wantErr: "matches nothing",
+		},
+		{
+			name:    "invalid target",
+			overlay: "    - target: components.schemas\n      remove: true\n",
+			wantErr: "target",
+		},
+		{
+			name:    "no actions",
+			overlay: "    []\n",
+			wantErr: "at least one action",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Apply([]byte(spec), writeOverlay(t, tt.overlay))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Apply() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}